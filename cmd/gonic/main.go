@@ -11,9 +11,10 @@ import (
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
 	"github.com/peterbourgon/ff"
 
+	"senan.xyz/g/gonic/cmd/internal/cmd"
 	"senan.xyz/g/gonic/db"
-	"senan.xyz/g/gonic/dir"
 	"senan.xyz/g/gonic/server"
+	"senan.xyz/g/gonic/server/covers"
 	"senan.xyz/g/gonic/version"
 )
 
@@ -21,9 +22,7 @@ func main() {
 	set := flag.NewFlagSet(version.NAME, flag.ExitOnError)
 	listenAddr := set.String("listen-addr", "0.0.0.0:4747", "listen address (optional)")
 	frontendAddr := set.String("frontend-addr", "", "frontend address for UPNP (optional)")
-	localMusicPath := set.String("music-path", "", "path to music (optional)")
-	remoteMusicS3Region := set.String("remote-music-s3-region", "us-west-2", "region of the S3 bucket to read music from (optional, default: us-west-2)")
-	remoteMusicS3Bucket := set.String("remote-music-s3-bucket", "", "name of the S3 bucket to read music from (optional)")
+	musicFlags := cmd.NewMusicFlags(set)
 	cachePath := set.String("cache-path", "/tmp/gonic_cache", "path to cache (optional, default: /tmp/gonic_cache)")
 	sqlitePath := set.String("db-path", "gonic.db", "path to database (optional, default: gonic.db)")
 	postgresHost := set.String("postgres-host", "", "name of the PostgreSQL server (optional)")
@@ -31,6 +30,12 @@ func main() {
 	postgresName := set.String("postgres-db", "gonic", "name of the PostgreSQL database (optional, default: gonic)")
 	postgresUser := set.String("postgres-user", "gonic", "name of the PostgreSQL user (optional, default: gonic)")
 	scanInterval := set.Int("scan-interval", 0, "interval (in minutes) to automatically scan music (optional)")
+	musicCacheSize := set.Int("music-cache-size", 0, "size (in MB) of the on disk cache of music streamed from remote music folders, eg. S3, SFTP, or WebDAV. kept under the cache path (optional)")
+	coverSizes := set.String("cover-sizes", "128,256,512,1024", "comma separated sizes (in px) covers are scaled to for clients which ask for smaller ones. kept under the cache path (optional, default: 128,256,512,1024)")
+	scanConcurrency := set.Int("scan-concurrency", 4, "number of tracks to read the tags of at once while scanning (optional, default: 4)")
+	scanDelimiters := set.String("multi-value-delimiters", ";", "characters which separate the values of artist and genre tags, eg. ';/' (optional, default: ;)")
+	variousArtists := set.String("various-artists", "Various Artists", "name of the artist compilations without an album artist tag are filed under (optional, default: Various Artists)")
+	scanWatcher := set.Bool("scan-watcher", false, "watch local music folders for changes, and rescan only what changed (optional)")
 	proxyPrefix := set.String("proxy-prefix", "", "url path prefix to use if behind proxy. eg '/gonic' (optional)")
 	_ = set.String("config-path", "", "path to config (optional)")
 	showVersion := set.Bool("version", false, "show gonic version")
@@ -47,15 +52,9 @@ func main() {
 		os.Exit(0)
 	}
 
-	var musicDir dir.Dir
-	var err error
-	if len(*remoteMusicS3Bucket) > 0 {
-		musicDir, err = dir.NewS3Dir(*remoteMusicS3Region, *remoteMusicS3Bucket)
-	} else {
-		musicDir, err = dir.NewLocalDir(*localMusicPath)
-	}
+	music, err := musicFlags.Open()
 	if err != nil {
-		log.Fatalf("please provide a valid music directory: %v\n", err)
+		log.Fatalf("error opening music: %v\n", err)
 	}
 
 	if _, err := os.Stat(*cachePath); os.IsNotExist(err) {
@@ -75,23 +74,41 @@ func main() {
 	}
 	defer database.Close()
 
+	if err := database.SetMusicFolders(music.Folders); err != nil {
+		log.Fatalf("error setting music folders: %v\n", err)
+	}
+	musicDirs, err := music.Dirs(*cachePath, *musicCacheSize)
+	if err != nil {
+		log.Fatalf("error opening music: %v\n", err)
+	}
+
+	coverSizeList, err := covers.ParseSizes(*coverSizes)
+	if err != nil {
+		log.Fatalf("please provide valid cover sizes: %v\n", err)
+	}
+
 	proxyPrefixExpr := regexp.MustCompile(`^\/*(.*?)\/*$`)
 	*proxyPrefix = proxyPrefixExpr.ReplaceAllString(*proxyPrefix, `/$1`)
 	serverOptions := server.Options{
-		DB:           database,
-		MusicDir:     musicDir,
-		CachePath:    *cachePath,
-		ListenAddr:   *listenAddr,
-		FrontendAddr: *frontendAddr,
-		ScanInterval: time.Duration(*scanInterval) * time.Minute,
-		ProxyPrefix:  *proxyPrefix,
+		DB:                 database,
+		MusicDirs:          musicDirs,
+		CachePath:          *cachePath,
+		ListenAddr:         *listenAddr,
+		FrontendAddr:       *frontendAddr,
+		ScanInterval:       time.Duration(*scanInterval) * time.Minute,
+		ScanWatch:          *scanWatcher,
+		ScanConcurrency:    *scanConcurrency,
+		ScanDelimiters:     *scanDelimiters,
+		ScanVariousArtists: *variousArtists,
+		CoverSizes:         coverSizeList,
+		ProxyPrefix:        *proxyPrefix,
 	}
 
 	log.Printf("using opts %+v\n", serverOptions)
 	s := server.New(serverOptions)
 
 	log.Printf("starting server at %s", *listenAddr)
-	if err := s.Start(); err != nil {
+	if err := s.Start(cmd.StopContext()); err != nil {
 		log.Fatalf("error starting server: %v\n", err)
 	}
 }
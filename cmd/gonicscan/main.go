@@ -9,17 +9,20 @@ import (
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
 	"github.com/peterbourgon/ff"
 
+	"senan.xyz/g/gonic/cmd/internal/cmd"
 	"senan.xyz/g/gonic/db"
-	"senan.xyz/g/gonic/dir"
 	"senan.xyz/g/gonic/scanner"
 	"senan.xyz/g/gonic/version"
 )
 
 func main() {
 	set := flag.NewFlagSet(version.NAME_SCAN, flag.ExitOnError)
-	localMusicPath := set.String("music-path", "", "path to music (optional)")
-	remoteMusicS3Region := set.String("remote-music-s3-region", "us-west-2", "region of the S3 bucket to read music from (optional, default us-west-2)")
-	remoteMusicS3Bucket := set.String("remote-music-s3-bucket", "", "name of the S3 bucket to read music from (optional)")
+	musicFlags := cmd.NewMusicFlags(set)
+	scanConcurrency := set.Int("scan-concurrency", 4, "number of tracks to read the tags of at once while scanning (optional, default: 4)")
+	scanDelimiters := set.String("multi-value-delimiters", ";", "characters which separate the values of artist and genre tags, eg. ';/' (optional, default: ;)")
+	variousArtists := set.String("various-artists", "Various Artists", "name of the artist compilations without an album artist tag are filed under (optional, default: Various Artists)")
+	fullRescan := set.Bool("full-rescan", false, "read every track again, even the ones which haven't been modified since the last scan (optional)")
+	printErrors := set.Bool("print-errors", false, "print a report of the files which couldn't be scanned when done (optional)")
 	sqlitePath := set.String("db-path", "gonic.db", "path to database (optional, default: gonic.db)")
 	postgresHost := set.String("postgres-host", "", "name of the PostgreSQL server (optional)")
 	postgresPort := set.Int("postgres-port", 5432, "port to use for PostgreSQL connection (optional, default: 5432)")
@@ -40,15 +43,9 @@ func main() {
 		os.Exit(0)
 	}
 
-	var musicDir dir.Dir
-	var err error
-	if len(*remoteMusicS3Bucket) > 0 {
-		musicDir, err = dir.NewS3Dir(*remoteMusicS3Region, *remoteMusicS3Bucket)
-	} else {
-		musicDir, err = dir.NewLocalDir(*localMusicPath)
-	}
+	music, err := musicFlags.Open()
 	if err != nil {
-		log.Fatalf("please provide a valid music directory: %v\n", err)
+		log.Fatalf("error opening music: %v\n", err)
 	}
 
 	var database *db.DB
@@ -62,11 +59,48 @@ func main() {
 	}
 	defer database.Close()
 
+	if err := database.SetMusicFolders(music.Folders); err != nil {
+		log.Fatalf("error setting music folders: %v\n", err)
+	}
+	// scans only read parts of files, so there's nothing to cache
+	musicDirs, err := music.Dirs("", 0)
+	if err != nil {
+		log.Fatalf("error opening music: %v\n", err)
+	}
+
 	s := scanner.New(
 		database,
-		musicDir,
+		musicDirs,
+		scanner.Options{
+			Concurrency:          *scanConcurrency,
+			MultiValueDelimiters: *scanDelimiters,
+			VariousArtists:       *variousArtists,
+		},
 	)
-	if err := s.Start(); err != nil {
+	if err := s.Start(cmd.StopContext(), scanner.ScanOptions{Force: *fullRescan}); err != nil {
 		log.Fatalf("error starting scanner: %v\n", err)
 	}
+	if *printErrors {
+		printScanErrors(database, s.Progress().ID)
+	}
+}
+
+// printScanErrors prints the errors of the scan with the given id to
+// stdout, by music folder
+func printScanErrors(database *db.DB, scanID int64) {
+	var scanErrors []*db.ScanError
+	database.
+		Preload("MusicFolder").
+		Where("scan_id=?", scanID).
+		Order("music_folder_id, path").
+		Find(&scanErrors)
+	fmt.Printf("%d errors\n", len(scanErrors))
+	var musicFolderID int
+	for _, scanError := range scanErrors {
+		if scanError.MusicFolderID != musicFolderID {
+			musicFolderID = scanError.MusicFolderID
+			fmt.Printf("\n%s (%s)\n", scanError.MusicFolder.Name, scanError.MusicFolder.Location)
+		}
+		fmt.Printf("  %s\n    %s: %s\n", scanError.Path, scanError.Stage, scanError.Error)
+	}
 }
@@ -0,0 +1,155 @@
+// Package cmd has what gonic and gonicscan have in common, such as the
+// flags for where the music is
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"senan.xyz/g/gonic/db"
+	"senan.xyz/g/gonic/dir"
+)
+
+// MusicFlags are the flags for where the music is, and how to read it
+type MusicFlags struct {
+	paths                     dir.MusicPaths
+	s3Region                  *string
+	s3Bucket                  *string
+	s3Endpoint                *string
+	s3PathStyle               *bool
+	s3AccessKey               *string
+	s3Prefix                  *string
+	s3RequesterPays           *bool
+	sftpUser                  *string
+	sftpPassword              *string
+	sftpKey                   *string
+	sftpKnownHosts            *string
+	sftpInsecureIgnoreHostKey *bool
+	webDAVUser                *string
+	webDAVPassword            *string
+	zipArchives               *bool
+	exclude                   *string
+}
+
+// NewMusicFlags adds the music flags to set
+func NewMusicFlags(set *flag.FlagSet) *MusicFlags {
+	f := &MusicFlags{}
+	set.Var(&f.paths, "music-path", "path to music, or an s3://, sftp://, webdav://, or webdavs:// url. prefix with a name like 'Audiobooks->/audiobooks'. can be given more than once (optional)")
+	f.s3Region = set.String("remote-music-s3-region", "us-west-2", "region of the S3 bucket to read music from (optional, default: us-west-2)")
+	f.s3Bucket = set.String("remote-music-s3-bucket", "", "name of the S3 bucket to read music from (optional)")
+	f.s3Endpoint = set.String("remote-music-s3-endpoint", "", "url of an S3 compatible service, eg. http://minio:9000 (optional, default: AWS)")
+	f.s3PathStyle = set.Bool("remote-music-s3-path-style", false, "use path style addressing for the S3 bucket, needed by most S3 compatible services (optional)")
+	f.s3AccessKey = set.String("remote-music-s3-access-key", "", "access key id for the S3 bucket (optional, default: from the environment)")
+	f.s3Prefix = set.String("remote-music-s3-prefix", "", "folder in the S3 bucket to use as the root of the music (optional)")
+	f.s3RequesterPays = set.Bool("remote-music-s3-requester-pays", true, "pay for requests to a requester pays S3 bucket (optional, default: true)")
+	f.sftpUser = set.String("remote-music-sftp-user", "", "user for sftp:// music paths, if it's not in the url (optional)")
+	f.sftpPassword = set.String("remote-music-sftp-password", "", "password for sftp:// music paths (optional)")
+	f.sftpKey = set.String("remote-music-sftp-key", "", "path to a private key for sftp:// music paths (optional)")
+	f.sftpKnownHosts = set.String("remote-music-sftp-known-hosts", "", "path to a known_hosts file to check the keys of sftp servers against (optional, default: ~/.ssh/known_hosts)")
+	f.sftpInsecureIgnoreHostKey = set.Bool("remote-music-sftp-insecure-ignore-host-key", false, "connect to sftp servers without checking their keys (optional)")
+	f.webDAVUser = set.String("remote-music-webdav-user", "", "user for webdav:// music paths (optional)")
+	f.webDAVPassword = set.String("remote-music-webdav-password", "", "password for webdav:// music paths (optional)")
+	f.zipArchives = set.Bool("music-zip-archives", false, "browse zip archives in the music folders as if they were folders (optional)")
+	f.exclude = set.String("scan-exclude", "", "comma separated gitignore style patterns of what to leave out of scans, eg. '@eaDir,_incoming/,*.nfo'. .gonicignore files in the music folders are used too (optional)")
+	return f
+}
+
+func (f *MusicFlags) remoteOptions() dir.RemoteOptions {
+	return dir.RemoteOptions{
+		S3: dir.S3Options{
+			Region:          *f.s3Region,
+			Endpoint:        *f.s3Endpoint,
+			PathStyle:       *f.s3PathStyle,
+			AccessKeyID:     *f.s3AccessKey,
+			SecretAccessKey: os.Getenv("GONIC_REMOTE_MUSIC_S3_SECRET_KEY"),
+			RequesterPays:   *f.s3RequesterPays,
+		},
+		SFTP: dir.SFTPOptions{
+			User:                  *f.sftpUser,
+			Password:              *f.sftpPassword,
+			KeyPath:               *f.sftpKey,
+			KnownHostsPath:        *f.sftpKnownHosts,
+			InsecureIgnoreHostKey: *f.sftpInsecureIgnoreHostKey,
+		},
+		WebDAV: dir.WebDAVOptions{
+			User:     *f.webDAVUser,
+			Password: *f.webDAVPassword,
+		},
+	}
+}
+
+// Music is what the music flags point to, once opened
+type Music struct {
+	// Folders don't have their ids until they're saved with
+	// db.SetMusicFolders
+	Folders     []*db.MusicFolder
+	dirs        []dir.Dir
+	zipArchives bool
+	exclude     []string
+}
+
+// Open opens the music paths, and the S3 bucket if there's one. it's
+// called after the flags are parsed
+func (f *MusicFlags) Open() (*Music, error) {
+	paths := f.paths
+	if len(*f.s3Bucket) > 0 {
+		location := fmt.Sprintf("s3://%s/%s", *f.s3Bucket, *f.s3Prefix)
+		if err := paths.Set(location); err != nil {
+			return nil, errors.Wrap(err, "please provide a valid S3 bucket")
+		}
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("please provide a music directory")
+	}
+	remoteOptions := f.remoteOptions()
+	music := &Music{
+		Folders:     make([]*db.MusicFolder, 0, len(paths)),
+		dirs:        make([]dir.Dir, 0, len(paths)),
+		zipArchives: *f.zipArchives,
+	}
+	if *f.exclude != "" {
+		music.exclude = strings.Split(*f.exclude, ",")
+	}
+	for _, path := range paths {
+		musicDir, err := dir.Open(path.Location, remoteOptions)
+		if err != nil {
+			return nil, errors.Wrap(err, "please provide a valid music directory")
+		}
+		music.dirs = append(music.dirs, musicDir)
+		music.Folders = append(music.Folders, &db.MusicFolder{
+			Name:     path.Name,
+			Location: path.Location,
+		})
+	}
+	return music, nil
+}
+
+// Dirs are the music dirs by music folder id, so it's called after the
+// folders are saved. if cacheSize (in MB) isn't 0, remote dirs get an on
+// disk cache under cachePath
+func (m *Music) Dirs(cachePath string, cacheSize int) (map[int]dir.Dir, error) {
+	musicDirs := make(map[int]dir.Dir, len(m.Folders))
+	for i, musicFolder := range m.Folders {
+		musicDir := m.dirs[i]
+		if m.zipArchives {
+			musicDir = dir.NewZipDir(musicDir)
+		}
+		musicDir = dir.NewIgnoreDir(musicDir, m.exclude)
+		if cacheSize > 0 && musicDir.GetTypeName() != "local" {
+			musicCachePath := filepath.Join(cachePath, "music", strconv.Itoa(musicFolder.ID))
+			var err error
+			musicDir, err = dir.NewCacheDir(musicDir, musicCachePath, int64(cacheSize)*1024*1024)
+			if err != nil {
+				return nil, errors.Wrap(err, "creating music cache")
+			}
+		}
+		musicDirs[musicFolder.ID] = musicDir
+	}
+	return musicDirs, nil
+}
@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// StopContext returns a context which is done when we're told to stop,
+// eg. by docker, or with ctrl-c
+func StopContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-sigs
+		log.Printf("got %s, stopping\n", sig)
+		cancel()
+		// a second one stops right away
+		signal.Stop(sigs)
+	}()
+	return ctx
+}
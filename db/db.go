@@ -5,6 +5,9 @@ import (
 	"log"
 	"net/url"
 	"os"
+	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres"
@@ -58,6 +61,17 @@ func NewGormDB(db *gorm.DB) (*DB, error) {
 		&migrationCreateTranscode,
 		&migrationAddGenre,
 		&migrationUpdateTranscodePrefIDX,
+		&migrationAddMusicFolders,
+		&migrationAddScanErrors,
+		&migrationAddMultiValueTags,
+		&migrationAddEmbeddedCovers,
+		&migrationAddReplayGain,
+		&migrationAddCueTracks,
+		&migrationAddLyrics,
+		&migrationAddExtendedTags,
+		&migrationAddTagAlbums,
+		&migrationAddCompilations,
+		&migrationAddContentHashes,
 	})
 	if err := migr.Migrate(); err != nil {
 		return nil, errors.Wrap(err, "migrating to latest version")
@@ -101,3 +115,133 @@ func (db *DB) WithTx(cb func(tx *gorm.DB)) {
 	defer tx.Commit()
 	cb(tx)
 }
+
+// SetMusicFolders makes the music_folders table match the configured
+// folders, and sets the ID of each. folders are matched by their cleaned
+// location, so a renamed folder keeps its albums. folders no longer
+// configured are deleted if they have no albums. ones which still have
+// albums are kept until they're deleted with DeleteMusicFolder, since their
+// location may have only been mistyped. albums scanned before there were
+// music folders are given to the first one
+func (db *DB) SetMusicFolders(folders []*MusicFolder) error {
+	var existing []*MusicFolder
+	if err := db.Find(&existing).Error; err != nil {
+		return errors.Wrap(err, "finding music folders")
+	}
+	byLocation := make(map[string]*MusicFolder, len(existing))
+	for _, folder := range existing {
+		location := cleanLocation(folder.Location)
+		if _, ok := byLocation[location]; ok && folder.Location != location {
+			continue
+		}
+		byLocation[location] = folder
+	}
+	tx := db.Begin()
+	configured := make(map[int]struct{}, len(folders))
+	for _, folder := range folders {
+		folder.Location = cleanLocation(folder.Location)
+		if prev, ok := byLocation[folder.Location]; ok {
+			folder.ID = prev.ID
+		}
+		if err := tx.Save(folder).Error; err != nil {
+			tx.Rollback()
+			return errors.Wrapf(err, "saving music folder %q", folder.Location)
+		}
+		configured[folder.ID] = struct{}{}
+	}
+	for _, folder := range existing {
+		if _, ok := configured[folder.ID]; ok {
+			continue
+		}
+		var albums int
+		tx.
+			Model(Album{}).
+			Where("music_folder_id=?", folder.ID).
+			Count(&albums)
+		if albums > 0 {
+			// it may have been stored with a user and password before
+			if location := cleanLocation(folder.Location); byLocation[location] == folder {
+				folder.Location = location
+				tx.
+					Model(folder).
+					UpdateColumn("location", location)
+			}
+			log.Printf("music folder %q (%s) isn't configured, but still has %d albums. it's kept until it's deleted from the admin page\n",
+				folder.Name, folder.Location, albums)
+			continue
+		}
+		if err := deleteMusicFolder(tx, folder.ID); err != nil {
+			tx.Rollback()
+			return errors.Wrapf(err, "deleting old music folder %q", folder.Location)
+		}
+	}
+	if len(folders) > 0 {
+		err := tx.
+			Model(Album{}).
+			Where("music_folder_id IS NULL").
+			Update("music_folder_id", folders[0].ID).
+			Error
+		if err != nil {
+			tx.Rollback()
+			return errors.Wrap(err, "adopting albums")
+		}
+	}
+	return tx.Commit().Error
+}
+
+// DeleteMusicFolder deletes a music folder, with its albums and everything
+// which belongs to them
+func (db *DB) DeleteMusicFolder(id int) error {
+	tx := db.Begin()
+	if err := deleteMusicFolder(tx, id); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit().Error
+}
+
+// deleteMusicFolder deletes the rows of a music folder one table at a time,
+// since sqlite doesn't cascade deletes without the foreign_keys pragma
+func deleteMusicFolder(tx *gorm.DB, id int) error {
+	const (
+		albums = "SELECT id FROM albums WHERE music_folder_id=?"
+		tracks = "SELECT id FROM tracks WHERE album_id IN (" + albums + ")"
+	)
+	steps := []struct {
+		model interface{}
+		where string
+	}{
+		{Lyrics{}, "track_id IN (" + tracks + ")"},
+		{TrackGenre{}, "track_id IN (" + tracks + ")"},
+		{Track{}, "album_id IN (" + albums + ")"},
+		{AlbumArtist{}, "album_id IN (" + albums + ")"},
+		{AlbumGenre{}, "album_id IN (" + albums + ")"},
+		{Play{}, "album_id IN (" + albums + ")"},
+		{Album{}, "music_folder_id=?"},
+		{ScanError{}, "music_folder_id=?"},
+		{MusicFolder{}, "id=?"},
+	}
+	for _, step := range steps {
+		if err := tx.Where(step.where, id).Delete(step.model).Error; err != nil {
+			return errors.Wrapf(err, "deleting from %s", tx.NewScope(step.model).TableName())
+		}
+	}
+	return nil
+}
+
+// cleanLocation is how a music folder's location is stored and matched, so
+// that eg. "/music/" and "/music" are the same folder. remote locations are
+// URLs, whose scheme and host aren't case sensitive. their user and
+// password are left out, since the location is shown on the admin page
+func cleanLocation(location string) string {
+	u, err := url.Parse(location)
+	if err != nil || !strings.Contains(location, "://") {
+		return filepath.Clean(location)
+	}
+	u.User = nil
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimSuffix(path.Clean("/"+u.Path), "/")
+	u.RawPath = ""
+	return u.String()
+}
@@ -140,3 +140,210 @@ var migrationUpdateTranscodePrefIDX = gormigrate.Migration{
 		return nil
 	},
 }
+
+var migrationAddMusicFolders = gormigrate.Migration{
+	ID: "202004201200",
+	Migrate: func(tx *gorm.DB) error {
+		step := tx.AutoMigrate(
+			MusicFolder{},
+		)
+		if err := step.Error; err != nil {
+			return fmt.Errorf("step create: %w", err)
+		}
+		// paths are only unique within a music folder now, since every
+		// folder has its own root and tree
+		step = tx.Exec(`
+			DROP INDEX IF EXISTS idx_left_path_right_path;
+		`)
+		if err := step.Error; err != nil {
+			return fmt.Errorf("step drop index: %w", err)
+		}
+		step = tx.AutoMigrate(
+			Album{},
+		)
+		if err := step.Error; err != nil {
+			return fmt.Errorf("step add column: %w", err)
+		}
+		return nil
+	},
+}
+
+var migrationAddScanErrors = gormigrate.Migration{
+	ID: "202005011200",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(
+			ScanError{},
+		).
+			Error
+	},
+}
+
+var migrationAddMultiValueTags = gormigrate.Migration{
+	ID: "202005081200",
+	Migrate: func(tx *gorm.DB) error {
+		step := tx.AutoMigrate(
+			AlbumArtist{},
+			AlbumGenre{},
+			TrackGenre{},
+		)
+		if err := step.Error; err != nil {
+			return fmt.Errorf("step create: %w", err)
+		}
+		// everything has the one artist or genre it had before, until it's
+		// scanned again
+		step = tx.Exec(`
+			INSERT INTO album_artists (album_id, artist_id)
+				SELECT id, tag_artist_id
+				FROM albums
+				WHERE tag_artist_id IS NOT NULL;
+		`)
+		if err := step.Error; err != nil {
+			return fmt.Errorf("step copy album artists: %w", err)
+		}
+		step = tx.Exec(`
+			INSERT INTO album_genres (album_id, genre_id)
+				SELECT id, tag_genre_id
+				FROM albums
+				WHERE tag_genre_id IS NOT NULL;
+		`)
+		if err := step.Error; err != nil {
+			return fmt.Errorf("step copy album genres: %w", err)
+		}
+		step = tx.Exec(`
+			INSERT INTO track_genres (track_id, genre_id)
+				SELECT id, tag_genre_id
+				FROM tracks
+				WHERE tag_genre_id IS NOT NULL;
+		`)
+		if err := step.Error; err != nil {
+			return fmt.Errorf("step copy track genres: %w", err)
+		}
+		return nil
+	},
+}
+
+var migrationAddEmbeddedCovers = gormigrate.Migration{
+	ID: "202005151200",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(
+			Album{},
+			Track{},
+		).
+			Error
+	},
+}
+
+var migrationAddReplayGain = gormigrate.Migration{
+	ID: "202005221200",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(
+			Album{},
+			Track{},
+		).
+			Error
+	},
+}
+
+var migrationAddCueTracks = gormigrate.Migration{
+	ID: "202005291200",
+	Migrate: func(tx *gorm.DB) error {
+		// a file can be more than one track now, so the cue track is
+		// part of the unique index of tracks
+		step := tx.Exec(`
+			DROP INDEX IF EXISTS idx_folder_filename;
+		`)
+		if err := step.Error; err != nil {
+			return fmt.Errorf("step drop index: %w", err)
+		}
+		step = tx.AutoMigrate(
+			Track{},
+		)
+		if err := step.Error; err != nil {
+			return fmt.Errorf("step add column: %w", err)
+		}
+		return nil
+	},
+}
+
+var migrationAddLyrics = gormigrate.Migration{
+	ID: "202006051200",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(
+			Lyrics{},
+		).
+			Error
+	},
+}
+
+var migrationAddExtendedTags = gormigrate.Migration{
+	ID: "202006121200",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(
+			Album{},
+			Track{},
+		).
+			Error
+	},
+}
+
+var migrationAddTagAlbums = gormigrate.Migration{
+	ID: "202006191200",
+	Migrate: func(tx *gorm.DB) error {
+		// the index is on what the scanner groups folders into albums by
+		step := tx.AutoMigrate(
+			Album{},
+		)
+		if err := step.Error; err != nil {
+			return fmt.Errorf("step add column and index: %w", err)
+		}
+		// group the folders like the scanner does, apart from finding the
+		// copies, which it does when they're next scanned
+		step = tx.Exec(`
+			UPDATE albums
+			SET tag_album_id=( SELECT MIN(other.id) FROM albums other
+			                   WHERE COALESCE(other.music_folder_id, 0)=COALESCE(albums.music_folder_id, 0)
+			                   AND other.tag_artist_id=albums.tag_artist_id
+			                   AND other.tag_title=albums.tag_title
+			                   AND COALESCE(other.tag_brainz_id, '')=COALESCE(albums.tag_brainz_id, '')
+			                   AND ( COALESCE(albums.tag_brainz_id, '')!=''
+			                         OR COALESCE(other.parent_id, 0)=COALESCE(albums.parent_id, 0) )
+			)
+			WHERE tag_artist_id IS NOT NULL
+			AND tag_title IS NOT NULL AND tag_title!='';
+		`)
+		if err := step.Error; err != nil {
+			return fmt.Errorf("step group tag albums: %w", err)
+		}
+		step = tx.Exec(`
+			UPDATE albums
+			SET tag_album_id=id
+			WHERE tag_artist_id IS NOT NULL
+			AND (tag_title IS NULL OR tag_title='');
+		`)
+		if err := step.Error; err != nil {
+			return fmt.Errorf("step set untitled tag albums: %w", err)
+		}
+		return nil
+	},
+}
+
+var migrationAddCompilations = gormigrate.Migration{
+	ID: "202006261200",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(
+			Album{},
+		).
+			Error
+	},
+}
+
+var migrationAddContentHashes = gormigrate.Migration{
+	ID: "202007031200",
+	Migrate: func(tx *gorm.DB) error {
+		// the tracks are hashed when they're next scanned
+		return tx.AutoMigrate(
+			Track{},
+		).
+			Error
+	},
+}
@@ -79,6 +79,33 @@ type Track struct {
 	TagGenre       *Genre
 	TagGenreID     int    `sql:"default: null; type:int REFERENCES genres(id) ON DELETE CASCADE"`
 	TagBrainzID    string `sql:"default: null"`
+	EmbeddedCover  bool   `sql:"default: null"`
+
+	// replaygain gain in dB and linear peak, from the tags or measured by
+	// the loudness analysis. measured ones are kept when the track is
+	// scanned again without tags
+	ReplayGainTrackGain *float64 `sql:"default: null"`
+	ReplayGainTrackPeak *float64 `sql:"default: null"`
+	ReplayGainAnalysed  bool     `sql:"default: null"`
+
+	// tracks from a cue sheet are the part of their file from CueStart to
+	// CueEnd, in milliseconds. CueEnd is zero for the end of the file.
+	// CueTrack is zero for tracks which are whole files
+	CueTrack int `gorm:"not null; default: 0; unique_index:idx_folder_filename"`
+	CueStart int `sql:"default: null"`
+	CueEnd   int `sql:"default: null"`
+
+	// more tags, which are shown to clients but aren't browsed by
+	TagComposer   string `sql:"default: null"`
+	TagConductor  string `sql:"default: null"`
+	TagComment    string `sql:"default: null"`
+	TagBPM        int    `sql:"default: null"`
+	TagArtistSort string `sql:"default: null"`
+
+	// ContentHash is a hash of the file's size and the end of it, which
+	// is audio for most formats. it's how the track is found again if the
+	// file is moved or renamed, along with the musicbrainz id and tags
+	ContentHash string `sql:"default: null" gorm:"index"`
 }
 
 func (t *Track) Ext() string {
@@ -129,28 +156,87 @@ type Play struct {
 	Count   int
 }
 
+type MusicFolder struct {
+	ID       int    `gorm:"primary_key"`
+	Name     string `gorm:"not null" sql:"default: null"`
+	Location string `gorm:"not null; unique_index" sql:"default: null"`
+}
+
 type Album struct {
 	ID            int `gorm:"primary_key"`
 	UpdatedAt     time.Time
 	ModifiedAt    time.Time
-	LeftPath      string `gorm:"unique_index:idx_left_path_right_path"`
-	RightPath     string `gorm:"not null; unique_index:idx_left_path_right_path" sql:"default: null"`
+	MusicFolder   *MusicFolder
+	MusicFolderID int    `gorm:"unique_index:idx_music_folder_left_path_right_path; index:idx_tag_album_group" sql:"default: null"`
+	LeftPath      string `gorm:"unique_index:idx_music_folder_left_path_right_path"`
+	RightPath     string `gorm:"not null; unique_index:idx_music_folder_left_path_right_path" sql:"default: null"`
 	RightPathUDec string `sql:"default: null"`
 	Parent        *Album
 	ParentID      int    `sql:"default: null; type:int REFERENCES albums(id) ON DELETE CASCADE"`
 	Cover         string `sql:"default: null"`
+	CoverTrackID  int    `sql:"default: null"`
 	TagArtist     *Artist
-	TagArtistID   int `sql:"default: null; type:int REFERENCES artists(id) ON DELETE CASCADE"`
+	TagArtistID   int `gorm:"index:idx_tag_album_group" sql:"default: null; type:int REFERENCES artists(id) ON DELETE CASCADE"`
 	TagGenre      *Genre
 	TagGenreID    int    `sql:"default: null; type:int REFERENCES genres(id) ON DELETE CASCADE"`
-	TagTitle      string `sql:"default: null"`
+	TagTitle      string `gorm:"index:idx_tag_album_group" sql:"default: null"`
 	TagTitleUDec  string `sql:"default: null"`
-	TagBrainzID   string `sql:"default: null"`
+	TagBrainzID   string `gorm:"index:idx_tag_album_group" sql:"default: null"`
 	TagYear       int    `sql:"default: null"`
 	Tracks        []*Track
 	ChildCount    int  `sql:"-"`
 	ReceivedPaths bool `gorm:"-"`
 	ReceivedTags  bool `gorm:"-"`
+	SavedTracks   bool `gorm:"-"`
+	// ReceivedAlbumArtist is whether the album artist was tagged, rather
+	// than taken from the track artist
+	ReceivedAlbumArtist bool `gorm:"-"`
+
+	// like the track's, from the tags of the first track or measured
+	ReplayGainAlbumGain *float64 `sql:"default: null"`
+	ReplayGainAlbumPeak *float64 `sql:"default: null"`
+	ReplayGainAnalysed  bool     `sql:"default: null"`
+
+	// more tags from the first track. the sort ones are used to order
+	// album lists. TagOriginalDate is as it's tagged, eg. "1973-03-01" or
+	// just a year, and TagReleaseTypes are lowercase and separated by "; "
+	TagTitleSort     string `sql:"default: null"`
+	TagArtistSort    string `sql:"default: null"`
+	TagLabel         string `sql:"default: null"`
+	TagCatalogNumber string `sql:"default: null"`
+	TagOriginalDate  string `sql:"default: null"`
+	TagOriginalYear  int    `sql:"default: null"`
+	TagReleaseTypes  string `sql:"default: null"`
+
+	// TagAlbumID is the album that the folder is part of when browsing by
+	// tags. it's the first of the folders of the album, so that an album
+	// with a folder per disc is one album. it's usually the folder itself.
+	// see the scanner's groupAlbums
+	TagAlbumID int `sql:"default: null" gorm:"index"`
+
+	// TagCompilation is whether the album is a compilation, either flagged
+	// by any of its tracks' tags or found from its tracks having different
+	// artists. compilations without an album artist tag are filed under
+	// the various artists artist
+	TagCompilation bool `sql:"default: null"`
+}
+
+// ReleaseTypes are the album's types, eg. "album" and "live"
+func (a *Album) ReleaseTypes() []string {
+	if a.TagReleaseTypes == "" {
+		return nil
+	}
+	return strings.Split(a.TagReleaseTypes, "; ")
+}
+
+// RelPath is the path of the folder in its music folder
+func (a *Album) RelPath() string {
+	return path.Join(a.LeftPath, a.RightPath)
+}
+
+// HasCover is whether the album has a cover file or an embedded picture
+func (a *Album) HasCover() bool {
+	return a.Cover != "" || a.CoverTrackID != 0
 }
 
 func (a *Album) IndexRightPath() string {
@@ -207,3 +293,58 @@ type TranscodePreference struct {
 	Client  string `gorm:"not null; unique_index:idx_user_id_client" sql:"default: null"`
 	Profile string `gorm:"not null" sql:"default: null"`
 }
+
+// AlbumArtist, AlbumGenre, and TrackGenre are the artists and genres an
+// album or track is listed under. there can be more than one of each, from
+// multi-valued tags. the first is also kept in TagArtistID or TagGenreID
+type AlbumArtist struct {
+	Album    *Album
+	AlbumID  int `gorm:"not null; primary_key; auto_increment:false" sql:"default: null; type:int REFERENCES albums(id) ON DELETE CASCADE"`
+	Artist   *Artist
+	ArtistID int `gorm:"not null; primary_key; auto_increment:false; index" sql:"default: null; type:int REFERENCES artists(id) ON DELETE CASCADE"`
+}
+
+type AlbumGenre struct {
+	Album   *Album
+	AlbumID int `gorm:"not null; primary_key; auto_increment:false" sql:"default: null; type:int REFERENCES albums(id) ON DELETE CASCADE"`
+	Genre   *Genre
+	GenreID int `gorm:"not null; primary_key; auto_increment:false; index" sql:"default: null; type:int REFERENCES genres(id) ON DELETE CASCADE"`
+}
+
+type TrackGenre struct {
+	Track   *Track
+	TrackID int `gorm:"not null; primary_key; auto_increment:false" sql:"default: null; type:int REFERENCES tracks(id) ON DELETE CASCADE"`
+	Genre   *Genre
+	GenreID int `gorm:"not null; primary_key; auto_increment:false; index" sql:"default: null; type:int REFERENCES genres(id) ON DELETE CASCADE"`
+}
+
+// Lyrics are the lyrics of a track, from its tags or from a .lrc file with
+// the same name. Text is as it was found, with any timestamps
+type Lyrics struct {
+	ID        int `gorm:"primary_key"`
+	UpdatedAt time.Time
+	Track     *Track
+	TrackID   int    `gorm:"not null; index" sql:"default: null; type:int REFERENCES tracks(id) ON DELETE CASCADE"`
+	Source    string `gorm:"not null" sql:"default: null"`
+	Text      string `gorm:"not null" sql:"default: null"`
+}
+
+// sources of Lyrics
+const (
+	LyricsSourceTags = "tags"
+	LyricsSourceLRC  = "lrc"
+)
+
+// ScanError is something which went wrong in a scan, eg. a track whose tags
+// couldn't be read. ScanID is the unix nano time the scan started at, so
+// later scans have bigger ones
+type ScanError struct {
+	ID            int `gorm:"primary_key"`
+	CreatedAt     time.Time
+	ScanID        int64 `gorm:"not null; index" sql:"default: null"`
+	MusicFolder   *MusicFolder
+	MusicFolderID int    `gorm:"not null" sql:"default: null; type:int REFERENCES music_folders(id) ON DELETE CASCADE"`
+	Path          string `gorm:"not null" sql:"default: null"`
+	Stage         string `gorm:"not null" sql:"default: null"`
+	Error         string `gorm:"not null" sql:"default: null"`
+}
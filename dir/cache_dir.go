@@ -0,0 +1,413 @@
+package dir
+
+import (
+	"container/list"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	cacheMetaExt = ".json"
+	cacheTempExt = ".tmp"
+	// cacheMaxEntryFraction keeps single big files (eg. audiobooks) from
+	// pushing everything else out. files bigger than the budget divided by
+	// this are always read from the wrapped Dir
+	cacheMaxEntryFraction = 4
+)
+
+// cacheEntry is a file we have a copy of. it's saved next to the copy,
+// so the cache survives restarts
+type cacheEntry struct {
+	key     string
+	Path    string    `json:"path"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+	ETag    string    `json:"etag"`
+}
+
+func (e *cacheEntry) matches(info FileInfo) bool {
+	return e.Size == info.Size &&
+		e.ModTime.Equal(info.ModTime) &&
+		e.ETag == info.ETag
+}
+
+// CacheStats is a snapshot of what a CacheDir is holding
+type CacheStats struct {
+	Entries  int
+	Bytes    int64
+	MaxBytes int64
+	Hits     int
+	Misses   int
+}
+
+// CacheDir wraps another Dir, and keeps copies of the files that are read
+// from it on disk. it is meant for remote Dirs, where streaming the same
+// track again would mean fetching it again. copies are checked against the
+// file's size, modification time, and ETag before they're used, and the
+// least recently used ones are removed when the cache is over its budget.
+// everything other than GetFile goes straight to the wrapped Dir
+type CacheDir struct {
+	Dir
+	path     string
+	maxBytes int64
+	mu       sync.Mutex
+	lru      *list.List               // of *cacheEntry, most recent first
+	entries  map[string]*list.Element // key -> element in lru
+	bytes    int64
+	fetching map[string]struct{} // keys being copied into the cache
+	hits     int
+	misses   int
+}
+
+// NewCacheDir wraps inner with a cache at path, which will hold up to
+// maxBytes of files. copies left in path from before are kept
+func NewCacheDir(inner Dir, path string, maxBytes int64) (*CacheDir, error) {
+	if maxBytes <= 0 {
+		return nil, errors.New("cache needs a size")
+	}
+	if err := os.MkdirAll(path, os.ModePerm); err != nil {
+		return nil, errors.Wrap(err, "creating cache dir")
+	}
+	c := &CacheDir{
+		Dir:      inner,
+		path:     path,
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  map[string]*list.Element{},
+		fetching: map[string]struct{}{},
+	}
+	if err := c.load(); err != nil {
+		return nil, errors.Wrap(err, "loading cache")
+	}
+	return c, nil
+}
+
+// load reads back the entries in the cache path, ordered by when they were
+// last used. anything that isn't a complete entry is removed
+func (c *CacheDir) load() error {
+	infos, err := ioutil.ReadDir(c.path)
+	if err != nil {
+		return err
+	}
+	type loaded struct {
+		entry    *cacheEntry
+		lastUsed time.Time
+	}
+	var found []loaded
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || !strings.HasSuffix(name, cacheMetaExt) {
+			continue
+		}
+		key := strings.TrimSuffix(name, cacheMetaExt)
+		entry, err := c.readMeta(key)
+		if err != nil {
+			log.Printf("removing bad cache entry `%s`: %v\n", key, err)
+			c.remove(key)
+			continue
+		}
+		dataInfo, err := os.Stat(c.dataPath(key))
+		if err != nil || dataInfo.Size() != entry.Size {
+			c.remove(key)
+			continue
+		}
+		found = append(found, loaded{entry, dataInfo.ModTime()})
+	}
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].lastUsed.After(found[j].lastUsed)
+	})
+	for _, l := range found {
+		c.entries[l.entry.key] = c.lru.PushBack(l.entry)
+		c.bytes += l.entry.Size
+	}
+	// clean up the copies which don't have meta-data, or were half written
+	for _, info := range infos {
+		name := info.Name()
+		key := strings.TrimSuffix(name, cacheMetaExt)
+		if _, ok := c.entries[key]; !ok || strings.HasSuffix(name, cacheTempExt) {
+			os.Remove(filepath.Join(c.path, name))
+		}
+	}
+	c.evict()
+	return nil
+}
+
+func (c *CacheDir) readMeta(key string) (*cacheEntry, error) {
+	data, err := ioutil.ReadFile(c.metaPath(key))
+	if err != nil {
+		return nil, err
+	}
+	entry := &cacheEntry{key: key}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func cacheKey(path string) string {
+	sum := sha1.Sum([]byte(path))
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *CacheDir) dataPath(key string) string {
+	return filepath.Join(c.path, key)
+}
+
+func (c *CacheDir) metaPath(key string) string {
+	return filepath.Join(c.path, key+cacheMetaExt)
+}
+
+func (c *CacheDir) remove(key string) {
+	os.Remove(c.metaPath(key))
+	os.Remove(c.dataPath(key))
+}
+
+// evict removes the least recently used entries until we're in budget.
+// it must be called with mu held
+func (c *CacheDir) evict() {
+	for c.bytes > c.maxBytes {
+		el := c.lru.Back()
+		if el == nil {
+			return
+		}
+		c.drop(el)
+	}
+}
+
+// drop removes an entry. it must be called with mu held
+func (c *CacheDir) drop(el *list.Element) {
+	entry := c.lru.Remove(el).(*cacheEntry)
+	delete(c.entries, entry.key)
+	c.bytes -= entry.Size
+	c.remove(entry.key)
+}
+
+func (c *CacheDir) GetFile(path string) (time.Time, ReadSeekCloser, error) {
+	info, err := c.Dir.Stat(path)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if info.Size > c.maxBytes/cacheMaxEntryFraction {
+		return c.Dir.GetFile(path)
+	}
+	key := cacheKey(path)
+	file, err := c.open(key, path, info)
+	if err == nil {
+		return info.ModTime, file, nil
+	}
+	log.Printf("error caching `%s`, reading it directly: %v\n", path, err)
+	return c.Dir.GetFile(path)
+}
+
+// open returns our copy of the file. if we don't have it, or if it's out
+// of date, it's read from the wrapped Dir and copied into the cache as
+// it's read
+func (c *CacheDir) open(key, path string, info FileInfo) (ReadSeekCloser, error) {
+	c.mu.Lock()
+	if el, ok := c.entries[key]; ok {
+		entry := el.Value.(*cacheEntry)
+		if entry.matches(info) {
+			c.lru.MoveToFront(el)
+			c.hits++
+			c.mu.Unlock()
+			// the data file's mod time is when it was last used, for
+			// loading the cache back in the right order
+			now := time.Now()
+			_ = os.Chtimes(c.dataPath(key), now, now)
+			return os.Open(c.dataPath(key))
+		}
+		// it changed since we fetched it
+		c.drop(el)
+	}
+	c.misses++
+	if _, ok := c.fetching[key]; ok {
+		// someone else is copying it, so this reader doesn't need to
+		c.mu.Unlock()
+		_, reader, err := c.Dir.GetFile(path)
+		return reader, err
+	}
+	c.fetching[key] = struct{}{}
+	c.mu.Unlock()
+
+	fill, err := c.startFill(key, path, info)
+	if err != nil {
+		c.mu.Lock()
+		delete(c.fetching, key)
+		c.mu.Unlock()
+		return nil, err
+	}
+	return fill, nil
+}
+
+// cacheFill reads a file from the wrapped Dir, and copies what's read
+// into the cache as it goes, so the caller doesn't wait for the whole
+// file. what the caller skipped or didn't read is fetched when it's closed
+type cacheFill struct {
+	c       *CacheDir
+	entry   *cacheEntry
+	reader  ReadSeekCloser
+	tmp     *os.File
+	pos     int64 // in reader
+	written int64 // to tmp, from the start of the file
+	err     error // from writing to tmp, after which the copy is given up
+}
+
+func (c *CacheDir) startFill(key, path string, info FileInfo) (*cacheFill, error) {
+	_, reader, err := c.Dir.GetFile(path)
+	if err != nil {
+		return nil, err
+	}
+	tmp, err := os.Create(c.dataPath(key) + cacheTempExt)
+	if err != nil {
+		reader.Close()
+		return nil, errors.Wrap(err, "creating cache file")
+	}
+	return &cacheFill{
+		c: c,
+		entry: &cacheEntry{
+			key:     key,
+			Path:    path,
+			Size:    info.Size,
+			ModTime: info.ModTime,
+			ETag:    info.ETag,
+		},
+		reader: reader,
+		tmp:    tmp,
+	}, nil
+}
+
+func (f *cacheFill) Read(p []byte) (int, error) {
+	n, err := f.reader.Read(p)
+	if n > 0 && f.err == nil && f.pos == f.written {
+		_, f.err = f.tmp.Write(p[:n])
+		f.written += int64(n)
+	}
+	f.pos += int64(n)
+	return n, err
+}
+
+func (f *cacheFill) Seek(offset int64, whence int) (int64, error) {
+	pos, err := f.reader.Seek(offset, whence)
+	if err != nil {
+		return 0, err
+	}
+	f.pos = pos
+	return pos, nil
+}
+
+// Close closes the wrapped reader and finishes the copy. if the caller
+// read all of the file, that's quick. otherwise the rest of it is fetched
+// in the background
+func (f *cacheFill) Close() error {
+	err := f.reader.Close()
+	if f.err != nil || f.written >= f.entry.Size {
+		f.finish()
+	} else {
+		go f.finish()
+	}
+	return err
+}
+
+// finish adds the copy to the cache if it could be completed
+func (f *cacheFill) finish() {
+	err := f.complete()
+	c := f.c
+	c.mu.Lock()
+	delete(c.fetching, f.entry.key)
+	if err == nil {
+		c.entries[f.entry.key] = c.lru.PushFront(f.entry)
+		c.bytes += f.entry.Size
+		c.evict()
+	}
+	c.mu.Unlock()
+	if err != nil {
+		log.Printf("error caching `%s`: %v\n", f.entry.Path, err)
+	}
+}
+
+// complete fetches what wasn't read into the copy, and moves it into place
+// with its meta-data
+func (f *cacheFill) complete() error {
+	key := f.entry.key
+	tmpPath := f.c.dataPath(key) + cacheTempExt
+	err := f.err
+	if err == nil && f.written < f.entry.Size {
+		err = f.fetchRest()
+	}
+	if closeErr := f.tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && f.written != f.entry.Size {
+		err = errors.Errorf("expected %d bytes, got %d", f.entry.Size, f.written)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return errors.Wrap(err, "copying to cache")
+	}
+	meta, err := json.Marshal(f.entry)
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := ioutil.WriteFile(f.c.metaPath(key), meta, 0644); err != nil {
+		os.Remove(tmpPath)
+		return errors.Wrap(err, "writing cache meta-data")
+	}
+	if err := os.Rename(tmpPath, f.c.dataPath(key)); err != nil {
+		f.c.remove(key)
+		return errors.Wrap(err, "moving cache file")
+	}
+	return nil
+}
+
+// fetchRest copies the part of the file after what was read into the copy
+func (f *cacheFill) fetchRest() error {
+	_, reader, err := f.c.Dir.GetFile(f.entry.Path)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	if _, err := reader.Seek(f.written, io.SeekStart); err != nil {
+		return err
+	}
+	n, err := io.Copy(f.tmp, reader)
+	f.written += n
+	return err
+}
+
+// Stats returns how full the cache is, and how well it's doing
+func (c *CacheDir) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStats{
+		Entries:  c.lru.Len(),
+		Bytes:    c.bytes,
+		MaxBytes: c.maxBytes,
+		Hits:     c.hits,
+		Misses:   c.misses,
+	}
+}
+
+// Clear removes every entry from the cache. files being fetched right now
+// are added when they're done
+func (c *CacheDir) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for el := c.lru.Front(); el != nil; el = c.lru.Front() {
+		c.drop(el)
+	}
+	c.hits = 0
+	c.misses = 0
+}
@@ -0,0 +1,195 @@
+package dir
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+// newTestCacheDir makes a cache dir over inner in a new temp dir. callers
+// remove cacheDir.path when they're done
+func newTestCacheDir(t *testing.T, inner Dir, maxBytes int64) *CacheDir {
+	t.Helper()
+	path, err := ioutil.TempDir("", "gonic-cache")
+	if err != nil {
+		t.Fatalf("making temp dir: %v", err)
+	}
+	cacheDir, err := NewCacheDir(inner, path, maxBytes)
+	if err != nil {
+		os.RemoveAll(path)
+		t.Fatalf("creating cache dir: %v", err)
+	}
+	return cacheDir
+}
+
+func readCached(t *testing.T, cacheDir *CacheDir, path string) []byte {
+	t.Helper()
+	_, reader, err := cacheDir.GetFile(path)
+	if err != nil {
+		t.Fatalf("getting file: %v", err)
+	}
+	defer reader.Close()
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("reading file: %v", err)
+	}
+	return data
+}
+
+func TestCacheDirReadThrough(t *testing.T) {
+	data := testData(100 * 1024)
+	fake := newFakeS3("music", map[string][]byte{"a/track.flac": data})
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{})
+	defer closeServer()
+	cacheDir := newTestCacheDir(t, s3dir, 1024*1024)
+	defer os.RemoveAll(cacheDir.path)
+	for i := 0; i < 3; i++ {
+		if got := readCached(t, cacheDir, "a/track.flac"); !bytes.Equal(got, data) {
+			t.Fatalf("read %d doesn't match", i)
+		}
+	}
+	if fake.counts().bytesSent != len(data) {
+		t.Errorf("expected the track to be fetched once, %d bytes were sent", fake.counts().bytesSent)
+	}
+	stats := cacheDir.Stats()
+	if stats.Entries != 1 || stats.Bytes != int64(len(data)) {
+		t.Errorf("expected 1 entry of %d bytes, got %+v", len(data), stats)
+	}
+	if stats.Hits != 2 || stats.Misses != 1 {
+		t.Errorf("expected 2 hits and 1 miss, got %+v", stats)
+	}
+}
+
+func TestCacheDirFillsWhatWasntRead(t *testing.T) {
+	data := testData(100 * 1024)
+	fake := newFakeS3("music", map[string][]byte{"a/track.flac": data})
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{})
+	defer closeServer()
+	cacheDir := newTestCacheDir(t, s3dir, 1024*1024)
+	defer os.RemoveAll(cacheDir.path)
+	// only the end is read, like a client seeking to a range
+	_, reader, err := cacheDir.GetFile("a/track.flac")
+	if err != nil {
+		t.Fatalf("getting file: %v", err)
+	}
+	if _, err := reader.Seek(int64(len(data)-10), io.SeekStart); err != nil {
+		t.Fatalf("seeking: %v", err)
+	}
+	end := make([]byte, 10)
+	if _, err := io.ReadFull(reader, end); err != nil {
+		t.Fatalf("reading: %v", err)
+	}
+	if !bytes.Equal(end, data[len(data)-10:]) {
+		t.Errorf("the end doesn't match")
+	}
+	reader.Close()
+	// the rest is fetched in the background
+	deadline := time.Now().Add(5 * time.Second)
+	for cacheDir.Stats().Entries == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the file to be cached")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	sent := fake.counts().bytesSent
+	if got := readCached(t, cacheDir, "a/track.flac"); !bytes.Equal(got, data) {
+		t.Errorf("cached copy doesn't match")
+	}
+	if fake.counts().bytesSent != sent {
+		t.Errorf("expected the cached copy to be read")
+	}
+}
+
+func TestCacheDirValidates(t *testing.T) {
+	fake := newFakeS3("music", map[string][]byte{"a/track.flac": []byte("old")})
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{})
+	defer closeServer()
+	cacheDir := newTestCacheDir(t, s3dir, 1024*1024)
+	defer os.RemoveAll(cacheDir.path)
+	readCached(t, cacheDir, "a/track.flac")
+	// same size and mod time, but a different etag
+	fake.Lock()
+	fake.objects["a/track.flac"] = []byte("new")
+	fake.Unlock()
+	if got := readCached(t, cacheDir, "a/track.flac"); string(got) != "new" {
+		t.Errorf("expected the changed object, got %q", got)
+	}
+	if stats := cacheDir.Stats(); stats.Entries != 1 || stats.Misses != 2 {
+		t.Errorf("expected the entry to be replaced, got %+v", stats)
+	}
+}
+
+func TestCacheDirEvicts(t *testing.T) {
+	// four of these fit in the budget
+	fake := newFakeS3("music", map[string][]byte{
+		"a.flac": testData(240),
+		"b.flac": testData(240),
+		"c.flac": testData(240),
+		"d.flac": testData(240),
+		"e.flac": testData(240),
+	})
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{})
+	defer closeServer()
+	cacheDir := newTestCacheDir(t, s3dir, 1000)
+	defer os.RemoveAll(cacheDir.path)
+	for _, path := range []string{"a.flac", "b.flac", "c.flac", "d.flac"} {
+		readCached(t, cacheDir, path)
+	}
+	readCached(t, cacheDir, "a.flac") // now b is the least recently used
+	readCached(t, cacheDir, "e.flac")
+	if stats := cacheDir.Stats(); stats.Entries != 4 || stats.Bytes != 960 {
+		t.Errorf("expected an entry to be evicted, got %+v", stats)
+	}
+	sent := fake.counts().bytesSent
+	for _, path := range []string{"a.flac", "c.flac", "d.flac", "e.flac"} {
+		readCached(t, cacheDir, path)
+	}
+	if fake.counts().bytesSent != sent {
+		t.Errorf("expected a, c, d, and e to still be cached")
+	}
+	readCached(t, cacheDir, "b.flac")
+	if fake.counts().bytesSent != sent+240 {
+		t.Errorf("expected b to be fetched again")
+	}
+}
+
+func TestCacheDirSkipsBigFiles(t *testing.T) {
+	fake := newFakeS3("music", map[string][]byte{"book.m4b": testData(500)})
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{})
+	defer closeServer()
+	cacheDir := newTestCacheDir(t, s3dir, 1000)
+	defer os.RemoveAll(cacheDir.path)
+	readCached(t, cacheDir, "book.m4b")
+	if stats := cacheDir.Stats(); stats.Entries != 0 {
+		t.Errorf("expected the file to be too big to cache, got %+v", stats)
+	}
+}
+
+func TestCacheDirReloadAndClear(t *testing.T) {
+	data := testData(1000)
+	fake := newFakeS3("music", map[string][]byte{"a.flac": data})
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{})
+	defer closeServer()
+	cacheDir := newTestCacheDir(t, s3dir, 1024*1024)
+	defer os.RemoveAll(cacheDir.path)
+	readCached(t, cacheDir, "a.flac")
+	// a new cache over the same path has the entry already
+	reloaded, err := NewCacheDir(s3dir, cacheDir.path, 1024*1024)
+	if err != nil {
+		t.Fatalf("reloading cache dir: %v", err)
+	}
+	if stats := reloaded.Stats(); stats.Entries != 1 || stats.Bytes != int64(len(data)) {
+		t.Errorf("expected the entry to be loaded, got %+v", stats)
+	}
+	reloaded.Clear()
+	if stats := reloaded.Stats(); stats.Entries != 0 || stats.Bytes != 0 {
+		t.Errorf("expected the cache to be empty, got %+v", stats)
+	}
+	files, _ := ioutil.ReadDir(cacheDir.path)
+	if len(files) != 0 {
+		t.Errorf("expected no files left, got %d", len(files))
+	}
+}
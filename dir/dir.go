@@ -2,9 +2,20 @@ package dir
 
 import (
 	"io"
+	"path/filepath"
 	"time"
 )
 
+// SkipDir can be returned by a WalkFunc called for a folder, to leave out
+// everything in it. the folder isn't listed, and the PostWalkFunc isn't
+// called for it
+var SkipDir = filepath.SkipDir
+
+// IgnoreFilename is the name of the files which list what to leave out of
+// walks, see IgnoreDir. Dirs pass them to the WalkFunc straight after the
+// folder they're in, before anything else in it
+const IgnoreFilename = ".gonicignore"
+
 type WalkFunc func(relPath string, fileSize int64, modTime time.Time, isDirectory bool) error
 type PostWalkFunc func(relPath string) error
 
@@ -14,8 +25,24 @@ type ReadSeekCloser interface {
 	io.Closer
 }
 
+// FileInfo is what a Dir can tell about a file without reading it
+type FileInfo struct {
+	Size    int64
+	ModTime time.Time
+	// ETag identifies the version of the file, if the Dir has them
+	ETag string
+}
+
 type Dir interface {
 	GetTypeName() string
 	Walk(Callback WalkFunc, PostChildrenCallback PostWalkFunc) error
+	// WalkPath is like Walk, but only visits the folder at relPath and
+	// what's under it
+	WalkPath(relPath string, Callback WalkFunc, PostChildrenCallback PostWalkFunc) error
 	GetFile(path string) (time.Time, ReadSeekCloser, error)
+	// Stat returns the size and version of the file at path
+	Stat(path string) (FileInfo, error)
+	// GetFileRange reads up to length bytes of the file at path starting at
+	// offset, without fetching the rest of it
+	GetFileRange(path string, offset, length int64) ([]byte, error)
 }
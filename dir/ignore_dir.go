@@ -0,0 +1,265 @@
+package dir
+
+import (
+	"log"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ignoreFileMaxSize is the most we read of an ignore file
+const ignoreFileMaxSize = 64 * 1024
+
+// ignorePattern is a line of an ignore file. they work like gitignore's
+type ignorePattern struct {
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// parseIgnorePattern compiles a gitignore style pattern. ok is false for
+// blank lines, comments, and patterns which don't compile
+func parseIgnorePattern(line string) (ignorePattern, bool) {
+	var p ignorePattern
+	line = strings.TrimRight(line, "\r")
+	// trailing spaces are ignored unless they're escaped
+	for strings.HasSuffix(line, " ") && !strings.HasSuffix(line, `\ `) {
+		line = line[:len(line)-1]
+	}
+	if line == "" || line[0] == '#' {
+		return p, false
+	}
+	switch {
+	case strings.HasPrefix(line, `\#`), strings.HasPrefix(line, `\!`):
+		line = line[1:]
+	case line[0] == '!':
+		p.negate = true
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if line == "" {
+		return p, false
+	}
+	// patterns with a slash before the end are relative to the ignore
+	// file's folder. others match at any depth
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+	var expr strings.Builder
+	expr.WriteString("^")
+	if !anchored {
+		expr.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(line); {
+		switch {
+		case strings.HasPrefix(line[i:], "**/"):
+			expr.WriteString("(?:.*/)?")
+			i += 3
+		case strings.HasPrefix(line[i:], "**"):
+			expr.WriteString(".*")
+			i += 2
+		case line[i] == '*':
+			expr.WriteString("[^/]*")
+			i++
+		case line[i] == '?':
+			expr.WriteString("[^/]")
+			i++
+		case line[i] == '[':
+			end := strings.IndexByte(line[i+1:], ']')
+			if end == -1 {
+				expr.WriteString(regexp.QuoteMeta("["))
+				i++
+				continue
+			}
+			class := line[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr.WriteString("[" + strings.Replace(class, `\`, `\\`, -1) + "]")
+			i += end + 2
+		case line[i] == '\\' && i+1 < len(line):
+			expr.WriteString(regexp.QuoteMeta(line[i+1 : i+2]))
+			i += 2
+		default:
+			expr.WriteString(regexp.QuoteMeta(line[i : i+1]))
+			i++
+		}
+	}
+	expr.WriteString("$")
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return p, false
+	}
+	p.re = re
+	return p, true
+}
+
+func parseIgnorePatterns(lines []string) []ignorePattern {
+	var patterns []ignorePattern
+	for _, line := range lines {
+		if p, ok := parseIgnorePattern(line); ok {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
+// ignoreRules are the patterns that apply under a folder
+type ignoreRules struct {
+	base     string
+	patterns []ignorePattern
+}
+
+// match returns whether relPath is ignored by the rules, and whether any
+// of them were about relPath at all
+func (r ignoreRules) match(relPath string, isDir bool) (ignored, matched bool) {
+	if r.base != "." {
+		relPath = strings.TrimPrefix(relPath, r.base+"/")
+	}
+	for _, p := range r.patterns {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		if p.re.MatchString(relPath) {
+			ignored, matched = !p.negate, true
+		}
+	}
+	return ignored, matched
+}
+
+// IgnoreDir wraps another Dir, and leaves what's excluded out of walks.
+// exclusions come from a global list of patterns, and from IgnoreFilename
+// files at any level of the tree. both are written like gitignore files,
+// and the global ones are relative to the root. like git, the deepest file
+// has the last word, and the last matching line in it wins. excluded
+// folders are skipped whole, so they're never listed. the ignore files
+// themselves are left out too
+type IgnoreDir struct {
+	Dir
+	global ignoreRules
+}
+
+func NewIgnoreDir(inner Dir, patterns []string) *IgnoreDir {
+	return &IgnoreDir{
+		Dir:    inner,
+		global: ignoreRules{base: ".", patterns: parseIgnorePatterns(patterns)},
+	}
+}
+
+// ignoreWalk is the state of a single walk. it has the rules of the
+// ignore files found so far, by the folder they're in
+type ignoreWalk struct {
+	id    *IgnoreDir
+	mu    sync.Mutex
+	rules map[string]ignoreRules
+}
+
+// ignored checks relPath against the global rules, then the rules of each
+// folder above it from the top down
+func (w *ignoreWalk) ignored(relPath string, isDir bool) bool {
+	ignored, _ := w.id.global.match(relPath, isDir)
+	var folders []string
+	for folder := path.Dir(relPath); ; folder = path.Dir(folder) {
+		folders = append(folders, folder)
+		if folder == "." {
+			break
+		}
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for i := len(folders) - 1; i >= 0; i-- {
+		rules, ok := w.rules[folders[i]]
+		if !ok {
+			continue
+		}
+		if folderIgnored, matched := rules.match(relPath, isDir); matched {
+			ignored = folderIgnored
+		}
+	}
+	return ignored
+}
+
+// load reads the ignore file at relPath
+func (w *ignoreWalk) load(relPath string, size int64) {
+	if size > ignoreFileMaxSize {
+		size = ignoreFileMaxSize
+	}
+	data, err := w.id.Dir.GetFileRange(relPath, 0, size)
+	if err != nil {
+		log.Printf("error reading ignore file `%s`: %v\n", relPath, err)
+		return
+	}
+	folder := path.Dir(relPath)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.rules[folder] = ignoreRules{
+		base:     folder,
+		patterns: parseIgnorePatterns(strings.Split(string(data), "\n")),
+	}
+}
+
+// loadAbove reads the ignore files of the folders above relPath, for walks
+// which start part way down the tree. it returns true if relPath, or one
+// of the folders above it, is ignored
+func (w *ignoreWalk) loadAbove(relPath string) bool {
+	if relPath == "." {
+		return false
+	}
+	parts := strings.Split(relPath, "/")
+	for i := range parts {
+		folder := path.Join(append([]string{"."}, parts[:i]...)...)
+		if folder != "." && w.ignored(folder, true) {
+			return true
+		}
+		ignorePath := path.Join(folder, IgnoreFilename)
+		if info, err := w.id.Dir.Stat(ignorePath); err == nil {
+			w.load(ignorePath, info.Size)
+		}
+	}
+	return w.ignored(relPath, true)
+}
+
+func (id *IgnoreDir) Walk(Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	return id.WalkPath(".", Callback, PostChildrenCallback)
+}
+
+func (id *IgnoreDir) WalkPath(relPath string, Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	relPath = path.Clean(relPath)
+	walk := &ignoreWalk{
+		id:    id,
+		rules: map[string]ignoreRules{},
+	}
+	if walk.loadAbove(relPath) {
+		log.Printf("not walking `%s`, it's ignored\n", relPath)
+		return nil
+	}
+	return id.Dir.WalkPath(relPath,
+		func(itemPath string, fileSize int64, modTime time.Time, isDirectory bool) error {
+			if !isDirectory && path.Base(itemPath) == IgnoreFilename {
+				walk.load(itemPath, fileSize)
+				return nil
+			}
+			if itemPath != relPath && walk.ignored(itemPath, isDirectory) {
+				if isDirectory {
+					return SkipDir
+				}
+				return nil
+			}
+			return Callback(itemPath, fileSize, modTime, isDirectory)
+		},
+		PostChildrenCallback,
+	)
+}
+
+// Watch watches the wrapped Dir, if it can be watched
+func (id *IgnoreDir) Watch(done <-chan struct{}, debounce time.Duration, fn WatchFunc) error {
+	watcher, ok := id.Dir.(Watcher)
+	if !ok {
+		return ErrWatchUnsupported
+	}
+	return watcher.Watch(done, debounce, fn)
+}
@@ -0,0 +1,187 @@
+package dir
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestIgnorePatterns(t *testing.T) {
+	cases := []struct {
+		pattern string
+		relPath string
+		isDir   bool
+		exp     bool
+	}{
+		{"*.nfo", "album.nfo", false, true},
+		{"*.nfo", "artist/album/album.nfo", false, true},
+		{"*.nfo", "album.nfo.flac", false, false},
+		{"@eaDir", "artist/@eaDir", true, true},
+		{"_incoming/", "_incoming", true, true},
+		{"_incoming/", "_incoming", false, false},
+		{"/top", "top", true, true},
+		{"/top", "artist/top", true, false},
+		{"artist/live", "artist/live", true, true},
+		{"artist/live", "other/artist/live", true, false},
+		{"**/live", "a/b/live", true, true},
+		{"**/live", "live", true, true},
+		{"a/**/b", "a/b", true, true},
+		{"a/**/b", "a/x/y/b", true, true},
+		{"a/**", "a/x/y", false, true},
+		{"a/**", "a", true, false},
+		{"track?.flac", "track1.flac", false, true},
+		{"track?.flac", "track10.flac", false, false},
+		{"disc[12]", "disc2", true, true},
+		{"disc[!12]", "disc2", true, false},
+		{"disc[!12]", "disc3", true, true},
+		{`\#1`, "#1", true, true},
+		{"#comment", "#comment", true, false},
+		{"*.log   ", "rip.log", false, true},
+	}
+	for _, tc := range cases {
+		rules := ignoreRules{base: ".", patterns: parseIgnorePatterns([]string{tc.pattern})}
+		if act, _ := rules.match(tc.relPath, tc.isDir); act != tc.exp {
+			t.Errorf("pattern %q on %q (dir %t): expected %t, got %t",
+				tc.pattern, tc.relPath, tc.isDir, tc.exp, act)
+		}
+	}
+}
+
+func writeTestFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root, err := ioutil.TempDir("", "gonic-ignore")
+	if err != nil {
+		t.Fatalf("making temp dir: %v", err)
+	}
+	for relPath, data := range files {
+		fullPath := filepath.Join(root, filepath.FromSlash(relPath))
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			t.Fatalf("making dir: %v", err)
+		}
+		if err := ioutil.WriteFile(fullPath, []byte(data), 0644); err != nil {
+			t.Fatalf("writing file: %v", err)
+		}
+	}
+	return root
+}
+
+// walkPaths walks d from relPath, and returns the sorted paths it was
+// called with
+func walkPaths(t *testing.T, d Dir, relPath string) []string {
+	t.Helper()
+	var paths []string
+	err := d.WalkPath(relPath,
+		func(relPath string, _ int64, _ time.Time, _ bool) error {
+			if path.Base(relPath) == IgnoreFilename {
+				t.Errorf("expected ignore files to be left out, got %q", relPath)
+			}
+			paths = append(paths, relPath)
+			return nil
+		},
+		func(string) error { return nil },
+	)
+	if err != nil {
+		t.Fatalf("walking: %v", err)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+var testIgnoreFiles = map[string]string{
+	IgnoreFilename:          "*.nfo\n# not the logs of this one\n!keep/*.nfo\n",
+	"a/01.flac":             "",
+	"a/album.nfo":           "",
+	"keep/album.nfo":        "",
+	"@eaDir/thumb.jpg":      "",
+	"_incoming/new/01.flac": "",
+	"b/" + IgnoreFilename:   "live/\n",
+	"b/live/01.flac":        "",
+	"b/studio/01.flac":      "",
+	"b/studio/live/01.flac": "",
+	"c/" + IgnoreFilename:   "!*.nfo\n",
+	"c/album.nfo":           "",
+	"c/d/" + IgnoreFilename: "01.flac\n",
+	"c/d/01.flac":           "",
+	"c/d/02.flac":           "",
+}
+
+func TestIgnoreDirWalk(t *testing.T) {
+	root := writeTestFiles(t, testIgnoreFiles)
+	defer os.RemoveAll(root)
+	localDir, err := NewLocalDir(root)
+	if err != nil {
+		t.Fatalf("making local dir: %v", err)
+	}
+	ignoreDir := NewIgnoreDir(localDir, []string{"@eaDir", "_incoming/"})
+	paths := walkPaths(t, ignoreDir, ".")
+	exp := []string{
+		".",
+		"a",
+		"a/01.flac",
+		"b",
+		"b/studio",
+		"b/studio/01.flac",
+		"c",
+		"c/album.nfo",
+		"c/d",
+		"c/d/02.flac",
+		"keep",
+		"keep/album.nfo",
+	}
+	if !reflect.DeepEqual(paths, exp) {
+		t.Errorf("expected\n%q\ngot\n%q", exp, paths)
+	}
+}
+
+func TestIgnoreDirWalkPath(t *testing.T) {
+	root := writeTestFiles(t, testIgnoreFiles)
+	defer os.RemoveAll(root)
+	localDir, err := NewLocalDir(root)
+	if err != nil {
+		t.Fatalf("making local dir: %v", err)
+	}
+	ignoreDir := NewIgnoreDir(localDir, []string{"_incoming/"})
+	// the rules of the folders above still apply
+	exp := []string{"c/d", "c/d/02.flac"}
+	if paths := walkPaths(t, ignoreDir, "c/d"); !reflect.DeepEqual(paths, exp) {
+		t.Errorf("expected\n%q\ngot\n%q", exp, paths)
+	}
+	exp = []string{"b/studio", "b/studio/01.flac"}
+	if paths := walkPaths(t, ignoreDir, "b/studio"); !reflect.DeepEqual(paths, exp) {
+		t.Errorf("expected\n%q\ngot\n%q", exp, paths)
+	}
+	// and walks of ignored folders find nothing
+	if paths := walkPaths(t, ignoreDir, "b/live"); len(paths) != 0 {
+		t.Errorf("expected an ignored folder to be left out, got %q", paths)
+	}
+	if paths := walkPaths(t, ignoreDir, "_incoming/new"); len(paths) != 0 {
+		t.Errorf("expected a folder in an ignored one to be left out, got %q", paths)
+	}
+}
+
+func TestIgnoreDirSkipsS3Lists(t *testing.T) {
+	fake := newFakeS3("music", map[string][]byte{
+		IgnoreFilename:        []byte("_incoming/\n*.log\n"),
+		"a/01.flac":           testData(10),
+		"a/rip.log":           testData(10),
+		"_incoming/x/01.flac": testData(10),
+		"_incoming/y/01.flac": testData(10),
+		"@eaDir/a/thumb.jpg":  testData(10),
+	})
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{})
+	defer closeServer()
+	ignoreDir := NewIgnoreDir(s3dir, []string{"@eaDir"})
+	exp := []string{".", "a", "a/01.flac"}
+	if paths := walkPaths(t, ignoreDir, "."); !reflect.DeepEqual(paths, exp) {
+		t.Errorf("expected\n%q\ngot\n%q", exp, paths)
+	}
+	// the root and a
+	if fake.counts().lists != 2 {
+		t.Errorf("expected ignored folders not to be listed, got %d lists", fake.counts().lists)
+	}
+}
@@ -3,6 +3,7 @@ package dir
 import (
 	"github.com/karrick/godirwalk"
 	"github.com/pkg/errors"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
@@ -25,8 +26,16 @@ func (ld LocalDir) GetTypeName() string {
 }
 
 func (ld LocalDir) Walk(Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
-	return godirwalk.Walk(ld.path, &godirwalk.Options{
+	return ld.WalkPath(".", Callback, PostChildrenCallback)
+}
+
+func (ld LocalDir) WalkPath(relPath string, Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	return godirwalk.Walk(filepath.Join(ld.path, relPath), &godirwalk.Options{
 		Callback: func(osPathname string, de *godirwalk.Dirent) error {
+			if de.Name() == IgnoreFilename {
+				// it was passed on with its folder
+				return nil
+			}
 			stat, err := os.Stat(osPathname)
 			if err != nil {
 				return errors.Wrap(err, "stating")
@@ -39,7 +48,10 @@ func (ld LocalDir) Walk(Callback WalkFunc, PostChildrenCallback PostWalkFunc) er
 			if err != nil {
 				return err
 			}
-			return Callback(relPath, stat.Size(), stat.ModTime(), isDir)
+			if err := Callback(relPath, stat.Size(), stat.ModTime(), isDir); err != nil || !isDir {
+				return err
+			}
+			return ld.walkIgnoreFile(osPathname, relPath, Callback)
 		},
 		PostChildrenCallback: func(osPathname string, de *godirwalk.Dirent) error {
 			return PostChildrenCallback(osPathname)
@@ -49,6 +61,16 @@ func (ld LocalDir) Walk(Callback WalkFunc, PostChildrenCallback PostWalkFunc) er
 	})
 }
 
+// walkIgnoreFile passes on the folder's ignore file if it has one, before
+// the rest of what's in it
+func (ld LocalDir) walkIgnoreFile(osPathname, relPath string, Callback WalkFunc) error {
+	stat, err := os.Stat(filepath.Join(osPathname, IgnoreFilename))
+	if err != nil || stat.IsDir() {
+		return nil
+	}
+	return Callback(filepath.Join(relPath, IgnoreFilename), stat.Size(), stat.ModTime(), false)
+}
+
 func (ld LocalDir) GetFile(path string) (time.Time, ReadSeekCloser, error) {
 	fullPath := filepath.Join(ld.path, path)
 
@@ -65,4 +87,29 @@ func (ld LocalDir) GetFile(path string) (time.Time, ReadSeekCloser, error) {
 	}
 
 	return stat.ModTime(), file, nil
-}
\ No newline at end of file
+}
+
+func (ld LocalDir) Stat(path string) (FileInfo, error) {
+	stat, err := os.Stat(filepath.Join(ld.path, path))
+	if err != nil {
+		return FileInfo{}, errors.Wrap(err, "Couldn't stat file")
+	}
+	return FileInfo{
+		Size:    stat.Size(),
+		ModTime: stat.ModTime(),
+	}, nil
+}
+
+func (ld LocalDir) GetFileRange(path string, offset, length int64) ([]byte, error) {
+	file, err := os.Open(filepath.Join(ld.path, path))
+	if err != nil {
+		return nil, errors.Wrap(err, "Couldn't read file")
+	}
+	defer file.Close()
+	data := make([]byte, length)
+	n, err := file.ReadAt(data, offset)
+	if err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "Couldn't read range of file")
+	}
+	return data[:n], nil
+}
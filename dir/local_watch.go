@@ -0,0 +1,185 @@
+package dir
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/karrick/godirwalk"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrWatchLimit is returned by Watch when the system won't let us watch
+	// any more folders. eg. when fs.inotify.max_user_watches is too low for
+	// the size of the library
+	ErrWatchLimit = errors.New("reached the limit of watched folders")
+	// ErrWatchUnsupported is returned by Watch on systems without inotify
+	ErrWatchUnsupported = errors.New("watching folders is not supported on this system")
+)
+
+// Watcher is implemented by the Dirs which can tell us about changes
+// as they happen
+type Watcher interface {
+	Watch(done <-chan struct{}, debounce time.Duration, fn WatchFunc) error
+}
+
+// WatchFunc is called with the relative paths of the folders which have
+// changed. if it returns an error the paths are kept, and passed again
+// with the next batch
+type WatchFunc func(relPaths []string) error
+
+// fsEvent is a change to an entry in a watched folder
+type fsEvent struct {
+	path  string
+	isDir bool
+	// created is set for new entries, including ones moved in from
+	// somewhere else
+	created bool
+	// overflow is set when the kernel dropped events, so we don't know
+	// what changed
+	overflow bool
+}
+
+// fsWatcher is implemented per platform. it watches single folders, not
+// whole trees
+type fsWatcher interface {
+	add(path string) error
+	events() <-chan fsEvent
+	close() error
+}
+
+// Watch watches every folder under the LocalDir, and calls fn with the
+// folders that changed once there have been no more changes for debounce.
+// new folders are watched as they appear. it returns when done is closed,
+// or with ErrWatchLimit or ErrWatchUnsupported if the tree can't be
+// watched, in which case the caller should fall back to full scans
+func (ld LocalDir) Watch(done <-chan struct{}, debounce time.Duration, fn WatchFunc) error {
+	watcher, err := newFSWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.close()
+	start := time.Now()
+	count, err := ld.watchTree(watcher, ld.path)
+	if err != nil {
+		return err
+	}
+	log.Printf("watching %d folders under `%s`, took %s\n",
+		count, ld.path, time.Since(start))
+	// ** begin collecting changes
+	pending := map[string]struct{}{}
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+	for {
+		select {
+		case <-done:
+			return nil
+		case event, ok := <-watcher.events():
+			if !ok {
+				return errors.New("watcher closed")
+			}
+			if event.overflow {
+				log.Printf("missed changes under `%s`, will rescan it all\n", ld.path)
+				pending["."] = struct{}{}
+				timer.Reset(debounce)
+				continue
+			}
+			if event.created && event.isDir {
+				_, err := ld.watchTree(watcher, event.path)
+				if errors.Is(err, ErrWatchLimit) {
+					return err
+				}
+				if err != nil {
+					log.Printf("error watching `%s`: %v\n", event.path, err)
+				}
+			}
+			// the folder which holds the changed entry needs to be
+			// rescanned, that way new folders are found from their parent
+			relPath, err := filepath.Rel(ld.path, filepath.Dir(event.path))
+			if err != nil || strings.HasPrefix(relPath, "..") {
+				continue
+			}
+			pending[filepath.ToSlash(relPath)] = struct{}{}
+			timer.Reset(debounce)
+		case <-timer.C:
+			relPaths := ld.existingRoots(pending)
+			if err := fn(relPaths); err != nil {
+				log.Printf("error handling changes under `%s`: %v\n", ld.path, err)
+				timer.Reset(debounce)
+				continue
+			}
+			pending = map[string]struct{}{}
+		}
+	}
+}
+
+// watchTree adds a watch for every folder under root, including itself
+func (ld LocalDir) watchTree(watcher fsWatcher, root string) (int, error) {
+	var count int
+	err := godirwalk.Walk(root, &godirwalk.Options{
+		Callback: func(osPathname string, de *godirwalk.Dirent) error {
+			isDir, err := de.IsDirOrSymlinkToDir()
+			if err != nil || !isDir {
+				return nil
+			}
+			if err := watcher.add(osPathname); err != nil {
+				return err
+			}
+			count++
+			return nil
+		},
+		ErrorCallback: func(_ string, err error) godirwalk.ErrorAction {
+			if errors.Is(err, ErrWatchLimit) {
+				return godirwalk.Halt
+			}
+			// the folder may have been removed since we saw the event
+			return godirwalk.SkipNode
+		},
+		Unsorted:            true,
+		FollowSymbolicLinks: true,
+	})
+	return count, err
+}
+
+// existingRoots returns the smallest set of paths which covers all the
+// changed ones. removed folders are replaced with their closest parent that
+// still exists, so that what was under them can be cleaned up
+func (ld LocalDir) existingRoots(changed map[string]struct{}) []string {
+	paths := make([]string, 0, len(changed))
+	for relPath := range changed {
+		for relPath != "." {
+			if _, err := os.Stat(filepath.Join(ld.path, relPath)); err == nil {
+				break
+			}
+			relPath = filepath.ToSlash(filepath.Dir(relPath))
+		}
+		paths = append(paths, relPath)
+	}
+	return collapsePaths(paths)
+}
+
+// collapsePaths removes duplicates and paths which are under another
+// path in the list
+func collapsePaths(paths []string) []string {
+	set := make(map[string]struct{}, len(paths))
+	for _, p := range paths {
+		set[p] = struct{}{}
+	}
+	ret := make([]string, 0, len(set))
+	for p := range set {
+		covered := false
+		for parent := p; parent != "." && !covered; {
+			parent = filepath.ToSlash(filepath.Dir(parent))
+			_, covered = set[parent]
+		}
+		if !covered {
+			ret = append(ret, p)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
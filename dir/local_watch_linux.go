@@ -0,0 +1,139 @@
+//go:build linux
+// +build linux
+
+package dir
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/pkg/errors"
+)
+
+const inotifyMask = syscall.IN_CREATE |
+	syscall.IN_CLOSE_WRITE |
+	syscall.IN_DELETE |
+	syscall.IN_MOVED_FROM |
+	syscall.IN_MOVED_TO |
+	syscall.IN_ONLYDIR
+
+// inotifyWatcher is a fsWatcher using the inotify syscalls directly. the
+// fd is non blocking and wrapped in an os.File so that reads go through
+// the runtime's poller, and closing it stops the read loop
+type inotifyWatcher struct {
+	// fd is kept as well as file, because file.Fd() would put it back in
+	// blocking mode
+	fd   int
+	file *os.File
+	// wd -> the path of the folder it watches
+	paths   map[int]string
+	pathsMu sync.Mutex
+	evCh    chan fsEvent
+	done    chan struct{}
+}
+
+func newFSWatcher() (fsWatcher, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		if err == syscall.EMFILE {
+			// we've hit fs.inotify.max_user_instances
+			return nil, ErrWatchLimit
+		}
+		return nil, errors.Wrap(err, "init inotify")
+	}
+	w := &inotifyWatcher{
+		fd:    fd,
+		file:  os.NewFile(uintptr(fd), "inotify"),
+		paths: map[int]string{},
+		evCh:  make(chan fsEvent),
+		done:  make(chan struct{}),
+	}
+	go w.read()
+	return w, nil
+}
+
+func (w *inotifyWatcher) add(path string) error {
+	wd, err := syscall.InotifyAddWatch(w.fd, path, inotifyMask)
+	if err == syscall.ENOSPC {
+		// we've hit fs.inotify.max_user_watches
+		return ErrWatchLimit
+	}
+	if err != nil {
+		return errors.Wrapf(err, "watch `%s`", path)
+	}
+	w.pathsMu.Lock()
+	w.paths[wd] = path
+	w.pathsMu.Unlock()
+	return nil
+}
+
+func (w *inotifyWatcher) events() <-chan fsEvent {
+	return w.evCh
+}
+
+func (w *inotifyWatcher) close() error {
+	close(w.done)
+	return w.file.Close()
+}
+
+// send passes an event on, unless the watcher was closed and nobody is
+// receiving anymore
+func (w *inotifyWatcher) send(event fsEvent) bool {
+	select {
+	case w.evCh <- event:
+		return true
+	case <-w.done:
+		return false
+	}
+}
+
+func (w *inotifyWatcher) read() {
+	defer close(w.evCh)
+	var buf [syscall.SizeofInotifyEvent * 4096]byte
+	for {
+		n, err := w.file.Read(buf[:])
+		if err != nil {
+			if err != io.EOF && !errors.Is(err, os.ErrClosed) {
+				log.Printf("error reading inotify events: %v\n", err)
+			}
+			return
+		}
+		for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+			raw := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameStart := offset + syscall.SizeofInotifyEvent
+			nameEnd := nameStart + int(raw.Len)
+			name := string(bytes.TrimRight(buf[nameStart:nameEnd], "\x00"))
+			offset = nameEnd
+			if raw.Mask&syscall.IN_Q_OVERFLOW != 0 {
+				if !w.send(fsEvent{overflow: true}) {
+					return
+				}
+				continue
+			}
+			w.pathsMu.Lock()
+			dirPath, ok := w.paths[int(raw.Wd)]
+			if raw.Mask&syscall.IN_IGNORED != 0 {
+				// the folder was removed, so the kernel dropped its watch
+				delete(w.paths, int(raw.Wd))
+			}
+			w.pathsMu.Unlock()
+			if !ok || name == "" {
+				continue
+			}
+			event := fsEvent{
+				path:    filepath.Join(dirPath, name),
+				isDir:   raw.Mask&syscall.IN_ISDIR != 0,
+				created: raw.Mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0,
+			}
+			if !w.send(event) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !linux
+// +build !linux
+
+package dir
+
+func newFSWatcher() (fsWatcher, error) {
+	return nil, ErrWatchUnsupported
+}
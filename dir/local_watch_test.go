@@ -0,0 +1,91 @@
+package dir
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCollapsePaths(t *testing.T) {
+	cases := []struct {
+		in, exp []string
+	}{
+		{[]string{"a", "a/b", "a/b/c"}, []string{"a"}},
+		{[]string{"a/b", "a b", "a/c", "a"}, []string{"a", "a b"}},
+		{[]string{"a/b", "a/b", "c"}, []string{"a/b", "c"}},
+		{[]string{"a/b", ".", "c"}, []string{"."}},
+		{[]string{"ab", "a"}, []string{"a", "ab"}},
+	}
+	for _, tc := range cases {
+		if act := collapsePaths(tc.in); !reflect.DeepEqual(act, tc.exp) {
+			t.Errorf("collapsePaths(%q): expected %q, got %q", tc.in, tc.exp, act)
+		}
+	}
+}
+
+func TestLocalWatch(t *testing.T) {
+	root, err := ioutil.TempDir("", "gonic-watch")
+	if err != nil {
+		t.Fatalf("making temp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+	if err := os.MkdirAll(filepath.Join(root, "artist"), 0755); err != nil {
+		t.Fatalf("making artist dir: %v", err)
+	}
+	ld, err := NewLocalDir(root)
+	if err != nil {
+		t.Fatalf("making local dir: %v", err)
+	}
+	done := make(chan struct{})
+	defer close(done)
+	changes := make(chan []string, 8)
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- ld.Watch(done, 100*time.Millisecond, func(relPaths []string) error {
+			changes <- relPaths
+			return nil
+		})
+	}()
+	// give the watcher a moment to add its watches
+	time.Sleep(200 * time.Millisecond)
+	// a new album is added, then its tracks. the tracks' folder wasn't there
+	// when we started watching
+	album := filepath.Join(root, "artist", "album")
+	if err := os.Mkdir(album, 0755); err != nil {
+		t.Fatalf("making album dir: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	for _, name := range []string{"01.flac", "02.flac"} {
+		if err := ioutil.WriteFile(filepath.Join(album, name), []byte{0}, 0644); err != nil {
+			t.Fatalf("writing track: %v", err)
+		}
+	}
+	select {
+	case err := <-watchErr:
+		if err == ErrWatchUnsupported || err == ErrWatchLimit {
+			t.Skipf("can't watch here: %v", err)
+		}
+		t.Fatalf("watch returned early: %v", err)
+	case relPaths := <-changes:
+		if exp := []string{"artist"}; !reflect.DeepEqual(relPaths, exp) {
+			t.Errorf("expected changes %q, got %q", exp, relPaths)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no changes seen")
+	}
+	// then removed again. the closest folder that's still there is rescanned
+	if err := os.RemoveAll(album); err != nil {
+		t.Fatalf("removing album: %v", err)
+	}
+	select {
+	case relPaths := <-changes:
+		if exp := []string{"artist"}; !reflect.DeepEqual(relPaths, exp) {
+			t.Errorf("expected changes %q, got %q", exp, relPaths)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no changes seen")
+	}
+}
@@ -0,0 +1,118 @@
+package dir
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// musicPathAliasSep separates an optional name from the location in a
+// music path. eg. "Audiobooks->/mnt/audiobooks"
+const musicPathAliasSep = "->"
+
+// MusicPath is a named root of the library. Location is either a local
+// path or the URL of a remote one, see Open
+type MusicPath struct {
+	Name     string
+	Location string
+}
+
+// MusicPaths is a flag.Value which can be given more than once
+type MusicPaths []MusicPath
+
+func (m *MusicPaths) String() string {
+	if m == nil {
+		return ""
+	}
+	strs := make([]string, 0, len(*m))
+	for _, path := range *m {
+		strs = append(strs, path.Name+musicPathAliasSep+path.Location)
+	}
+	return strings.Join(strs, ", ")
+}
+
+func (m *MusicPaths) Set(value string) error {
+	if value == "" {
+		return nil
+	}
+	path := MusicPath{Location: value}
+	if parts := strings.SplitN(value, musicPathAliasSep, 2); len(parts) == 2 {
+		path.Name = strings.TrimSpace(parts[0])
+		path.Location = strings.TrimSpace(parts[1])
+	}
+	if path.Location == "" {
+		return fmt.Errorf("no location in music path %q", value)
+	}
+	if path.Name == "" {
+		path.Name = defaultMusicPathName(path.Location)
+	}
+	*m = append(*m, path)
+	return nil
+}
+
+func defaultMusicPathName(location string) string {
+	u, err := url.Parse(location)
+	if err != nil || !strings.Contains(location, "://") {
+		return filepath.Base(location)
+	}
+	if u.Scheme == "s3" || strings.Trim(u.Path, "/") == "" {
+		return u.Hostname()
+	}
+	return path.Base(u.Path)
+}
+
+// RemoteOptions is what's needed to open remote locations, other than
+// what's in the location URL itself
+type RemoteOptions struct {
+	S3     S3Options
+	SFTP   SFTPOptions
+	WebDAV WebDAVOptions
+}
+
+// Open creates the Dir for a music path's location. remote locations are
+// URLs, and take everything they don't have from opts. they are
+//
+//	s3://bucket/some/prefix
+//	sftp://user@host:port/some/path
+//	webdav://host/some/path (or webdavs:// for https)
+func Open(location string, opts RemoteOptions) (Dir, error) {
+	u, err := url.Parse(location)
+	if err != nil || !strings.Contains(location, "://") {
+		localDir, err := NewLocalDir(location)
+		if err != nil {
+			return nil, err
+		}
+		return localDir, nil
+	}
+	switch u.Scheme {
+	case "s3":
+		s3Opts := opts.S3
+		s3Opts.Bucket = u.Host
+		s3Opts.KeyPrefix = u.Path
+		return NewS3Dir(s3Opts)
+	case "sftp":
+		sftpOpts := opts.SFTP
+		sftpOpts.Host = u.Host
+		sftpOpts.Path = u.Path
+		if u.User != nil {
+			sftpOpts.User = u.User.Username()
+			if password, ok := u.User.Password(); ok {
+				sftpOpts.Password = password
+			}
+		}
+		return NewSFTPDir(sftpOpts)
+	case "webdav", "webdavs":
+		webdavOpts := opts.WebDAV
+		if u.Scheme == "webdavs" {
+			u.Scheme = "https"
+		} else {
+			u.Scheme = "http"
+		}
+		webdavOpts.URL = u.String()
+		return NewWebDAVDir(webdavOpts)
+	default:
+		return nil, fmt.Errorf("unknown music path scheme %q", u.Scheme)
+	}
+}
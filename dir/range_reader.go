@@ -0,0 +1,117 @@
+package dir
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// rangeReadAhead is the size of the buffer sitting in front of an open
+// body. it keeps small reads (eg. from tag parsers) from turning into many
+// tiny network reads
+const rangeReadAhead = 256 * 1024
+
+// rangeOpenFunc requests a remote file from offset to the end
+type rangeOpenFunc func(offset int64) (io.ReadCloser, error)
+
+// rangeReader is a ReadSeekCloser over a remote file (eg. an S3 object)
+// which doesn't fetch anything until it's read from. the body is requested
+// from the current offset to the end of the file with a ranged request, and
+// is kept open for sequential reads. seeking outside the read-ahead buffer
+// drops the body, so the next read starts a new ranged request
+type rangeReader struct {
+	open rangeOpenFunc
+	// name is used in errors
+	name string
+	size int64
+	// offset is the position the next Read will return data from
+	offset int64
+	// body is nil when there is no request in flight. buf is kept
+	// around and reset for the next body
+	body io.ReadCloser
+	buf  *bufio.Reader
+}
+
+func (r *rangeReader) openBody() error {
+	body, err := r.open(r.offset)
+	if err != nil {
+		return err
+	}
+	r.body = body
+	if r.buf == nil {
+		r.buf = bufio.NewReaderSize(body, rangeReadAhead)
+	} else {
+		r.buf.Reset(body)
+	}
+	return nil
+}
+
+func (r *rangeReader) drop() error {
+	if r.body == nil {
+		return nil
+	}
+	err := r.body.Close()
+	r.body = nil
+	return err
+}
+
+func (r *rangeReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+	if r.body == nil {
+		if err := r.openBody(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.buf.Read(p)
+	r.offset += int64(n)
+	if err == io.EOF && r.offset < r.size {
+		// the connection was cut short. drop it and let the next
+		// read resume from where we got to
+		_ = r.drop()
+		err = nil
+		if n == 0 {
+			err = io.ErrUnexpectedEOF
+		}
+	}
+	return n, err
+}
+
+func (r *rangeReader) Seek(offset int64, whence int) (int64, error) {
+	var target int64
+	switch whence {
+	case io.SeekStart:
+		target = offset
+	case io.SeekCurrent:
+		target = r.offset + offset
+	case io.SeekEnd:
+		target = r.size + offset
+	default:
+		return 0, errors.New("range reader: invalid whence")
+	}
+	if target < 0 {
+		return 0, errors.New("range reader: negative position")
+	}
+	if target == r.offset {
+		return target, nil
+	}
+	// a short skip forward that's already buffered doesn't need a new request
+	if r.body != nil && target > r.offset && target-r.offset <= int64(r.buf.Buffered()) {
+		if _, err := r.buf.Discard(int(target - r.offset)); err != nil {
+			return 0, err
+		}
+		r.offset = target
+		return target, nil
+	}
+	if err := r.drop(); err != nil {
+		return 0, errors.Wrapf(err, "Failed to close file `%v`", r.name)
+	}
+	r.offset = target
+	return target, nil
+}
+
+func (r *rangeReader) Close() error {
+	return r.drop()
+}
@@ -1,61 +1,145 @@
 package dir
 
 import (
-	"bytes"
+	"fmt"
 	"github.com/pkg/errors"
 	"io"
 	"io/ioutil"
+	"log"
+	"path"
 	"strings"
 	"time"
 
 	_ "github.com/aws/aws-sdk-go"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 )
 
+// S3Options configures where and how an S3Dir reads its objects. only
+// Bucket is required. the others allow for S3 compatible stores, such as
+// MinIO or Ceph RGW, to be used instead of AWS
+type S3Options struct {
+	Region string
+	Bucket string
+	// Endpoint is the base URL of the service. eg. "http://minio:9000".
+	// leave it empty to use AWS
+	Endpoint string
+	// PathStyle requests objects at "endpoint/bucket/key" instead of at
+	// "bucket.endpoint/key". most self hosted stores need this
+	PathStyle bool
+	// AccessKeyID and SecretAccessKey are used instead of the default
+	// credential chain (env vars, shared config, instance roles) if set
+	AccessKeyID     string
+	SecretAccessKey string
+	// KeyPrefix is a folder inside the bucket to use as the root
+	KeyPrefix string
+	// RequesterPays acknowledges that we're paying for requests to a
+	// requester pays bucket
+	RequesterPays bool
+}
+
 type S3Dir struct {
 	region, bucketName string
+	keyPrefix          string
+	requesterPays      bool
 	s3Client           *s3.S3
 }
 
-func NewS3Dir(region, bucket string) (*S3Dir, error) {
-	awsSession, err := session.NewSession(&aws.Config{
-		Region: aws.String(region),
-	})
+func NewS3Dir(opts S3Options) (*S3Dir, error) {
+	if opts.Bucket == "" {
+		return nil, errors.New("no bucket name provided")
+	}
+	config := &aws.Config{
+		Region: aws.String(opts.Region),
+	}
+	if opts.Endpoint != "" {
+		config.Endpoint = aws.String(opts.Endpoint)
+	}
+	if opts.PathStyle {
+		config.S3ForcePathStyle = aws.Bool(true)
+	}
+	if opts.AccessKeyID != "" || opts.SecretAccessKey != "" {
+		config.Credentials = credentials.NewStaticCredentials(
+			opts.AccessKeyID,
+			opts.SecretAccessKey,
+			"",
+		)
+	}
+	awsSession, err := session.NewSession(config)
 	if err != nil {
 		return nil, err
 	}
 	svc := s3.New(awsSession)
 
+	// normalise the prefix to either "" or "some/folder/" so that it can be
+	// joined with, and trimmed from, object keys
+	keyPrefix := strings.Trim(opts.KeyPrefix, "/")
+	if keyPrefix != "" {
+		keyPrefix += "/"
+	}
+
 	return &S3Dir{
-		region:     region,
-		bucketName: bucket,
-		s3Client:   svc,
+		region:        opts.Region,
+		bucketName:    opts.Bucket,
+		keyPrefix:     keyPrefix,
+		requesterPays: opts.RequesterPays,
+		s3Client:      svc,
 	}, nil
 }
 
+// requestPayer is the value for the RequestPayer field of the requests we
+// make, which must only be set for requester pays buckets
+func (s3dir S3Dir) requestPayer() *string {
+	if !s3dir.requesterPays {
+		return nil
+	}
+	return aws.String(s3.RequestPayerRequester)
+}
+
 func (s3dir S3Dir) GetTypeName() string {
 	return "s3"
 }
 
+// s3WalkStats counts the requests and keys seen during a single walk, so
+// we can tell from the logs that a big bucket was listed completely
+type s3WalkStats struct {
+	pages    int
+	prefixes int
+	keys     int
+}
+
 func (s3dir S3Dir) Walk(Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
-	return s3dir.walkFolder("", Callback, PostChildrenCallback)
+	return s3dir.WalkPath(".", Callback, PostChildrenCallback)
 }
 
-func (s3dir S3Dir) walkFolder(prefix string, Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
-	resp, err := s3dir.s3Client.ListObjectsV2(&s3.ListObjectsV2Input{
-		Bucket: aws.String(s3dir.bucketName),
-		Prefix: aws.String(prefix),
-		Delimiter: aws.String("/"),
-		RequestPayer: aws.String("requester"),
-	})
-	if err != nil {
-		return errors.Wrapf(err, "Failed to list items in bucket %q", s3dir.bucketName)
+func (s3dir S3Dir) WalkPath(relPath string, Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	prefix := s3dir.keyPrefix
+	if relPath = path.Clean(relPath); relPath != "." {
+		prefix += relPath + "/"
+	}
+	start := time.Now()
+	stats := &s3WalkStats{}
+	if err := s3dir.walkFolder(prefix, stats, Callback, PostChildrenCallback); err != nil {
+		return err
 	}
+	log.Printf("listed bucket %q in %s, %d pages, %d prefixes, %d keys\n",
+		s3dir.bucketName,
+		time.Since(start),
+		stats.pages,
+		stats.prefixes,
+		stats.keys,
+	)
+	return nil
+}
 
-	// enter the folder, but make sure to remove any trailing / before calling our handlers
-	relPath := prefix
+func (s3dir S3Dir) walkFolder(prefix string, stats *s3WalkStats, Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	// enter the folder, but make sure to remove the key prefix and any
+	// trailing / before calling our handlers. this is done before listing
+	// it, so that skipped folders are never listed
+	relPrefix := strings.TrimPrefix(prefix, s3dir.keyPrefix)
+	relPath := relPrefix
 	if strings.HasSuffix(relPath, "/") {
 		relPath = relPath[:len(relPath)-1]
 	}
@@ -63,52 +147,133 @@ func (s3dir S3Dir) walkFolder(prefix string, Callback WalkFunc, PostChildrenCall
 		relPath = "."
 	}
 	if err := Callback(relPath, 0, time.Time{}, true); err != nil {
+		if err == SkipDir {
+			return nil
+		}
 		return err
 	}
 
+	// a single response holds at most 1000 entries, so keep following the
+	// continuation token until we have the whole folder
+	var prefixes []*s3.CommonPrefix
+	var contents []*s3.Object
+	err := s3dir.s3Client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(s3dir.bucketName),
+		Prefix: aws.String(prefix),
+		Delimiter: aws.String("/"),
+		RequestPayer: s3dir.requestPayer(),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		stats.pages++
+		prefixes = append(prefixes, page.CommonPrefixes...)
+		contents = append(contents, page.Contents...)
+		return true
+	})
+	if err != nil {
+		return errors.Wrapf(err, "Failed to list items in bucket %q", s3dir.bucketName)
+	}
+	stats.prefixes += len(prefixes)
+	stats.keys += len(contents)
+
+	// the ignore file comes first, so that it applies to everything else
+	ignoreKey := prefix + IgnoreFilename
+	for _, item := range contents {
+		if *item.Key != ignoreKey {
+			continue
+		}
+		relKey := strings.TrimPrefix(*item.Key, s3dir.keyPrefix)
+		if err := Callback(relKey, *item.Size, *item.LastModified, false); err != nil {
+			return err
+		}
+	}
+
 	// first iterate deeper into the hierarchy
-	for _, item := range resp.CommonPrefixes {
-		if err := s3dir.walkFolder(*item.Prefix, Callback, PostChildrenCallback); err != nil {
+	for _, item := range prefixes {
+		if err := s3dir.walkFolder(*item.Prefix, stats, Callback, PostChildrenCallback); err != nil {
 			return err
 		}
 	}
 
 	// then iterate over the contained files
-	for _, item := range resp.Contents {
-		if strings.Compare(prefix, *item.Key) == 0 {
+	for _, item := range contents {
+		if strings.Compare(prefix, *item.Key) == 0 || *item.Key == ignoreKey {
 			continue
 		}
-		if err := Callback(*item.Key, *item.Size, *item.LastModified, false); err != nil {
+		relKey := strings.TrimPrefix(*item.Key, s3dir.keyPrefix)
+		if err := Callback(relKey, *item.Size, *item.LastModified, false); err != nil {
 			return err
 		}
 	}
 
 	// exit the folder
-	return PostChildrenCallback(prefix)
+	return PostChildrenCallback(relPrefix)
 }
 
-type nopReadSeekCloser struct {
-	io.ReadSeeker
-}
+func (s3dir S3Dir) GetFile(path string) (time.Time, ReadSeekCloser, error) {
+	// only fetch the object's meta-data here. the contents are requested lazily
+	// with ranged GETs as the reader is consumed, so seeking (eg. by
+	// http.ServeContent) doesn't require downloading the whole object
+	info, err := s3dir.Stat(path)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
 
-func (nopReadSeekCloser) Close() error { return nil }
+	reader := &rangeReader{
+		open: func(offset int64) (io.ReadCloser, error) {
+			return s3dir.openFrom(path, offset)
+		},
+		name: path,
+		size: info.Size,
+	}
+	return info.ModTime, reader, nil
+}
 
+// openFrom requests the object at path from offset to the end
+func (s3dir S3Dir) openFrom(path string, offset int64) (io.ReadCloser, error) {
+	resp, err := s3dir.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket:       aws.String(s3dir.bucketName),
+		Key:          aws.String(s3dir.keyPrefix + path),
+		Range:        aws.String(fmt.Sprintf("bytes=%d-", offset)),
+		RequestPayer: s3dir.requestPayer(),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to get range of file `%v` from S3 bucket `%v`", path, s3dir.bucketName)
+	}
+	return resp.Body, nil
+}
 
-func (s3dir S3Dir) GetFile(path string) (time.Time, ReadSeekCloser, error) {
-	response, err := s3dir.s3Client.GetObject(&s3.GetObjectInput{
+func (s3dir S3Dir) Stat(path string) (FileInfo, error) {
+	head, err := s3dir.s3Client.HeadObject(&s3.HeadObjectInput{
 		Bucket: aws.String(s3dir.bucketName),
-		Key: aws.String(path),
-		RequestPayer: aws.String("requester"),
+		Key: aws.String(s3dir.keyPrefix + path),
+		RequestPayer: s3dir.requestPayer(),
 	})
 	if err != nil {
-		return time.Time{}, nil, errors.Wrapf(err, "Failed to get file `%v` from S3 bucket `%v`", path, s3dir.bucketName)
+		return FileInfo{}, errors.Wrapf(err, "Failed to get file `%v` from S3 bucket `%v`", path, s3dir.bucketName)
 	}
+	return FileInfo{
+		Size:    aws.Int64Value(head.ContentLength),
+		ModTime: aws.TimeValue(head.LastModified),
+		ETag:    aws.StringValue(head.ETag),
+	}, nil
+}
 
-	// now let's read the response and buffer it to enable seeking
-	data, err := ioutil.ReadAll(response.Body)
+func (s3dir S3Dir) GetFileRange(path string, offset, length int64) ([]byte, error) {
+	if length <= 0 {
+		return nil, nil
+	}
+	resp, err := s3dir.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(s3dir.bucketName),
+		Key: aws.String(s3dir.keyPrefix + path),
+		Range: aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)),
+		RequestPayer: s3dir.requestPayer(),
+	})
 	if err != nil {
-		return time.Time{}, nil, errors.Wrapf(err, "Failed to read file `%v` from S3 bucket `%v`", path, s3dir.bucketName)
+		return nil, errors.Wrapf(err, "Failed to get range of file `%v` from S3 bucket `%v`", path, s3dir.bucketName)
 	}
-
-	return *response.LastModified, nopReadSeekCloser{bytes.NewReader(data)}, nil
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to read range of file `%v` from S3 bucket `%v`", path, s3dir.bucketName)
+	}
+	return data, nil
 }
@@ -0,0 +1,481 @@
+package dir
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeS3 is a tiny stand in for an S3 compatible server. it only knows
+// enough of the API (path style ListObjectsV2, HeadObject, and ranged
+// GetObject) for S3Dir to be tested against
+type fakeS3 struct {
+	sync.Mutex
+	bucket    string
+	objects   map[string][]byte
+	modTime   time.Time
+	pageSize  int
+	bytesSent int
+	gets      int
+	lists     int
+	// payers is the number of requests acknowledging requester pays
+	payers int
+}
+
+func newFakeS3(bucket string, objects map[string][]byte) *fakeS3 {
+	return &fakeS3{
+		bucket:   bucket,
+		objects:  objects,
+		modTime:  time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+		pageSize: 1000,
+	}
+}
+
+type fakeS3ListResult struct {
+	XMLName               xml.Name `xml:"ListBucketResult"`
+	Name                  string
+	Prefix                string
+	KeyCount              int
+	MaxKeys               int
+	IsTruncated           bool
+	NextContinuationToken string `xml:",omitempty"`
+	Contents              []fakeS3Object
+	CommonPrefixes        []fakeS3Prefix
+}
+
+type fakeS3Object struct {
+	Key          string
+	Size         int
+	LastModified time.Time
+}
+
+type fakeS3Prefix struct {
+	Prefix string
+}
+
+// fakeS3Counts are how much a fakeS3 has served so far
+type fakeS3Counts struct {
+	bytesSent int
+	gets      int
+	lists     int
+	payers    int
+}
+
+// counts reads the counters under the lock that the handler writes them
+// under
+func (f *fakeS3) counts() fakeS3Counts {
+	f.Lock()
+	defer f.Unlock()
+	return fakeS3Counts{
+		bytesSent: f.bytesSent,
+		gets:      f.gets,
+		lists:     f.lists,
+		payers:    f.payers,
+	}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	if r.Header.Get("X-Amz-Request-Payer") == "requester" {
+		f.payers++
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	if path == f.bucket {
+		f.serveList(w, r)
+		return
+	}
+	key := strings.TrimPrefix(path, f.bucket+"/")
+	data, ok := f.objects[key]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Last-Modified", f.modTime.Format(http.TimeFormat))
+	w.Header().Set("ETag", fmt.Sprintf(`"%x"`, md5.Sum(data)))
+	if r.Method == http.MethodHead {
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		return
+	}
+	f.gets++
+	start, end := 0, len(data)
+	if rng := r.Header.Get("Range"); rng != "" {
+		parts := strings.SplitN(strings.TrimPrefix(rng, "bytes="), "-", 2)
+		start, _ = strconv.Atoi(parts[0])
+		if parts[1] != "" {
+			end, _ = strconv.Atoi(parts[1])
+			end++
+		}
+		if end > len(data) {
+			end = len(data)
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end-1, len(data)))
+		w.Header().Set("Content-Length", strconv.Itoa(end-start))
+		w.WriteHeader(http.StatusPartialContent)
+	}
+	n, _ := w.Write(data[start:end])
+	f.bytesSent += n
+}
+
+func (f *fakeS3) serveList(w http.ResponseWriter, r *http.Request) {
+	f.lists++
+	query := r.URL.Query()
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+	keys := make([]string, 0, len(f.objects))
+	for key := range f.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	// like S3, both keys and common prefixes count towards a page, and the
+	// continuation token is opaque. here it's the index of the next entry
+	type entry struct {
+		key      string
+		isPrefix bool
+	}
+	var entries []entry
+	seenPrefixes := map[string]struct{}{}
+	for _, key := range keys {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		rest := key[len(prefix):]
+		if i := strings.Index(rest, delimiter); delimiter != "" && i >= 0 {
+			common := prefix + rest[:i+len(delimiter)]
+			if _, ok := seenPrefixes[common]; !ok {
+				seenPrefixes[common] = struct{}{}
+				entries = append(entries, entry{common, true})
+			}
+			continue
+		}
+		entries = append(entries, entry{key, false})
+	}
+	start, _ := strconv.Atoi(query.Get("continuation-token"))
+	end := start + f.pageSize
+	if end > len(entries) {
+		end = len(entries)
+	}
+	result := fakeS3ListResult{Name: f.bucket, Prefix: prefix, MaxKeys: f.pageSize}
+	for _, e := range entries[start:end] {
+		if e.isPrefix {
+			result.CommonPrefixes = append(result.CommonPrefixes, fakeS3Prefix{e.key})
+			continue
+		}
+		result.Contents = append(result.Contents, fakeS3Object{
+			Key:          e.key,
+			Size:         len(f.objects[e.key]),
+			LastModified: f.modTime,
+		})
+	}
+	result.KeyCount = end - start
+	if end < len(entries) {
+		result.IsTruncated = true
+		result.NextContinuationToken = strconv.Itoa(end)
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+// newTestS3Dir serves fake, and returns a dir for it along with a func to
+// stop serving it
+func newTestS3Dir(t *testing.T, fake *fakeS3, opts S3Options) (*S3Dir, func()) {
+	t.Helper()
+	server := httptest.NewServer(fake)
+	opts.Region = "us-east-1"
+	opts.Bucket = fake.bucket
+	opts.Endpoint = server.URL
+	opts.PathStyle = true
+	opts.AccessKeyID = "key"
+	opts.SecretAccessKey = "secret"
+	s3dir, err := NewS3Dir(opts)
+	if err != nil {
+		server.Close()
+		t.Fatalf("creating s3 dir: %v", err)
+	}
+	return s3dir, server.Close
+}
+
+func testData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	return data
+}
+
+func TestS3GetFileIsLazy(t *testing.T) {
+	data := testData(4 * rangeReadAhead)
+	fake := newFakeS3("music", map[string][]byte{"a/track.flac": data})
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{})
+	defer closeServer()
+	modTime, reader, err := s3dir.GetFile("a/track.flac")
+	if err != nil {
+		t.Fatalf("getting file: %v", err)
+	}
+	defer reader.Close()
+	if !modTime.Equal(fake.modTime) {
+		t.Errorf("expected mod time %v, got %v", fake.modTime, modTime)
+	}
+	size, err := reader.Seek(0, io.SeekEnd)
+	if err != nil {
+		t.Fatalf("seeking to end: %v", err)
+	}
+	if size != int64(len(data)) {
+		t.Errorf("expected size %d, got %d", len(data), size)
+	}
+	if fake.counts().gets != 0 {
+		t.Errorf("expected no GETs before reading, got %d", fake.counts().gets)
+	}
+}
+
+func TestS3GetFileRange(t *testing.T) {
+	data := testData(4 * rangeReadAhead)
+	fake := newFakeS3("music", map[string][]byte{"a/track.flac": data})
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{})
+	defer closeServer()
+	got, err := s3dir.GetFileRange("a/track.flac", 1000, 100)
+	if err != nil {
+		t.Fatalf("getting range: %v", err)
+	}
+	if !bytes.Equal(got, data[1000:1100]) {
+		t.Errorf("range doesn't match")
+	}
+	if fake.counts().bytesSent != 100 {
+		t.Errorf("expected 100 bytes sent, got %d", fake.counts().bytesSent)
+	}
+}
+
+func TestS3GetFileSeekAndRead(t *testing.T) {
+	data := testData(4 * rangeReadAhead)
+	fake := newFakeS3("music", map[string][]byte{"a/track.flac": data})
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{})
+	defer closeServer()
+	_, reader, err := s3dir.GetFile("a/track.flac")
+	if err != nil {
+		t.Fatalf("getting file: %v", err)
+	}
+	defer reader.Close()
+	// read the tail of the file
+	tailStart := int64(len(data) - 1000)
+	if _, err := reader.Seek(tailStart, io.SeekStart); err != nil {
+		t.Fatalf("seeking: %v", err)
+	}
+	tail, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("reading tail: %v", err)
+	}
+	if !bytes.Equal(tail, data[tailStart:]) {
+		t.Errorf("tail didn't match")
+	}
+	if fake.counts().bytesSent != 1000 {
+		t.Errorf("expected only the tail to be sent, got %d bytes", fake.counts().bytesSent)
+	}
+	// then go back and read the head
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		t.Fatalf("seeking: %v", err)
+	}
+	head := make([]byte, 10)
+	if _, err := io.ReadFull(reader, head); err != nil {
+		t.Fatalf("reading head: %v", err)
+	}
+	if !bytes.Equal(head, data[:10]) {
+		t.Errorf("head didn't match")
+	}
+	// and a short skip forward should be served from the buffer
+	gets := fake.counts().gets
+	if _, err := reader.Seek(100, io.SeekCurrent); err != nil {
+		t.Fatalf("seeking: %v", err)
+	}
+	if _, err := io.ReadFull(reader, head); err != nil {
+		t.Fatalf("reading after skip: %v", err)
+	}
+	if !bytes.Equal(head, data[110:120]) {
+		t.Errorf("data after skip didn't match")
+	}
+	if fake.counts().gets != gets {
+		t.Errorf("expected skip to be buffered, got %d new GETs", fake.counts().gets-gets)
+	}
+}
+
+func TestS3GetFileServeContentRange(t *testing.T) {
+	data := testData(3 * rangeReadAhead)
+	fake := newFakeS3("music", map[string][]byte{"track.mp3": data})
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{})
+	defer closeServer()
+	modTime, reader, err := s3dir.GetFile("track.mp3")
+	if err != nil {
+		t.Fatalf("getting file: %v", err)
+	}
+	defer reader.Close()
+	req := httptest.NewRequest(http.MethodGet, "/stream", nil)
+	req.Header.Set("Range", "bytes=500000-500099")
+	rr := httptest.NewRecorder()
+	http.ServeContent(rr, req, "track.mp3", modTime, reader)
+	if rr.Code != http.StatusPartialContent {
+		t.Fatalf("expected 206, got %d", rr.Code)
+	}
+	if !bytes.Equal(rr.Body.Bytes(), data[500000:500100]) {
+		t.Errorf("range body didn't match")
+	}
+	if fake.counts().bytesSent > 500000 {
+		t.Errorf("expected a partial download, got %d bytes", fake.counts().bytesSent)
+	}
+}
+
+func TestS3WalkPaginates(t *testing.T) {
+	objects := map[string][]byte{}
+	// more folders and files than fit in a single page
+	for i := 0; i < 1200; i++ {
+		objects[fmt.Sprintf("artist/album-%04d/track.flac", i)] = []byte{0}
+	}
+	for i := 0; i < 2500; i++ {
+		objects[fmt.Sprintf("singles/track-%04d.mp3", i)] = []byte{0}
+	}
+	fake := newFakeS3("music", objects)
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{})
+	defer closeServer()
+	var folders, files int
+	err := s3dir.Walk(
+		func(relPath string, _ int64, _ time.Time, isDir bool) error {
+			if isDir {
+				folders++
+			} else {
+				files++
+			}
+			return nil
+		},
+		func(string) error { return nil },
+	)
+	if err != nil {
+		t.Fatalf("walking: %v", err)
+	}
+	if files != len(objects) {
+		t.Errorf("expected %d files, got %d", len(objects), files)
+	}
+	// the root, "artist", "singles", and the album folders
+	if expected := 3 + 1200; folders != expected {
+		t.Errorf("expected %d folders, got %d", expected, folders)
+	}
+	// one page for the root, two for "artist", three for "singles", and
+	// one for each of the album folders
+	if expected := 1 + 2 + 3 + 1200; fake.counts().lists != expected {
+		t.Errorf("expected %d list requests, got %d", expected, fake.counts().lists)
+	}
+}
+
+func TestS3KeyPrefix(t *testing.T) {
+	fake := newFakeS3("music", map[string][]byte{
+		"other/track.flac":        {0},
+		"library/a/track.flac":    {1},
+		"library/b/c/track.flac":  {2},
+		"library/b/c/cover.jpg":   {3},
+		"libraryish/no/track.mp3": {4},
+	})
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{KeyPrefix: "/library/"})
+	defer closeServer()
+	var paths []string
+	err := s3dir.Walk(
+		func(relPath string, _ int64, _ time.Time, isDir bool) error {
+			paths = append(paths, relPath)
+			return nil
+		},
+		func(string) error { return nil },
+	)
+	if err != nil {
+		t.Fatalf("walking: %v", err)
+	}
+	expected := []string{
+		".",
+		"a", "a/track.flac",
+		"b", "b/c", "b/c/cover.jpg", "b/c/track.flac",
+	}
+	if strings.Join(paths, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected paths %v, got %v", expected, paths)
+	}
+	_, reader, err := s3dir.GetFile("b/c/cover.jpg")
+	if err != nil {
+		t.Fatalf("getting file: %v", err)
+	}
+	defer reader.Close()
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("reading file: %v", err)
+	}
+	if !bytes.Equal(data, []byte{3}) {
+		t.Errorf("expected the prefixed object, got %v", data)
+	}
+}
+
+func TestS3WalkPath(t *testing.T) {
+	fake := newFakeS3("music", map[string][]byte{
+		"library/a/track.flac":   {1},
+		"library/b/c/track.flac": {2},
+		"library/b/d/track.flac": {3},
+		"library/bc/track.flac":  {4},
+	})
+	s3dir, closeServer := newTestS3Dir(t, fake, S3Options{KeyPrefix: "library"})
+	defer closeServer()
+	var paths []string
+	err := s3dir.WalkPath("b/",
+		func(relPath string, _ int64, _ time.Time, isDir bool) error {
+			paths = append(paths, relPath)
+			return nil
+		},
+		func(string) error { return nil },
+	)
+	if err != nil {
+		t.Fatalf("walking: %v", err)
+	}
+	expected := []string{
+		"b",
+		"b/c", "b/c/track.flac",
+		"b/d", "b/d/track.flac",
+	}
+	if strings.Join(paths, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected paths %v, got %v", expected, paths)
+	}
+}
+
+func TestS3RequesterPays(t *testing.T) {
+	objects := map[string][]byte{"track.flac": {0}}
+	for _, requesterPays := range []bool{false, true} {
+		fake := newFakeS3("music", objects)
+		s3dir, closeServer := newTestS3Dir(t, fake, S3Options{RequesterPays: requesterPays})
+		defer closeServer()
+		err := s3dir.Walk(
+			func(string, int64, time.Time, bool) error { return nil },
+			func(string) error { return nil },
+		)
+		if err != nil {
+			t.Fatalf("walking: %v", err)
+		}
+		_, reader, err := s3dir.GetFile("track.flac")
+		if err != nil {
+			t.Fatalf("getting file: %v", err)
+		}
+		if _, err := ioutil.ReadAll(reader); err != nil {
+			t.Fatalf("reading file: %v", err)
+		}
+		reader.Close()
+		// one list, one head, and one get
+		expected := 0
+		if requesterPays {
+			expected = 3
+		}
+		if fake.counts().payers != expected {
+			t.Errorf("requester pays %t: expected %d acknowledged requests, got %d",
+				requesterPays, expected, fake.counts().payers)
+		}
+	}
+}
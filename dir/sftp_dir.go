@@ -0,0 +1,292 @@
+package dir
+
+import (
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// SFTPOptions configures the server an SFTPDir reads its files from. Host
+// and User are required, and at least one of Password and KeyPath
+type SFTPOptions struct {
+	// Host is the address of the server, with an optional port. eg.
+	// "nas.lan:2222"
+	Host     string
+	User     string
+	Password string
+	// KeyPath is a private key file to authenticate with
+	KeyPath string
+	// KnownHostsPath is an OpenSSH known_hosts file to check the server's
+	// key against. it's ~/.ssh/known_hosts if it's empty
+	KnownHostsPath string
+	// InsecureIgnoreHostKey connects without checking the server's key
+	InsecureIgnoreHostKey bool
+	// Path is the folder on the server to use as the root
+	Path string
+}
+
+// SFTPDir reads files over SFTP. the connection is made when it's created,
+// and made again if it drops
+type SFTPDir struct {
+	host     string
+	root     string
+	config   *ssh.ClientConfig
+	mu       sync.Mutex
+	sshConn  *ssh.Client
+	sftpConn *sftp.Client
+}
+
+func NewSFTPDir(opts SFTPOptions) (*SFTPDir, error) {
+	if opts.Host == "" {
+		return nil, errors.New("no sftp host provided")
+	}
+	if opts.User == "" {
+		return nil, errors.New("no sftp user provided")
+	}
+	var auth []ssh.AuthMethod
+	if opts.KeyPath != "" {
+		key, err := ioutil.ReadFile(opts.KeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading sftp key")
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing sftp key")
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if opts.Password != "" {
+		auth = append(auth, ssh.Password(opts.Password))
+	}
+	if len(auth) == 0 {
+		return nil, errors.New("no sftp password or key provided")
+	}
+	hostKeyCallback, err := sftpHostKeyCallback(opts)
+	if err != nil {
+		return nil, err
+	}
+	host := opts.Host
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, "22")
+	}
+	root := path.Clean("/" + opts.Path)
+	sftpDir := &SFTPDir{
+		host: host,
+		root: root,
+		config: &ssh.ClientConfig{
+			User:            opts.User,
+			Auth:            auth,
+			HostKeyCallback: hostKeyCallback,
+			Timeout:         30 * time.Second,
+		},
+	}
+	// connect now so that bad options are found straight away
+	client, err := sftpDir.client()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := client.Stat(root); err != nil {
+		return nil, errors.Wrapf(err, "stating %q on sftp server", root)
+	}
+	return sftpDir, nil
+}
+
+// sftpHostKeyCallback checks the server's key against the known hosts file.
+// if there isn't one, the server isn't connected to unless checking its key
+// was turned off
+func sftpHostKeyCallback(opts SFTPOptions) (ssh.HostKeyCallback, error) {
+	if opts.InsecureIgnoreHostKey {
+		log.Printf("not checking the host key of sftp server `%s`\n", opts.Host)
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+	knownHostsPath := opts.KnownHostsPath
+	if knownHostsPath == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, errors.Wrap(err, "finding home dir")
+		}
+		knownHostsPath = filepath.Join(home, ".ssh", "known_hosts")
+		if _, err := os.Stat(knownHostsPath); err != nil {
+			return nil, errors.Errorf("no known hosts file to check the key of sftp server `%s` against", opts.Host)
+		}
+	}
+	callback, err := knownhosts.New(knownHostsPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading known hosts")
+	}
+	return callback, nil
+}
+
+// client returns the current connection, or makes a new one if there
+// isn't one
+func (sd *SFTPDir) client() (*sftp.Client, error) {
+	sd.mu.Lock()
+	defer sd.mu.Unlock()
+	if sd.sftpConn != nil {
+		return sd.sftpConn, nil
+	}
+	sshConn, err := ssh.Dial("tcp", sd.host, sd.config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "connecting to sftp server `%s`", sd.host)
+	}
+	sftpConn, err := sftp.NewClient(sshConn)
+	if err != nil {
+		sshConn.Close()
+		return nil, errors.Wrap(err, "starting sftp session")
+	}
+	sd.sshConn = sshConn
+	sd.sftpConn = sftpConn
+	// forget the connection once it's gone, so the next call makes a new one
+	go func() {
+		_ = sshConn.Wait()
+		sd.mu.Lock()
+		defer sd.mu.Unlock()
+		if sd.sshConn == sshConn {
+			sd.sshConn = nil
+			sd.sftpConn = nil
+		}
+	}()
+	return sftpConn, nil
+}
+
+// Close closes the connection to the server
+func (sd *SFTPDir) Close() error {
+	sd.mu.Lock()
+	defer sd.mu.Unlock()
+	if sd.sshConn == nil {
+		return nil
+	}
+	sd.sftpConn.Close()
+	err := sd.sshConn.Close()
+	sd.sshConn = nil
+	sd.sftpConn = nil
+	return err
+}
+
+func (sd *SFTPDir) GetTypeName() string {
+	return "sftp"
+}
+
+func (sd *SFTPDir) fullPath(relPath string) string {
+	return path.Join(sd.root, relPath)
+}
+
+func (sd *SFTPDir) Walk(Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	return sd.WalkPath(".", Callback, PostChildrenCallback)
+}
+
+func (sd *SFTPDir) WalkPath(relPath string, Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	client, err := sd.client()
+	if err != nil {
+		return err
+	}
+	relPath = path.Clean(relPath)
+	stat, err := client.Stat(sd.fullPath(relPath))
+	if err != nil {
+		return errors.Wrap(err, "stating")
+	}
+	return sd.walkFolder(client, relPath, stat, Callback, PostChildrenCallback)
+}
+
+func (sd *SFTPDir) walkFolder(client *sftp.Client, relPath string, stat os.FileInfo, Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	if err := Callback(relPath, stat.Size(), stat.ModTime(), true); err != nil {
+		if err == SkipDir {
+			return nil
+		}
+		return err
+	}
+	entries, err := client.ReadDir(sd.fullPath(relPath))
+	if err != nil {
+		return errors.Wrapf(err, "reading folder %q", relPath)
+	}
+	// the ignore file comes first, so that it applies to everything else
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Name() == IgnoreFilename && entries[j].Name() != IgnoreFilename
+	})
+	for _, entry := range entries {
+		entryPath := path.Join(relPath, entry.Name())
+		if entry.Mode()&os.ModeSymlink != 0 {
+			// follow it, like LocalDir does
+			entry, err = client.Stat(sd.fullPath(entryPath))
+			if err != nil {
+				log.Printf("error following link %q: %v\n", entryPath, err)
+				continue
+			}
+		}
+		if entry.IsDir() {
+			if err := sd.walkFolder(client, entryPath, entry, Callback, PostChildrenCallback); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := Callback(entryPath, entry.Size(), entry.ModTime(), false); err != nil {
+			return err
+		}
+	}
+	return PostChildrenCallback(relPath)
+}
+
+func (sd *SFTPDir) GetFile(path string) (time.Time, ReadSeekCloser, error) {
+	client, err := sd.client()
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	file, err := client.Open(sd.fullPath(path))
+	if err != nil {
+		return time.Time{}, nil, errors.Wrap(err, "Couldn't read file")
+	}
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return time.Time{}, nil, errors.Wrap(err, "Couldn't stat file")
+	}
+	return stat.ModTime(), file, nil
+}
+
+func (sd *SFTPDir) Stat(path string) (FileInfo, error) {
+	client, err := sd.client()
+	if err != nil {
+		return FileInfo{}, err
+	}
+	stat, err := client.Stat(sd.fullPath(path))
+	if err != nil {
+		return FileInfo{}, errors.Wrap(err, "Couldn't stat file")
+	}
+	return FileInfo{
+		Size:    stat.Size(),
+		ModTime: stat.ModTime(),
+	}, nil
+}
+
+func (sd *SFTPDir) GetFileRange(path string, offset, length int64) ([]byte, error) {
+	client, err := sd.client()
+	if err != nil {
+		return nil, err
+	}
+	file, err := client.Open(sd.fullPath(path))
+	if err != nil {
+		return nil, errors.Wrap(err, "Couldn't read file")
+	}
+	defer file.Close()
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "Couldn't seek in file")
+	}
+	data := make([]byte, length)
+	n, err := io.ReadFull(file, data)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, errors.Wrap(err, "Couldn't read range of file")
+	}
+	return data[:n], nil
+}
@@ -0,0 +1,336 @@
+package dir
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// testTree is the music that the remote Dirs are tested against. names
+// with spaces and such are there to check they're escaped properly
+var testTree = map[string][]byte{
+	"top.mp3":                           testData(300),
+	"artist/album (2001)/01 track.flac": testData(5000),
+	"artist/album (2001)/cover.jpg":     testData(100),
+	"artist/album (2001)/cd 2/01.flac":  testData(2000),
+	"ärtist/#1 & more.mp3":              testData(700),
+}
+
+// writeTestTree writes testTree to a new temp dir, with mod times in whole
+// seconds, since that's as precise as the remote Dirs get. callers remove
+// the dir when they're done
+func writeTestTree(t *testing.T) string {
+	t.Helper()
+	root, err := ioutil.TempDir("", "gonic-tree")
+	if err != nil {
+		t.Fatalf("making temp dir: %v", err)
+	}
+	if err := os.Mkdir(filepath.Join(root, "empty"), 0755); err != nil {
+		t.Fatalf("making empty dir: %v", err)
+	}
+	modTime := time.Date(2020, 4, 1, 12, 30, 0, 0, time.UTC)
+	for relPath, data := range testTree {
+		fullPath := filepath.Join(root, filepath.FromSlash(relPath))
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			t.Fatalf("making dir: %v", err)
+		}
+		if err := ioutil.WriteFile(fullPath, data, 0644); err != nil {
+			t.Fatalf("writing file: %v", err)
+		}
+		if err := os.Chtimes(fullPath, modTime, modTime); err != nil {
+			t.Fatalf("setting mod time: %v", err)
+		}
+	}
+	return root
+}
+
+// walkEntries walks d from relPath, and returns a line for every file and
+// folder it was called with, sorted
+func walkEntries(t *testing.T, d Dir, relPath string) []string {
+	t.Helper()
+	var entries []string
+	err := d.WalkPath(relPath,
+		func(relPath string, size int64, modTime time.Time, isDir bool) error {
+			if isDir {
+				entries = append(entries, fmt.Sprintf("%s/", relPath))
+				return nil
+			}
+			entries = append(entries, fmt.Sprintf("%s %d %s",
+				relPath, size, modTime.UTC().Format(time.RFC3339)))
+			return nil
+		},
+		func(string) error { return nil },
+	)
+	if err != nil {
+		t.Fatalf("walking: %v", err)
+	}
+	sort.Strings(entries)
+	return entries
+}
+
+// testRemoteDir checks that a remote Dir over the tree at root behaves the
+// same as a LocalDir over it
+func testRemoteDir(t *testing.T, root string, remote Dir) {
+	t.Helper()
+	local, err := NewLocalDir(root)
+	if err != nil {
+		t.Fatalf("making local dir: %v", err)
+	}
+	for _, relPath := range []string{".", "artist", "artist/album (2001)"} {
+		exp := walkEntries(t, local, relPath)
+		if relPath == "." && len(exp) != len(testTree)+6 {
+			t.Fatalf("expected every file and folder in the local walk, got %q", exp)
+		}
+		if act := walkEntries(t, remote, relPath); !reflect.DeepEqual(act, exp) {
+			t.Errorf("walking %q: expected\n%q\ngot\n%q", relPath, exp, act)
+		}
+	}
+	for relPath, data := range testTree {
+		expModTime, _, _ := local.GetFile(relPath)
+		modTime, reader, err := remote.GetFile(relPath)
+		if err != nil {
+			t.Fatalf("getting %q: %v", relPath, err)
+		}
+		got, err := ioutil.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			t.Fatalf("reading %q: %v", relPath, err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("contents of %q don't match", relPath)
+		}
+		if !modTime.Equal(expModTime) {
+			t.Errorf("expected %q to be modified at %v, got %v", relPath, expModTime, modTime)
+		}
+		info, err := remote.Stat(relPath)
+		if err != nil {
+			t.Fatalf("stating %q: %v", relPath, err)
+		}
+		if info.Size != int64(len(data)) || !info.ModTime.Equal(expModTime) {
+			t.Errorf("unexpected stat for %q: %+v", relPath, info)
+		}
+	}
+	const relPath = "artist/album (2001)/01 track.flac"
+	data := testTree[relPath]
+	got, err := remote.GetFileRange(relPath, 1000, 500)
+	if err != nil {
+		t.Fatalf("getting range: %v", err)
+	}
+	if !bytes.Equal(got, data[1000:1500]) {
+		t.Errorf("range doesn't match")
+	}
+	// ranges past the end are cut short
+	got, err = remote.GetFileRange(relPath, int64(len(data))-10, 100)
+	if err != nil {
+		t.Fatalf("getting range past end: %v", err)
+	}
+	if !bytes.Equal(got, data[len(data)-10:]) {
+		t.Errorf("expected the last 10 bytes, got %d", len(got))
+	}
+	if _, err := remote.Stat("missing.mp3"); err == nil {
+		t.Errorf("expected an error stating a missing file")
+	}
+}
+
+const (
+	testSFTPUser     = "gonic"
+	testSFTPPassword = "hunter2"
+)
+
+// startSFTPServer starts an SSH server which serves the local filesystem
+// over SFTP, and returns its address, a known_hosts file with its key, and
+// a func to stop it and remove the file
+func startSFTPServer(t *testing.T) (string, string, func()) {
+	t.Helper()
+	hostKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating host key: %v", err)
+	}
+	signer, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		t.Fatalf("making signer: %v", err)
+	}
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(meta ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if meta.User() == testSFTPUser && string(password) == testSFTPPassword {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("bad password for %q", meta.User())
+		},
+	}
+	config.AddHostKey(signer)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+	addr := listener.Addr().String()
+	knownHosts, err := ioutil.TempFile("", "gonic-known-hosts")
+	if err != nil {
+		listener.Close()
+		t.Fatalf("making known hosts: %v", err)
+	}
+	fmt.Fprintln(knownHosts, knownhosts.Line([]string{addr}, signer.PublicKey()))
+	knownHosts.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSFTPConn(conn, config)
+		}
+	}()
+	return addr, knownHosts.Name(), func() {
+		listener.Close()
+		os.Remove(knownHosts.Name())
+	}
+}
+
+func serveSFTPConn(conn net.Conn, config *ssh.ServerConfig) {
+	serverConn, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		conn.Close()
+		return
+	}
+	defer serverConn.Close()
+	go ssh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "only sessions")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func(requests <-chan *ssh.Request) {
+			for req := range requests {
+				// the payload is the subsystem's name, prefixed with its length
+				ok := req.Type == "subsystem" && string(req.Payload[4:]) == "sftp"
+				req.Reply(ok, nil)
+			}
+		}(requests)
+		go func() {
+			defer channel.Close()
+			server, err := sftp.NewServer(channel, sftp.ReadOnly())
+			if err != nil {
+				return
+			}
+			_ = server.Serve()
+		}()
+	}
+}
+
+func TestSFTPDir(t *testing.T) {
+	root := writeTestTree(t)
+	defer os.RemoveAll(root)
+	addr, knownHosts, stopServer := startSFTPServer(t)
+	defer stopServer()
+	sftpDir, err := NewSFTPDir(SFTPOptions{
+		Host:           addr,
+		User:           testSFTPUser,
+		Password:       testSFTPPassword,
+		KnownHostsPath: knownHosts,
+		Path:           root,
+	})
+	if err != nil {
+		t.Fatalf("making sftp dir: %v", err)
+	}
+	defer sftpDir.Close()
+	testRemoteDir(t, root, sftpDir)
+}
+
+func TestSFTPDirReconnects(t *testing.T) {
+	root := writeTestTree(t)
+	defer os.RemoveAll(root)
+	addr, _, stopServer := startSFTPServer(t)
+	defer stopServer()
+	sftpDir, err := NewSFTPDir(SFTPOptions{
+		Host:                  addr,
+		User:                  testSFTPUser,
+		Password:              testSFTPPassword,
+		InsecureIgnoreHostKey: true,
+		Path:                  root,
+	})
+	if err != nil {
+		t.Fatalf("making sftp dir: %v", err)
+	}
+	defer sftpDir.Close()
+	// drop the connection from under it
+	sftpDir.mu.Lock()
+	sftpDir.sshConn.Close()
+	sftpDir.mu.Unlock()
+	var info FileInfo
+	for i := 0; i < 50; i++ {
+		if info, err = sftpDir.Stat("top.mp3"); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatalf("expected to reconnect, got: %v", err)
+	}
+	if info.Size != int64(len(testTree["top.mp3"])) {
+		t.Errorf("unexpected size %d", info.Size)
+	}
+}
+
+func TestSFTPDirBadPassword(t *testing.T) {
+	root := writeTestTree(t)
+	defer os.RemoveAll(root)
+	addr, knownHosts, stopServer := startSFTPServer(t)
+	defer stopServer()
+	_, err := NewSFTPDir(SFTPOptions{
+		Host:           addr,
+		User:           testSFTPUser,
+		Password:       "wrong",
+		KnownHostsPath: knownHosts,
+		Path:           root,
+	})
+	if err == nil {
+		t.Fatalf("expected an error with the wrong password")
+	}
+}
+
+func TestSFTPDirChecksHostKey(t *testing.T) {
+	root := writeTestTree(t)
+	defer os.RemoveAll(root)
+	addr, _, stopServer := startSFTPServer(t)
+	defer stopServer()
+	// the known hosts of another server, with a different key
+	_, otherKnownHosts, stopOther := startSFTPServer(t)
+	defer stopOther()
+	data, err := ioutil.ReadFile(otherKnownHosts)
+	if err != nil {
+		t.Fatalf("reading known hosts: %v", err)
+	}
+	fields := bytes.Fields(data)
+	line := fmt.Sprintf("%s %s %s\n", knownhosts.Normalize(addr), fields[1], fields[2])
+	if err := ioutil.WriteFile(otherKnownHosts, []byte(line), 0644); err != nil {
+		t.Fatalf("writing known hosts: %v", err)
+	}
+	_, err = NewSFTPDir(SFTPOptions{
+		Host:           addr,
+		User:           testSFTPUser,
+		Password:       testSFTPPassword,
+		KnownHostsPath: otherKnownHosts,
+		Path:           root,
+	})
+	if err == nil {
+		t.Fatalf("expected an error with the wrong host key")
+	}
+}
@@ -0,0 +1,333 @@
+package dir
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WebDAVOptions configures the server a WebDAVDir reads its files from
+type WebDAVOptions struct {
+	// URL is the folder on the server to use as the root. eg.
+	// "https://nas.lan/remote.php/dav/files/me/Music"
+	URL      string
+	User     string
+	Password string
+	// Client is used for requests if set
+	Client *http.Client
+}
+
+// WebDAVDir reads files from a WebDAV server. folders are listed with
+// PROPFIND, and files are read with ranged GETs
+type WebDAVDir struct {
+	root     *url.URL
+	user     string
+	password string
+	client   *http.Client
+}
+
+func NewWebDAVDir(opts WebDAVOptions) (*WebDAVDir, error) {
+	if opts.URL == "" {
+		return nil, errors.New("no webdav url provided")
+	}
+	root, err := url.Parse(opts.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing webdav url")
+	}
+	if root.Scheme != "http" && root.Scheme != "https" {
+		return nil, errors.Errorf("webdav url %q isn't http or https", opts.URL)
+	}
+	// the root is a folder, so make sure relative paths resolve into it
+	root.Path = strings.TrimSuffix(root.Path, "/") + "/"
+	if root.User != nil && opts.User == "" {
+		opts.User = root.User.Username()
+		opts.Password, _ = root.User.Password()
+	}
+	root.User = nil
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{}
+	}
+	webdavDir := &WebDAVDir{
+		root:     root,
+		user:     opts.User,
+		password: opts.Password,
+		client:   client,
+	}
+	// make sure the root is there, so that bad options are found
+	// straight away
+	if _, err := webdavDir.propfind(".", true, "0"); err != nil {
+		return nil, err
+	}
+	return webdavDir, nil
+}
+
+func (wd *WebDAVDir) GetTypeName() string {
+	return "webdav"
+}
+
+// davEntry is a file or folder from a PROPFIND response
+type davEntry struct {
+	relPath string
+	isDir   bool
+	size    int64
+	modTime time.Time
+	etag    string
+}
+
+type davMultistatus struct {
+	Responses []davResponse `xml:"DAV: response"`
+}
+
+type davResponse struct {
+	Href      string        `xml:"DAV: href"`
+	Propstats []davPropstat `xml:"DAV: propstat"`
+}
+
+type davPropstat struct {
+	Status string  `xml:"DAV: status"`
+	Prop   davProp `xml:"DAV: prop"`
+}
+
+type davProp struct {
+	ResourceType struct {
+		Collection *struct{} `xml:"DAV: collection"`
+	} `xml:"DAV: resourcetype"`
+	ContentLength string `xml:"DAV: getcontentlength"`
+	LastModified  string `xml:"DAV: getlastmodified"`
+	ETag          string `xml:"DAV: getetag"`
+}
+
+const davPropfindBody = `<?xml version="1.0" encoding="utf-8"?>
+<propfind xmlns="DAV:"><prop>
+<resourcetype/><getcontentlength/><getlastmodified/><getetag/>
+</prop></propfind>`
+
+// url returns the address of relPath. folders need a trailing slash, some
+// servers redirect to it otherwise
+func (wd *WebDAVDir) url(relPath string, isDir bool) string {
+	ref := &url.URL{Path: strings.TrimPrefix(path.Clean(relPath), ".")}
+	ref.Path = strings.TrimPrefix(ref.Path, "/")
+	if isDir && ref.Path != "" {
+		ref.Path += "/"
+	}
+	return wd.root.ResolveReference(ref).String()
+}
+
+func (wd *WebDAVDir) newRequest(method, relPath string, isDir bool, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, wd.url(relPath, isDir), body)
+	if err != nil {
+		return nil, err
+	}
+	if wd.user != "" {
+		req.SetBasicAuth(wd.user, wd.password)
+	}
+	return req, nil
+}
+
+// propfind lists the file or folder at relPath, and the entries in it if
+// depth is "1". the entry for relPath itself comes first
+func (wd *WebDAVDir) propfind(relPath string, isDir bool, depth string) ([]davEntry, error) {
+	req, err := wd.newRequest("PROPFIND", relPath, isDir, strings.NewReader(davPropfindBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Depth", depth)
+	req.Header.Set("Content-Type", "application/xml; charset=utf-8")
+	resp, err := wd.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing %q on webdav server", relPath)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, errors.Errorf("listing %q on webdav server: %s", relPath, resp.Status)
+	}
+	var multistatus davMultistatus
+	if err := xml.NewDecoder(resp.Body).Decode(&multistatus); err != nil {
+		return nil, errors.Wrapf(err, "parsing listing of %q", relPath)
+	}
+	var self []davEntry
+	var children []davEntry
+	for _, response := range multistatus.Responses {
+		entry, err := wd.parseResponse(response)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing listing of %q", relPath)
+		}
+		if entry.relPath == path.Clean(relPath) {
+			self = append(self, entry)
+			continue
+		}
+		children = append(children, entry)
+	}
+	if len(self) == 0 {
+		return nil, errors.Errorf("listing %q on webdav server: not in the response", relPath)
+	}
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].relPath < children[j].relPath
+	})
+	return append(self[:1], children...), nil
+}
+
+func (wd *WebDAVDir) parseResponse(response davResponse) (davEntry, error) {
+	href, err := url.Parse(strings.TrimSpace(response.Href))
+	if err != nil {
+		return davEntry{}, err
+	}
+	// hrefs can be absolute paths or whole urls. either way, the path is
+	// under the root's path
+	hrefPath := wd.root.ResolveReference(href).Path
+	rootPath := wd.root.Path
+	if !strings.HasPrefix(hrefPath+"/", rootPath) {
+		return davEntry{}, errors.Errorf("href %q isn't under the root", response.Href)
+	}
+	relPath := strings.Trim(strings.TrimPrefix(hrefPath, rootPath), "/")
+	if relPath == "" {
+		relPath = "."
+	}
+	entry := davEntry{relPath: relPath}
+	for _, propstat := range response.Propstats {
+		// props the server doesn't have come back in a propstat of their own
+		// with a 404 status
+		if fields := strings.Fields(propstat.Status); len(fields) > 1 && fields[1] != "200" {
+			continue
+		}
+		prop := propstat.Prop
+		if prop.ResourceType.Collection != nil {
+			entry.isDir = true
+		}
+		if prop.ContentLength != "" {
+			entry.size, _ = strconv.ParseInt(strings.TrimSpace(prop.ContentLength), 10, 64)
+		}
+		if prop.LastModified != "" {
+			entry.modTime, _ = http.ParseTime(strings.TrimSpace(prop.LastModified))
+		}
+		if prop.ETag != "" {
+			entry.etag = strings.TrimSpace(prop.ETag)
+		}
+	}
+	return entry, nil
+}
+
+func (wd *WebDAVDir) Walk(Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	return wd.WalkPath(".", Callback, PostChildrenCallback)
+}
+
+func (wd *WebDAVDir) WalkPath(relPath string, Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	entries, err := wd.propfind(path.Clean(relPath), true, "0")
+	if err != nil {
+		return err
+	}
+	return wd.walkFolder(entries[0], Callback, PostChildrenCallback)
+}
+
+// walkFolder walks the folder self, which came from its parent's listing.
+// that way skipped folders are never listed
+func (wd *WebDAVDir) walkFolder(self davEntry, Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	if err := Callback(self.relPath, self.size, self.modTime, true); err != nil {
+		if err == SkipDir {
+			return nil
+		}
+		return err
+	}
+	entries, err := wd.propfind(self.relPath, true, "1")
+	if err != nil {
+		return err
+	}
+	children := entries[1:]
+	// the ignore file comes first, so that it applies to everything else
+	sort.SliceStable(children, func(i, j int) bool {
+		return path.Base(children[i].relPath) == IgnoreFilename &&
+			path.Base(children[j].relPath) != IgnoreFilename
+	})
+	for _, entry := range children {
+		if entry.isDir {
+			if err := wd.walkFolder(entry, Callback, PostChildrenCallback); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := Callback(entry.relPath, entry.size, entry.modTime, false); err != nil {
+			return err
+		}
+	}
+	return PostChildrenCallback(self.relPath)
+}
+
+func (wd *WebDAVDir) GetFile(path string) (time.Time, ReadSeekCloser, error) {
+	// like S3Dir, the contents are only requested as the reader is consumed
+	info, err := wd.Stat(path)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	reader := &rangeReader{
+		open: func(offset int64) (io.ReadCloser, error) {
+			return wd.get(path, fmt.Sprintf("bytes=%d-", offset))
+		},
+		name: path,
+		size: info.Size,
+	}
+	return info.ModTime, reader, nil
+}
+
+// get requests a range of the file at relPath
+func (wd *WebDAVDir) get(relPath string, byteRange string) (io.ReadCloser, error) {
+	req, err := wd.newRequest(http.MethodGet, relPath, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Range", byteRange)
+	resp, err := wd.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to get file `%v` from webdav server", relPath)
+	}
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		return resp.Body, nil
+	case http.StatusRequestedRangeNotSatisfiable:
+		// asked for past the end
+		resp.Body.Close()
+		return ioutil.NopCloser(strings.NewReader("")), nil
+	default:
+		// a server which ignores ranges would send the wrong bytes
+		resp.Body.Close()
+		return nil, errors.Errorf("Failed to get range of file `%v` from webdav server: %s", relPath, resp.Status)
+	}
+}
+
+func (wd *WebDAVDir) Stat(path string) (FileInfo, error) {
+	entries, err := wd.propfind(path, false, "0")
+	if err != nil {
+		return FileInfo{}, errors.Wrap(err, "Couldn't stat file")
+	}
+	return FileInfo{
+		Size:    entries[0].size,
+		ModTime: entries[0].modTime,
+		ETag:    entries[0].etag,
+	}, nil
+}
+
+func (wd *WebDAVDir) GetFileRange(path string, offset, length int64) ([]byte, error) {
+	if length <= 0 {
+		return nil, nil
+	}
+	body, err := wd.get(path, fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	data, err := ioutil.ReadAll(io.LimitReader(body, length))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to read range of file `%v` from webdav server", path)
+	}
+	return data, nil
+}
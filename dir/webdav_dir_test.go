@@ -0,0 +1,152 @@
+package dir
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testWebDAVUser     = "gonic"
+	testWebDAVPassword = "hunter2"
+)
+
+// fakeWebDAV serves a local folder with just enough of WebDAV (PROPFIND
+// and ranged GETs) for WebDAVDir to be tested against. it's mounted under
+// prefix, like most servers do
+type fakeWebDAV struct {
+	root   string
+	prefix string
+}
+
+type fakeDAVResponse struct {
+	XMLName      xml.Name  `xml:"D:response"`
+	Href         string    `xml:"D:href"`
+	Collection   *struct{} `xml:"D:propstat>D:prop>D:resourcetype>D:collection"`
+	Length       int64     `xml:"D:propstat>D:prop>D:getcontentlength,omitempty"`
+	LastModified string    `xml:"D:propstat>D:prop>D:getlastmodified"`
+	ETag         string    `xml:"D:propstat>D:prop>D:getetag,omitempty"`
+	Status       string    `xml:"D:propstat>D:status"`
+}
+
+type fakeDAVMultistatus struct {
+	XMLName   xml.Name `xml:"D:multistatus"`
+	Namespace string   `xml:"xmlns:D,attr"`
+	Responses []fakeDAVResponse
+}
+
+func (f *fakeWebDAV) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, password, ok := r.BasicAuth()
+	if !ok || user != testWebDAVUser || password != testWebDAVPassword {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, f.prefix) {
+		http.NotFound(w, r)
+		return
+	}
+	relPath := strings.TrimPrefix(r.URL.Path, f.prefix)
+	fullPath := filepath.Join(f.root, filepath.FromSlash(relPath))
+	switch r.Method {
+	case "PROPFIND":
+		f.servePropfind(w, r, relPath, fullPath)
+	case http.MethodGet:
+		if r.Header.Get("Range") == "" {
+			// WebDAVDir should always ask for a range
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		http.ServeFile(w, r, fullPath)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeWebDAV) servePropfind(w http.ResponseWriter, r *http.Request, relPath, fullPath string) {
+	info, err := os.Stat(fullPath)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	response := func(relPath string, info os.FileInfo) fakeDAVResponse {
+		href := &url.URL{Path: path.Join(f.prefix, relPath)}
+		resp := fakeDAVResponse{
+			Href:         href.String(),
+			LastModified: info.ModTime().UTC().Format(http.TimeFormat),
+			Status:       "HTTP/1.1 200 OK",
+		}
+		if info.IsDir() {
+			resp.Href += "/"
+			resp.Collection = &struct{}{}
+			return resp
+		}
+		resp.Length = info.Size()
+		resp.ETag = `"` + info.ModTime().String() + `"`
+		return resp
+	}
+	multistatus := fakeDAVMultistatus{Namespace: "DAV:"}
+	multistatus.Responses = append(multistatus.Responses, response(relPath, info))
+	if info.IsDir() && r.Header.Get("Depth") == "1" {
+		file, err := os.Open(fullPath)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		children, err := file.Readdir(-1)
+		file.Close()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		for _, child := range children {
+			multistatus.Responses = append(multistatus.Responses,
+				response(path.Join(relPath, child.Name()), child))
+		}
+	}
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	_ = xml.NewEncoder(w).Encode(multistatus)
+}
+
+func newTestWebDAVDir(server *httptest.Server, password string) (*WebDAVDir, error) {
+	return NewWebDAVDir(WebDAVOptions{
+		URL:      server.URL + "/dav/files",
+		User:     testWebDAVUser,
+		Password: password,
+	})
+}
+
+func TestWebDAVDir(t *testing.T) {
+	root := writeTestTree(t)
+	defer os.RemoveAll(root)
+	server := httptest.NewServer(&fakeWebDAV{root: root, prefix: "/dav/files/"})
+	defer server.Close()
+	webdavDir, err := newTestWebDAVDir(server, testWebDAVPassword)
+	if err != nil {
+		t.Fatalf("making webdav dir: %v", err)
+	}
+	testRemoteDir(t, root, webdavDir)
+	info, err := webdavDir.Stat("top.mp3")
+	if err != nil {
+		t.Fatalf("stating: %v", err)
+	}
+	if info.ETag == "" {
+		t.Errorf("expected an etag")
+	}
+}
+
+func TestWebDAVDirBadPassword(t *testing.T) {
+	root := writeTestTree(t)
+	defer os.RemoveAll(root)
+	server := httptest.NewServer(&fakeWebDAV{root: root, prefix: "/dav/files/"})
+	defer server.Close()
+	if _, err := newTestWebDAVDir(server, "wrong"); err == nil {
+		t.Fatalf("expected an error with the wrong password")
+	}
+}
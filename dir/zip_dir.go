@@ -0,0 +1,564 @@
+package dir
+
+import (
+	"archive/zip"
+	"compress/flate"
+	"container/list"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// zipOpenArchives is how many archives a ZipDir keeps open. the scanner
+// reads from the members of one archive at a time, so it doesn't need many
+const zipOpenArchives = 8
+
+// ZipDir wraps another Dir, and makes the zip archives in it look like
+// folders. their members are walked as if they were extracted next to the
+// archive, eg. "artist/album.zip/01.flac", and can be read with GetFile and
+// GetFileRange like any other file. only stored and deflated members are
+// supported, and archives inside archives are left as files.
+// everything else goes straight to the wrapped Dir
+type ZipDir struct {
+	Dir
+	mu       sync.Mutex
+	lru      *list.List               // of *zipArchive, most recent first
+	archives map[string]*list.Element // path -> element in lru
+}
+
+func NewZipDir(inner Dir) *ZipDir {
+	return &ZipDir{
+		Dir:      inner,
+		lru:      list.New(),
+		archives: map[string]*list.Element{},
+	}
+}
+
+func isZipName(name string) bool {
+	return strings.EqualFold(path.Ext(name), ".zip")
+}
+
+// splitArchivePath splits a path inside an archive into the archive's
+// path and the member's. member is "." for the archive itself
+func splitArchivePath(relPath string) (archive, member string, ok bool) {
+	parts := strings.Split(path.Clean(relPath), "/")
+	for i, part := range parts {
+		if isZipName(part) {
+			archive = strings.Join(parts[:i+1], "/")
+			member = path.Join(append([]string{"."}, parts[i+1:]...)...)
+			return archive, member, true
+		}
+	}
+	return "", "", false
+}
+
+// zipNode is a file or folder in an archive
+type zipNode struct {
+	name     string
+	file     *zip.File // nil for folders
+	children map[string]*zipNode
+}
+
+func (n *zipNode) child(name string) *zipNode {
+	if n.children == nil {
+		n.children = map[string]*zipNode{}
+	}
+	c, ok := n.children[name]
+	if !ok {
+		c = &zipNode{name: name}
+		n.children[name] = c
+	}
+	return c
+}
+
+func (n *zipNode) sortedChildren() []*zipNode {
+	ret := make([]*zipNode, 0, len(n.children))
+	for _, c := range n.children {
+		ret = append(ret, c)
+	}
+	// the ignore file comes first, so that it applies to everything else
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].name == IgnoreFilename || ret[j].name == IgnoreFilename {
+			return ret[i].name == IgnoreFilename
+		}
+		return ret[i].name < ret[j].name
+	})
+	return ret
+}
+
+// zipArchive is an open archive. file is used to read the central
+// directory and local file headers. member data is read with readers of
+// its own, so that streaming doesn't hold up the scanner
+type zipArchive struct {
+	path        string
+	info        FileInfo
+	file        ReadSeekCloser
+	root        *zipNode
+	dataOffsets map[*zip.File]int64
+	mu          sync.Mutex // guards file and dataOffsets
+}
+
+// ReadAt lets archive/zip read from the archive
+func (a *zipArchive) ReadAt(p []byte, off int64) (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.file.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	n, err := io.ReadFull(a.file, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (a *zipArchive) dataOffset(f *zip.File) (int64, error) {
+	a.mu.Lock()
+	offset, ok := a.dataOffsets[f]
+	a.mu.Unlock()
+	if ok {
+		return offset, nil
+	}
+	// this reads the local header through ReadAt, so mu can't be held
+	offset, err := f.DataOffset()
+	if err != nil {
+		return 0, err
+	}
+	a.mu.Lock()
+	a.dataOffsets[f] = offset
+	a.mu.Unlock()
+	return offset, nil
+}
+
+// matches checks that the archive is the same version as info. walks
+// don't give us ETags, so they're only compared if both have one
+func (a *zipArchive) matches(info FileInfo) bool {
+	if a.info.Size != info.Size || !a.info.ModTime.Equal(info.ModTime) {
+		return false
+	}
+	return a.info.ETag == "" || info.ETag == "" || a.info.ETag == info.ETag
+}
+
+// find returns the node at member, which is "." for the root
+func (a *zipArchive) find(member string) (*zipNode, bool) {
+	node := a.root
+	if member == "." {
+		return node, true
+	}
+	for _, part := range strings.Split(member, "/") {
+		var ok bool
+		if node, ok = node.children[part]; !ok {
+			return nil, false
+		}
+	}
+	return node, true
+}
+
+// memberInfo is the FileInfo of a member. it has the archive's mod time
+// rather than its own, so that replacing an archive with one which has
+// older members still looks like a change
+func (a *zipArchive) memberInfo(f *zip.File) FileInfo {
+	info := FileInfo{
+		Size:    int64(f.UncompressedSize64),
+		ModTime: a.info.ModTime,
+	}
+	if a.info.ETag != "" {
+		info.ETag = fmt.Sprintf("%s-%08x", a.info.ETag, f.CRC32)
+	}
+	return info
+}
+
+// readZipTree makes a tree of the members of an archive. unsafe names,
+// like ones with "..", are left out, as are the resource forks macOS adds
+func readZipTree(files []*zip.File) *zipNode {
+	root := &zipNode{}
+	for _, f := range files {
+		name := strings.Trim(strings.Replace(f.Name, `\`, "/", -1), "/")
+		if name == "" || strings.HasPrefix(f.Name, "/") {
+			continue
+		}
+		parts := strings.Split(name, "/")
+		skip := false
+		for _, part := range parts {
+			if part == "" || part == "." || part == ".." || part == "__MACOSX" {
+				skip = true
+				break
+			}
+		}
+		if skip {
+			continue
+		}
+		node := root
+		for _, part := range parts {
+			node = node.child(part)
+		}
+		if !strings.HasSuffix(f.Name, "/") && len(node.children) == 0 {
+			node.file = f
+		}
+	}
+	return root
+}
+
+// open returns the archive at relPath, reusing an open one if it hasn't
+// changed since
+func (zd *ZipDir) open(relPath string, info FileInfo) (*zipArchive, error) {
+	zd.mu.Lock()
+	if el, ok := zd.archives[relPath]; ok {
+		archive := el.Value.(*zipArchive)
+		if archive.matches(info) {
+			zd.lru.MoveToFront(el)
+			zd.mu.Unlock()
+			return archive, nil
+		}
+		zd.drop(el)
+	}
+	zd.mu.Unlock()
+
+	_, file, err := zd.Dir.GetFile(relPath)
+	if err != nil {
+		return nil, err
+	}
+	archive := &zipArchive{
+		path:        relPath,
+		info:        info,
+		file:        file,
+		dataOffsets: map[*zip.File]int64{},
+	}
+	reader, err := zip.NewReader(archive, info.Size)
+	if err != nil {
+		file.Close()
+		return nil, errors.Wrapf(err, "reading archive `%s`", relPath)
+	}
+	archive.root = readZipTree(reader.File)
+
+	zd.mu.Lock()
+	defer zd.mu.Unlock()
+	if el, ok := zd.archives[relPath]; ok {
+		// someone else opened it at the same time
+		zd.drop(el)
+	}
+	zd.archives[relPath] = zd.lru.PushFront(archive)
+	for zd.lru.Len() > zipOpenArchives {
+		zd.drop(zd.lru.Back())
+	}
+	return archive, nil
+}
+
+// drop closes an archive. it must be called with mu held
+func (zd *ZipDir) drop(el *list.Element) {
+	archive := zd.lru.Remove(el).(*zipArchive)
+	delete(zd.archives, archive.path)
+	archive.mu.Lock()
+	archive.file.Close()
+	archive.mu.Unlock()
+}
+
+// member returns the archive and file at relPath, which must be a path
+// inside an archive
+func (zd *ZipDir) member(relPath string) (*zipArchive, *zip.File, error) {
+	archivePath, member, ok := splitArchivePath(relPath)
+	if !ok || member == "." {
+		return nil, nil, errors.Errorf("`%s` isn't in an archive", relPath)
+	}
+	info, err := zd.Dir.Stat(archivePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	archive, err := zd.open(archivePath, info)
+	if err != nil {
+		return nil, nil, err
+	}
+	node, ok := archive.find(member)
+	if !ok || node.file == nil {
+		return nil, nil, errors.Errorf("`%s` not found in archive `%s`", member, archivePath)
+	}
+	return archive, node.file, nil
+}
+
+func (zd *ZipDir) Walk(Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	return zd.WalkPath(".", Callback, PostChildrenCallback)
+}
+
+func (zd *ZipDir) WalkPath(relPath string, Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	if archivePath, member, ok := splitArchivePath(relPath); ok {
+		info, err := zd.Dir.Stat(archivePath)
+		if err != nil {
+			return err
+		}
+		archive, err := zd.open(archivePath, info)
+		if err != nil {
+			return err
+		}
+		node, ok := archive.find(member)
+		if !ok || node.file != nil {
+			return errors.Errorf("`%s` is not a folder in archive `%s`", member, archivePath)
+		}
+		return zd.walkNode(archive, node, path.Clean(relPath), Callback, PostChildrenCallback)
+	}
+	return zd.Dir.WalkPath(relPath,
+		func(relPath string, fileSize int64, modTime time.Time, isDirectory bool) error {
+			if isDirectory || !isZipName(relPath) {
+				return Callback(relPath, fileSize, modTime, isDirectory)
+			}
+			// the walk gives us what we'd get from Stat, apart from the ETag
+			info := FileInfo{Size: fileSize, ModTime: modTime}
+			archive, err := zd.open(relPath, info)
+			if err != nil {
+				log.Printf("error opening archive, leaving it as a file: %v\n", err)
+				return Callback(relPath, fileSize, modTime, isDirectory)
+			}
+			return zd.walkNode(archive, archive.root, relPath, Callback, PostChildrenCallback)
+		},
+		PostChildrenCallback,
+	)
+}
+
+func (zd *ZipDir) walkNode(archive *zipArchive, node *zipNode, relPath string, Callback WalkFunc, PostChildrenCallback PostWalkFunc) error {
+	var size int64
+	if node == archive.root {
+		size = archive.info.Size
+	}
+	if err := Callback(relPath, size, archive.info.ModTime, true); err != nil {
+		if err == SkipDir {
+			return nil
+		}
+		return err
+	}
+	for _, child := range node.sortedChildren() {
+		childPath := path.Join(relPath, child.name)
+		if child.file == nil {
+			if err := zd.walkNode(archive, child, childPath, Callback, PostChildrenCallback); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := Callback(childPath, int64(child.file.UncompressedSize64), archive.info.ModTime, false); err != nil {
+			return err
+		}
+	}
+	return PostChildrenCallback(relPath)
+}
+
+func (zd *ZipDir) GetFile(path string) (time.Time, ReadSeekCloser, error) {
+	if _, member, ok := splitArchivePath(path); !ok || member == "." {
+		return zd.Dir.GetFile(path)
+	}
+	archive, f, err := zd.member(path)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	offset, err := archive.dataOffset(f)
+	if err != nil {
+		return time.Time{}, nil, errors.Wrap(err, "reading member header")
+	}
+	// the member's data gets a reader of its own on the archive
+	_, file, err := zd.Dir.GetFile(archive.path)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	data := &sectionReader{
+		file: file,
+		base: offset,
+		size: int64(f.CompressedSize64),
+	}
+	switch f.Method {
+	case zip.Store:
+		return archive.info.ModTime, data, nil
+	case zip.Deflate:
+		return archive.info.ModTime, &inflateReader{
+			data: data,
+			size: int64(f.UncompressedSize64),
+		}, nil
+	default:
+		file.Close()
+		return time.Time{}, nil, errors.Errorf("unsupported compression method %d for `%s`", f.Method, path)
+	}
+}
+
+func (zd *ZipDir) Stat(path string) (FileInfo, error) {
+	if _, member, ok := splitArchivePath(path); !ok || member == "." {
+		return zd.Dir.Stat(path)
+	}
+	archive, f, err := zd.member(path)
+	if err != nil {
+		return FileInfo{}, err
+	}
+	return archive.memberInfo(f), nil
+}
+
+func (zd *ZipDir) GetFileRange(path string, offset, length int64) ([]byte, error) {
+	if _, member, ok := splitArchivePath(path); !ok || member == "." {
+		return zd.Dir.GetFileRange(path, offset, length)
+	}
+	archive, f, err := zd.member(path)
+	if err != nil {
+		return nil, err
+	}
+	size := int64(f.UncompressedSize64)
+	if offset >= size || length <= 0 {
+		return nil, nil
+	}
+	if offset+length > size {
+		length = size - offset
+	}
+	if f.Method == zip.Store {
+		// stored members are a range of the archive
+		dataOffset, err := archive.dataOffset(f)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading member header")
+		}
+		return zd.Dir.GetFileRange(archive.path, dataOffset+offset, length)
+	}
+	_, reader, err := zd.GetFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	if _, err := reader.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	data := make([]byte, length)
+	n, err := io.ReadFull(reader, data)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, errors.Wrap(err, "Couldn't read range of member")
+	}
+	return data[:n], nil
+}
+
+// Watch watches the wrapped Dir, if it can be watched
+func (zd *ZipDir) Watch(done <-chan struct{}, debounce time.Duration, fn WatchFunc) error {
+	watcher, ok := zd.Dir.(Watcher)
+	if !ok {
+		return ErrWatchUnsupported
+	}
+	return watcher.Watch(done, debounce, fn)
+}
+
+// sectionReader is a ReadSeekCloser over part of another one
+type sectionReader struct {
+	file ReadSeekCloser
+	base int64
+	size int64
+	// offset is where the next read is from. file is only seeked when a
+	// read doesn't follow on from the last one
+	offset  int64
+	seeked  bool
+	fileOff int64
+}
+
+func (r *sectionReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+	if left := r.size - r.offset; int64(len(p)) > left {
+		p = p[:left]
+	}
+	if !r.seeked || r.fileOff != r.offset {
+		if _, err := r.file.Seek(r.base+r.offset, io.SeekStart); err != nil {
+			return 0, err
+		}
+		r.seeked = true
+	}
+	n, err := r.file.Read(p)
+	r.offset += int64(n)
+	r.fileOff = r.offset
+	if err == io.EOF && r.offset < r.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (r *sectionReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("section reader: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("section reader: negative position")
+	}
+	r.offset = offset
+	return offset, nil
+}
+
+func (r *sectionReader) Close() error {
+	return r.file.Close()
+}
+
+// inflateReader is a ReadSeekCloser over a deflated member. deflate streams
+// can't be seeked in, so seeking forward reads up to the new offset, and
+// seeking back starts again from the start
+type inflateReader struct {
+	data *sectionReader
+	size int64
+	// offset is where the next read is from, and pos is where z is up to
+	offset int64
+	pos    int64
+	z      io.ReadCloser
+}
+
+func (r *inflateReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+	if r.z == nil || r.offset < r.pos {
+		if r.z != nil {
+			r.z.Close()
+		}
+		if _, err := r.data.Seek(0, io.SeekStart); err != nil {
+			return 0, err
+		}
+		r.z = flate.NewReader(r.data)
+		r.pos = 0
+	}
+	if r.offset > r.pos {
+		n, err := io.CopyN(ioutil.Discard, r.z, r.offset-r.pos)
+		r.pos += n
+		if err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.z.Read(p)
+	r.pos += int64(n)
+	r.offset = r.pos
+	if err == io.EOF && r.offset < r.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (r *inflateReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("inflate reader: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("inflate reader: negative position")
+	}
+	r.offset = offset
+	return offset, nil
+}
+
+func (r *inflateReader) Close() error {
+	if r.z != nil {
+		r.z.Close()
+	}
+	return r.data.Close()
+}
@@ -0,0 +1,200 @@
+package dir
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testZipMember struct {
+	name   string
+	data   []byte
+	method uint16
+}
+
+func writeTestZip(t *testing.T, path string, members []testZipMember, modTime time.Time) {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, m := range members {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: m.name, Method: m.method})
+		if err != nil {
+			t.Fatalf("creating member: %v", err)
+		}
+		if _, err := w.Write(m.data); err != nil {
+			t.Fatalf("writing member: %v", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("closing zip: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("making dir: %v", err)
+	}
+	if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatalf("writing zip: %v", err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("setting mod time: %v", err)
+	}
+}
+
+var testZipMembers = []testZipMember{
+	{"01 stored.flac", testData(5000), zip.Store},
+	{"02 deflated.flac", bytes.Repeat([]byte("la la "), 20000), zip.Deflate},
+	{"cover.jpg", testData(100), zip.Store},
+	{"cd 2/", nil, zip.Store},
+	{"cd 2/01.flac", testData(2000), zip.Deflate},
+	{"__MACOSX/._01 stored.flac", testData(10), zip.Store},
+	{"../escaped.flac", testData(10), zip.Store},
+}
+
+func newTestZipDir(t *testing.T) (*ZipDir, string) {
+	t.Helper()
+	root, err := ioutil.TempDir("", "gonic-zip")
+	if err != nil {
+		t.Fatalf("making temp dir: %v", err)
+	}
+	modTime := time.Date(2020, 4, 1, 12, 30, 0, 0, time.UTC)
+	writeTestZip(t, filepath.Join(root, "artist", "album.zip"), testZipMembers, modTime)
+	// not really an archive, so it's left as a file
+	if err := ioutil.WriteFile(filepath.Join(root, "artist", "broken.zip"), []byte("nope"), 0644); err != nil {
+		t.Fatalf("writing broken zip: %v", err)
+	}
+	if err := os.Chtimes(filepath.Join(root, "artist", "broken.zip"), modTime, modTime); err != nil {
+		t.Fatalf("setting mod time: %v", err)
+	}
+	localDir, err := NewLocalDir(root)
+	if err != nil {
+		t.Fatalf("making local dir: %v", err)
+	}
+	return NewZipDir(localDir), root
+}
+
+func TestZipDirWalk(t *testing.T) {
+	zipDir, root := newTestZipDir(t)
+	defer os.RemoveAll(root)
+	exp := []string{
+		"./",
+		"artist/",
+		"artist/album.zip/",
+		"artist/album.zip/01 stored.flac 5000 2020-04-01T12:30:00Z",
+		"artist/album.zip/02 deflated.flac 120000 2020-04-01T12:30:00Z",
+		"artist/album.zip/cd 2/",
+		"artist/album.zip/cd 2/01.flac 2000 2020-04-01T12:30:00Z",
+		"artist/album.zip/cover.jpg 100 2020-04-01T12:30:00Z",
+		"artist/broken.zip 4 2020-04-01T12:30:00Z",
+	}
+	if act := walkEntries(t, zipDir, "."); !reflect.DeepEqual(act, exp) {
+		t.Errorf("expected\n%q\ngot\n%q", exp, act)
+	}
+	exp = []string{
+		"artist/album.zip/cd 2/",
+		"artist/album.zip/cd 2/01.flac 2000 2020-04-01T12:30:00Z",
+	}
+	if act := walkEntries(t, zipDir, "artist/album.zip/cd 2"); !reflect.DeepEqual(act, exp) {
+		t.Errorf("walking a folder in an archive: expected\n%q\ngot\n%q", exp, act)
+	}
+}
+
+func TestZipDirGetFile(t *testing.T) {
+	zipDir, root := newTestZipDir(t)
+	defer os.RemoveAll(root)
+	for _, m := range testZipMembers[:3] {
+		relPath := "artist/album.zip/" + m.name
+		_, reader, err := zipDir.GetFile(relPath)
+		if err != nil {
+			t.Fatalf("getting %q: %v", relPath, err)
+		}
+		got, err := ioutil.ReadAll(reader)
+		if err != nil {
+			t.Fatalf("reading %q: %v", relPath, err)
+		}
+		if !bytes.Equal(got, m.data) {
+			t.Errorf("contents of %q don't match", relPath)
+		}
+		// seek around like http.ServeContent does
+		size, err := reader.Seek(0, io.SeekEnd)
+		if err != nil || size != int64(len(m.data)) {
+			t.Errorf("expected to seek to %d, got %d, %v", len(m.data), size, err)
+		}
+		if _, err := reader.Seek(50, io.SeekStart); err != nil {
+			t.Fatalf("seeking: %v", err)
+		}
+		part := make([]byte, 40)
+		if _, err := io.ReadFull(reader, part); err != nil {
+			t.Fatalf("reading after seek: %v", err)
+		}
+		if !bytes.Equal(part, m.data[50:90]) {
+			t.Errorf("read after seek of %q doesn't match", relPath)
+		}
+		reader.Close()
+		info, err := zipDir.Stat(relPath)
+		if err != nil {
+			t.Fatalf("stating %q: %v", relPath, err)
+		}
+		if info.Size != int64(len(m.data)) {
+			t.Errorf("expected %q to be %d bytes, got %d", relPath, len(m.data), info.Size)
+		}
+	}
+	if _, _, err := zipDir.GetFile("artist/album.zip/missing.flac"); err == nil {
+		t.Errorf("expected an error getting a missing member")
+	}
+	// the archive itself can still be read
+	if _, reader, err := zipDir.GetFile("artist/album.zip"); err != nil {
+		t.Errorf("expected to get the archive, got %v", err)
+	} else {
+		reader.Close()
+	}
+}
+
+func TestZipDirGetFileRange(t *testing.T) {
+	zipDir, root := newTestZipDir(t)
+	defer os.RemoveAll(root)
+	for _, m := range testZipMembers[:2] {
+		relPath := "artist/album.zip/" + m.name
+		got, err := zipDir.GetFileRange(relPath, 1000, 500)
+		if err != nil {
+			t.Fatalf("getting range of %q: %v", relPath, err)
+		}
+		if !bytes.Equal(got, m.data[1000:1500]) {
+			t.Errorf("range of %q doesn't match", relPath)
+		}
+		got, err = zipDir.GetFileRange(relPath, int64(len(m.data))-10, 100)
+		if err != nil {
+			t.Fatalf("getting range past end of %q: %v", relPath, err)
+		}
+		if !bytes.Equal(got, m.data[len(m.data)-10:]) {
+			t.Errorf("expected the last 10 bytes of %q, got %d", relPath, len(got))
+		}
+	}
+}
+
+func TestZipDirReopensChanged(t *testing.T) {
+	zipDir, root := newTestZipDir(t)
+	defer os.RemoveAll(root)
+	relPath := "artist/album.zip/cover.jpg"
+	if _, err := zipDir.Stat(relPath); err != nil {
+		t.Fatalf("stating: %v", err)
+	}
+	newCover := testData(300)
+	writeTestZip(t, filepath.Join(root, "artist", "album.zip"),
+		[]testZipMember{{"cover.jpg", newCover, zip.Store}},
+		time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC))
+	info, err := zipDir.Stat(relPath)
+	if err != nil {
+		t.Fatalf("stating: %v", err)
+	}
+	if info.Size != int64(len(newCover)) {
+		t.Errorf("expected the new archive to be read, got %+v", info)
+	}
+	if _, err := zipDir.Stat("artist/album.zip/01 stored.flac"); err == nil {
+		t.Errorf("expected the old member to be gone")
+	}
+}
@@ -0,0 +1,75 @@
+package scanner
+
+import (
+	"github.com/jinzhu/gorm"
+
+	"senan.xyz/g/gonic/db"
+)
+
+// saveCompilation files the album of a folder whose tags were read under
+// the various artists artist, if it's a compilation without an album
+// artist tag. otherwise it would be under the artist of its first track.
+// it's a compilation if any of its tracks are flagged as being from one,
+// or if its tracks are by different artists
+func (s *Scanner) saveCompilation(folder *db.Album) {
+	if !folder.ReceivedTags {
+		return
+	}
+	if !folder.TagCompilation {
+		folder.TagCompilation = s.mixedArtists(folder.ID)
+	}
+	if !folder.TagCompilation || folder.ReceivedAlbumArtist {
+		return
+	}
+	s.db.WithTx(func(tx *gorm.DB) {
+		artistID := findArtists(tx, []string{s.various})[0]
+		folder.TagArtistID = artistID
+		// the first track's sort name is for its artist
+		folder.TagArtistSort = ""
+		tx.Where("album_id=?", folder.ID).Delete(db.AlbumArtist{})
+		tx.Create(&db.AlbumArtist{AlbumID: folder.ID, ArtistID: artistID})
+		tx.
+			Model(db.Track{}).
+			Where("album_id=?", folder.ID).
+			UpdateColumn("artist_id", artistID)
+	})
+}
+
+// mixedArtists is whether the tracks of a folder are by different artists.
+// they are if no one artist is on more than half of them, so that an album
+// with guests on some tracks, eg. "A feat. B", is still by its artist, but
+// a split release with half of the tracks by each artist isn't
+func (s *Scanner) mixedArtists(folderID int) bool {
+	var counts []struct{ Count int }
+	s.db.
+		Model(db.Track{}).
+		Select("COUNT(*) AS count").
+		Where("album_id=? AND tag_track_artist IS NOT NULL AND tag_track_artist!=''", folderID).
+		Group("LOWER(tag_track_artist)").
+		Scan(&counts)
+	if len(counts) < 2 {
+		return false
+	}
+	var total, most int
+	for _, c := range counts {
+		total += c.Count
+		if c.Count > most {
+			most = c.Count
+		}
+	}
+	return most*2 <= total
+}
+
+// compilationArtistID is the artist a folder's album was filed under if
+// it's a compilation, or zero if it isn't one
+func compilationArtistID(tx *gorm.DB, folderID int) int {
+	folder := &db.Album{}
+	tx.
+		Select("tag_artist_id, tag_compilation").
+		Where("id=?", folderID).
+		First(folder)
+	if !folder.TagCompilation {
+		return 0
+	}
+	return folder.TagArtistID
+}
@@ -0,0 +1,390 @@
+package scanner
+
+import (
+	"bytes"
+	"log"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+
+	"senan.xyz/g/gonic/db"
+	"senan.xyz/g/gonic/scanner/cue"
+)
+
+// cueMaxSize is the biggest cue sheet we'll read. they're usually a few kB
+const cueMaxSize = 1 << 20
+
+// folderCues are the cue sheets in a folder, and the files in it which
+// were split by one and haven't changed. the sheets are read when the
+// folder is done, since they can come before or after their files
+type folderCues struct {
+	sheets []*item
+	split  map[string]*item // filename -> file
+}
+
+// cueFolders are the folders on the stack which have cue sheets, or split
+// files, in them
+type cueFolders map[*db.Album]*folderCues
+
+func (s *Scanner) folderCues(folder *db.Album) *folderCues {
+	cues, ok := s.curCues[folder]
+	if !ok {
+		cues = &folderCues{split: map[string]*item{}}
+		s.curCues[folder] = cues
+	}
+	return cues
+}
+
+func (s *Scanner) handleCue(it *item) {
+	cues := s.folderCues(s.curFolders.Peek())
+	cues.sheets = append(cues.sheets, it)
+}
+
+// handleSplitTrack keeps the tracks a file was split into by a cue sheet,
+// if the file hasn't changed. it returns false if the file needs reading.
+// whether the sheet is still there is found out when the folder is done
+func (s *Scanner) handleSplitTrack(it *item, folder *db.Album) bool {
+	var tracks []*db.Track
+	s.db.
+		Select("id, updated_at").
+		Where("album_id=? AND filename=? AND cue_track>0", folder.ID, it.filename).
+		Find(&tracks)
+	if len(tracks) == 0 || s.scanOpts.Force {
+		return false
+	}
+	for _, track := range tracks {
+		if !it.modTime.Before(track.UpdatedAt) {
+			return false
+		}
+	}
+	for _, track := range tracks {
+		s.seenTracks[track.ID] = struct{}{}
+	}
+	s.folderCues(folder).split[it.filename] = it
+	return true
+}
+
+// saveCues splits the files of folder which have cue sheets into their
+// tracks, once the folder's tracks have been saved. files which were
+// split before, but whose sheet is gone, are read again as whole tracks
+func (s *Scanner) saveCues(folder *db.Album) {
+	cues, ok := s.curCues[folder]
+	if !ok {
+		return
+	}
+	delete(s.curCues, folder)
+	covered := map[string]struct{}{}
+	for _, it := range cues.sheets {
+		sheet, err := s.readCue(it)
+		if err != nil {
+			log.Printf("error reading cue sheet `%s`: %v", it.relPath, err)
+			s.saveError(s.db.DB, it.relPath, StageCue, err)
+			continue
+		}
+		for _, file := range sheet.Files {
+			if len(file.Tracks) < 2 {
+				// a sheet of a file per track, there's nothing to split
+				continue
+			}
+			filename := s.cueFilename(folder, file.Name)
+			if filename == "" {
+				log.Printf("cue sheet `%s` refers to `%s`, which isn't a track", it.relPath, file.Name)
+				continue
+			}
+			covered[filename] = struct{}{}
+			s.db.WithTx(func(tx *gorm.DB) {
+				s.splitTrack(tx, folder, filename, it, sheet, file)
+			})
+		}
+	}
+	// the files which aren't split anymore
+	var filenames []string
+	s.db.
+		Model(db.Track{}).
+		Where("album_id=? AND cue_track>0", folder.ID).
+		Pluck("DISTINCT filename", &filenames)
+	unsplit := map[string][]int{}
+	for _, filename := range filenames {
+		if _, ok := covered[filename]; ok {
+			continue
+		}
+		var splitIDs []int
+		s.db.
+			Model(db.Track{}).
+			Where("album_id=? AND filename=? AND cue_track>0", folder.ID, filename).
+			Order("cue_track").
+			Pluck("id", &splitIDs)
+		s.db.
+			Where("id IN (?)", splitIDs).
+			Delete(db.Track{})
+		unsplit[filename] = splitIDs
+		s.regroup[folder.ID] = struct{}{}
+		if it, ok := cues.split[filename]; ok {
+			_ = s.handleTrack(it)
+		}
+	}
+	s.flushTracks()
+	s.moveUnsplitTracks(folder, unsplit)
+}
+
+// moveUnsplitTracks points the playlists and play queues of the tracks
+// files were split into, by filename, at the whole tracks they're now
+func (s *Scanner) moveUnsplitTracks(folder *db.Album, unsplit map[string][]int) {
+	moved := map[int]int{}
+	for filename, splitIDs := range unsplit {
+		whole := &db.Track{}
+		err := s.db.
+			Select("id").
+			Where("album_id=? AND filename=? AND cue_track=0", folder.ID, filename).
+			First(whole).
+			Error
+		if err != nil {
+			// the file couldn't be read
+			continue
+		}
+		delete(s.newTracks, whole.ID)
+		for _, id := range splitIDs {
+			moved[id] = whole.ID
+		}
+	}
+	if len(moved) == 0 {
+		return
+	}
+	s.db.WithTx(func(tx *gorm.DB) {
+		moveItems(tx, moved)
+	})
+}
+
+func (s *Scanner) readCue(it *item) (*cue.Sheet, error) {
+	if it.size > cueMaxSize {
+		return nil, errors.Errorf("sheet is bigger than %d bytes", cueMaxSize)
+	}
+	data, err := s.musicDirs[s.curMusicFolderID].GetFileRange(it.relPath, 0, it.size)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading file")
+	}
+	return cue.Parse(bytes.NewReader(data))
+}
+
+// cueFilename finds the track in folder that a sheet's file is. sheets
+// often name the file they were ripped to, eg. "album.wav", rather than
+// the one it was converted to after, so a file with the same name but a
+// different extension will do
+func (s *Scanner) cueFilename(folder *db.Album, name string) string {
+	name = path.Base(strings.Replace(name, "\\", "/", -1))
+	var filenames []string
+	s.db.
+		Model(db.Track{}).
+		Where("album_id=?", folder.ID).
+		Pluck("DISTINCT filename", &filenames)
+	stem := strings.TrimSuffix(name, path.Ext(name))
+	var match string
+	for _, filename := range filenames {
+		if filename == name {
+			return filename
+		}
+		if strings.EqualFold(strings.TrimSuffix(filename, path.Ext(filename)), stem) {
+			match = filename
+		}
+	}
+	return match
+}
+
+// splitTrack replaces the track of a whole file with a track for each of
+// the file's tracks in sheet. the tracks of an earlier split are reused,
+// so that they stay in playlists. if the file and sheet haven't changed
+// since then, nothing is done
+func (s *Scanner) splitTrack(tx *gorm.DB, folder *db.Album, filename string, sheetItem *item, sheet *cue.Sheet, file *cue.File) {
+	whole := &db.Track{}
+	err := tx.
+		Where("album_id=? AND filename=? AND cue_track=0", folder.ID, filename).
+		First(whole).
+		Error
+	if gorm.IsRecordNotFoundError(err) {
+		whole = nil
+	}
+	var split []*db.Track
+	tx.
+		Where("album_id=? AND filename=? AND cue_track>0", folder.ID, filename).
+		Order("cue_track").
+		Find(&split)
+	if whole == nil && len(split) == 0 {
+		// the file couldn't be read
+		return
+	}
+	if whole == nil && !s.scanOpts.Force && !cueChanged(sheetItem, split) {
+		return
+	}
+	base, length := whole, 0
+	if base != nil {
+		length = base.Length
+	} else {
+		base = split[0]
+		for _, track := range split {
+			if end := track.CueStart/1000 + track.Length; end > length {
+				length = end
+			}
+		}
+	}
+	var genreIDs []int
+	tx.
+		Model(db.TrackGenre{}).
+		Where("track_id=?", base.ID).
+		Pluck("genre_id", &genreIDs)
+	if len(genreIDs) == 0 {
+		genreIDs = []int{base.TagGenreID}
+	}
+
+	// ** begin the sheet's album tags
+	// which are taken over the file's, since they're what the sheet is for
+	albumColumns := map[string]interface{}{}
+	if sheet.Title != "" {
+		folder.TagTitle = sheet.Title
+		folder.TagTitleUDec = decoded(sheet.Title)
+		albumColumns["tag_title"] = folder.TagTitle
+		albumColumns["tag_title_u_dec"] = folder.TagTitleUDec
+	}
+	if year := intPrefix(sheet.Date); year > 0 {
+		folder.TagYear = year
+		albumColumns["tag_year"] = year
+	}
+	artistID := base.ArtistID
+	if sheet.Performer != "" {
+		artistIDs := findArtists(tx, []string{sheet.Performer})
+		artistID = artistIDs[0]
+		folder.TagArtistID = artistID
+		folder.ReceivedAlbumArtist = true
+		albumColumns["tag_artist_id"] = artistID
+		// the file's sort name is for its artist, which this may not be
+		folder.TagArtistSort = ""
+		albumColumns["tag_artist_sort"] = ""
+		tx.Where("album_id=?", folder.ID).Delete(db.AlbumArtist{})
+		tx.Create(&db.AlbumArtist{AlbumID: folder.ID, ArtistID: artistID})
+	}
+	if sheet.Genre != "" {
+		genreIDs = findGenres(tx, []string{sheet.Genre})
+		folder.TagGenreID = genreIDs[0]
+		albumColumns["tag_genre_id"] = genreIDs[0]
+		tx.Where("album_id=?", folder.ID).Delete(db.AlbumGenre{})
+		tx.Create(&db.AlbumGenre{AlbumID: folder.ID, GenreID: genreIDs[0]})
+	}
+	if len(albumColumns) > 0 {
+		tx.
+			Model(db.Album{}).
+			Where("id=?", folder.ID).
+			UpdateColumns(albumColumns)
+	}
+
+	// ** begin save the tracks
+	var firstID int
+	previous := map[int]*db.Track{}
+	for _, track := range split {
+		previous[track.CueTrack] = track
+	}
+	for _, cueTrack := range file.Tracks {
+		track, ok := previous[cueTrack.Number]
+		if !ok {
+			track = &db.Track{}
+		}
+		delete(previous, cueTrack.Number)
+		start, end := cueTrack.Start, file.End(cueTrack)
+		trackLength := length - int(start/time.Second)
+		if end > 0 {
+			trackLength = int((end - start) / time.Second)
+		}
+		track.Filename = base.Filename
+		track.FilenameUDec = base.FilenameUDec
+		track.AlbumID = folder.ID
+		track.ArtistID = artistID
+		track.TagGenreID = genreIDs[0]
+		track.Bitrate = base.Bitrate
+		track.EmbeddedCover = base.EmbeddedCover
+		track.TagDiscNumber = base.TagDiscNumber
+		track.TagComposer = base.TagComposer
+		track.TagConductor = base.TagConductor
+		track.ContentHash = base.ContentHash
+		track.TagTitle = cueTrack.Title
+		track.TagTitleUDec = decoded(cueTrack.Title)
+		track.TagTrackArtist = firstNonEmpty(cueTrack.Performer, sheet.Performer, base.TagTrackArtist)
+		track.TagTrackNumber = cueTrack.Number
+		track.TagBrainzID = ""
+		track.Length = trackLength
+		if length > 0 {
+			// roughly, for clients which show it
+			track.Size = int(int64(base.Size) * int64(trackLength) / int64(length))
+		}
+		track.CueTrack = cueTrack.Number
+		track.CueStart = int(start / time.Millisecond)
+		track.CueEnd = int(end / time.Millisecond)
+		// the gains of the whole file aren't the track's
+		if cueTrack.Gain != nil || !track.ReplayGainAnalysed {
+			track.ReplayGainTrackGain = cueTrack.Gain
+			track.ReplayGainTrackPeak = cueTrack.Peak
+			track.ReplayGainAnalysed = false
+		}
+		isNew := track.ID == 0
+		tx.Save(track)
+		if isNew {
+			s.noteNewTrack(track)
+		}
+		tx.Where("track_id=?", track.ID).Delete(db.TrackGenre{})
+		for _, genreID := range genreIDs {
+			tx.Create(&db.TrackGenre{TrackID: track.ID, GenreID: genreID})
+		}
+		s.seenTracks[track.ID] = struct{}{}
+		if firstID == 0 {
+			firstID = track.ID
+		}
+	}
+	for _, track := range previous {
+		tx.Delete(track)
+		delete(s.seenTracks, track.ID)
+	}
+	if whole != nil {
+		// the first track takes the whole one's places in playlists and
+		// play queues
+		moveItems(tx, map[int]int{whole.ID: firstID})
+		tx.Delete(whole)
+		delete(s.seenTracks, whole.ID)
+		delete(s.newTracks, whole.ID)
+	}
+	s.regroup[folder.ID] = struct{}{}
+	folder.SavedTracks = true
+	log.Printf("split `%s` into %d tracks with `%s`\n",
+		path.Join(folder.LeftPath, folder.RightPath, filename),
+		len(file.Tracks), sheetItem.filename)
+}
+
+// cueChanged is whether a sheet has been modified since the tracks it
+// split a file into were saved
+func cueChanged(sheetItem *item, split []*db.Track) bool {
+	for _, track := range split {
+		if !sheetItem.modTime.Before(track.UpdatedAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// intPrefix is the number at the start of in, eg. 1973 for "1973-03-01"
+func intPrefix(in string) int {
+	end := strings.IndexFunc(in, func(r rune) bool { return r < '0' || r > '9' })
+	if end < 0 {
+		end = len(in)
+	}
+	out, _ := strconv.Atoi(in[:end])
+	return out
+}
+
+func firstNonEmpty(in ...string) string {
+	for _, s := range in {
+		if s != "" {
+			return s
+		}
+	}
+	return ""
+}
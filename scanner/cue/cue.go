@@ -0,0 +1,213 @@
+// Package cue parses CUE sheets, which split a single file rip of an
+// album into its tracks
+package cue
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// framesPerSecond is the resolution of the times in a sheet, from CDs
+const framesPerSecond = 75
+
+type Sheet struct {
+	Title     string
+	Performer string
+	// these are from REM comments, which most rippers write
+	Genre string
+	Date  string
+	Files []*File
+}
+
+type File struct {
+	// Name is the path of the file, relative to the sheet. it's often not
+	// the file's real name, eg. "album.wav" for a file which was
+	// converted to "album.flac" after
+	Name   string
+	Tracks []*Track
+}
+
+type Track struct {
+	Number    int
+	Title     string
+	Performer string
+	// Start is INDEX 01 of the track. the track ends at the next one's,
+	// so that any gap is at the end of the track before it
+	Start time.Duration
+	// Gain and Peak are REM REPLAYGAIN_TRACK_* comments, if there are
+	Gain *float64
+	Peak *float64
+}
+
+// End is where t ends in file, or zero if it lasts until the end
+func (f *File) End(t *Track) time.Duration {
+	for i, track := range f.Tracks {
+		if track == t && i+1 < len(f.Tracks) {
+			return f.Tracks[i+1].Start
+		}
+	}
+	return 0
+}
+
+// Parse reads a sheet. sheets which aren't utf-8 are read as latin-1,
+// which is what most older rippers wrote
+func Parse(r io.Reader) (*Sheet, error) {
+	sheet := &Sheet{}
+	var file *File
+	var track *Track
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := scanner.Text()
+		if lineNum == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		if !utf8.ValidString(line) {
+			line = latin1(line)
+		}
+		fields := splitFields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		arg := func(i int) string {
+			if i < len(fields) {
+				return fields[i]
+			}
+			return ""
+		}
+		switch strings.ToUpper(fields[0]) {
+		case "FILE":
+			file = &File{Name: arg(1)}
+			track = nil
+			sheet.Files = append(sheet.Files, file)
+		case "TRACK":
+			if file == nil {
+				return nil, errors.Errorf("line %d: track before a file", lineNum)
+			}
+			number, err := strconv.Atoi(arg(1))
+			if err != nil {
+				return nil, errors.Errorf("line %d: invalid track number %q", lineNum, arg(1))
+			}
+			track = &Track{Number: number}
+			file.Tracks = append(file.Tracks, track)
+		case "INDEX":
+			if track == nil || arg(1) != "01" && arg(1) != "1" {
+				continue
+			}
+			start, err := parseTime(arg(2))
+			if err != nil {
+				return nil, errors.Wrapf(err, "line %d", lineNum)
+			}
+			track.Start = start
+		case "TITLE":
+			if track != nil {
+				track.Title = arg(1)
+				continue
+			}
+			sheet.Title = arg(1)
+		case "PERFORMER":
+			if track != nil {
+				track.Performer = arg(1)
+				continue
+			}
+			sheet.Performer = arg(1)
+		case "REM":
+			if len(fields) < 3 {
+				continue
+			}
+			value := strings.Join(fields[2:], " ")
+			switch strings.ToUpper(arg(1)) {
+			case "GENRE":
+				sheet.Genre = value
+			case "DATE":
+				sheet.Date = value
+			case "REPLAYGAIN_TRACK_GAIN":
+				if track != nil {
+					track.Gain = parseFloat(strings.TrimSuffix(strings.ToLower(value), " db"))
+				}
+			case "REPLAYGAIN_TRACK_PEAK":
+				if track != nil {
+					track.Peak = parseFloat(value)
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "reading sheet")
+	}
+	if len(sheet.Files) == 0 {
+		return nil, errors.New("no files in sheet")
+	}
+	return sheet, nil
+}
+
+// splitFields splits a line on spaces, keeping quoted fields together
+func splitFields(line string) []string {
+	var fields []string
+	line = strings.TrimSpace(line)
+	for line != "" {
+		var field string
+		if line[0] == '"' {
+			end := strings.IndexByte(line[1:], '"')
+			if end < 0 {
+				end = len(line) - 1
+			}
+			field, line = line[1:end+1], line[min(end+2, len(line)):]
+		} else {
+			end := strings.IndexAny(line, " \t")
+			if end < 0 {
+				end = len(line)
+			}
+			field, line = line[:end], line[end:]
+		}
+		fields = append(fields, field)
+		line = strings.TrimLeft(line, " \t")
+	}
+	return fields
+}
+
+// parseTime parses a time like "mm:ss:ff", where ff are frames
+func parseTime(in string) (time.Duration, error) {
+	parts := strings.Split(in, ":")
+	if len(parts) != 3 {
+		return 0, errors.Errorf("invalid time %q", in)
+	}
+	var nums [3]int
+	for i, part := range parts {
+		num, err := strconv.Atoi(part)
+		if err != nil || num < 0 {
+			return 0, errors.Errorf("invalid time %q", in)
+		}
+		nums[i] = num
+	}
+	frames := (nums[0]*60+nums[1])*framesPerSecond + nums[2]
+	return time.Duration(frames) * time.Second / framesPerSecond, nil
+}
+
+func parseFloat(in string) *float64 {
+	out, err := strconv.ParseFloat(strings.TrimSpace(in), 64)
+	if err != nil {
+		return nil
+	}
+	return &out
+}
+
+func latin1(in string) string {
+	runes := make([]rune, len(in))
+	for i := 0; i < len(in); i++ {
+		runes[i] = rune(in[i])
+	}
+	return string(runes)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,95 @@
+package cue
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSheet = "\ufeff" + `REM GENRE "Progressive Rock"
+REM DATE 1973
+REM DISCID 8B0B5A0B
+PERFORMER "Pink Floyd"
+TITLE "The Dark Side of the Moon"
+FILE "album.wav" WAVE
+  TRACK 01 AUDIO
+    TITLE "Speak to Me"
+    INDEX 01 00:00:00
+  TRACK 02 AUDIO
+    TITLE "Breathe"
+    PERFORMER "Pink Floyd feat. Someone"
+    REM REPLAYGAIN_TRACK_GAIN -3.21 dB
+    REM REPLAYGAIN_TRACK_PEAK 0.987654
+    INDEX 00 01:05:00
+    INDEX 01 01:07:37
+  TRACK 03 AUDIO
+    TITLE "On the Run"
+    INDEX 01 03:50:74
+`
+
+func TestParse(t *testing.T) {
+	sheet, err := Parse(strings.NewReader(testSheet))
+	if err != nil {
+		t.Fatalf("parsing: %v", err)
+	}
+	if sheet.Title != "The Dark Side of the Moon" || sheet.Performer != "Pink Floyd" {
+		t.Errorf("unexpected album %q by %q", sheet.Title, sheet.Performer)
+	}
+	if sheet.Genre != "Progressive Rock" {
+		t.Errorf("unexpected genre %q", sheet.Genre)
+	}
+	if sheet.Date != "1973" {
+		t.Errorf("unexpected date %q", sheet.Date)
+	}
+	if len(sheet.Files) != 1 || sheet.Files[0].Name != "album.wav" {
+		t.Fatalf("expected one file, got %+v", sheet.Files)
+	}
+	file := sheet.Files[0]
+	if len(file.Tracks) != 3 {
+		t.Fatalf("expected three tracks, got %d", len(file.Tracks))
+	}
+	breathe := file.Tracks[1]
+	if breathe.Number != 2 || breathe.Title != "Breathe" || breathe.Performer != "Pink Floyd feat. Someone" {
+		t.Errorf("unexpected track %+v", breathe)
+	}
+	// 37 frames are 493.33ms
+	if exp := 67*time.Second + 37*time.Second/75; breathe.Start != exp {
+		t.Errorf("expected a start of %v, got %v", exp, breathe.Start)
+	}
+	if end := file.End(breathe); end != file.Tracks[2].Start {
+		t.Errorf("expected the track to end where the next starts, got %v", end)
+	}
+	if end := file.End(file.Tracks[2]); end != 0 {
+		t.Errorf("expected the last track to end with the file, got %v", end)
+	}
+	if breathe.Gain == nil || *breathe.Gain != -3.21 || breathe.Peak == nil || *breathe.Peak != 0.987654 {
+		t.Errorf("unexpected replaygain %v %v", breathe.Gain, breathe.Peak)
+	}
+	if file.Tracks[0].Gain != nil {
+		t.Errorf("expected no gain for the first track")
+	}
+}
+
+func TestParseLatin1(t *testing.T) {
+	sheet, err := Parse(strings.NewReader("PERFORMER \"Bj\xf6rk\"\nFILE \"a.flac\" WAVE\n"))
+	if err != nil {
+		t.Fatalf("parsing: %v", err)
+	}
+	if sheet.Performer != "Björk" {
+		t.Errorf("expected latin-1 to be decoded, got %q", sheet.Performer)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, sheet := range []string{
+		"",
+		"TRACK 01 AUDIO\n",
+		"REM\n",
+		"FILE \"a.flac\" WAVE\nTRACK xx AUDIO\n",
+		"FILE \"a.flac\" WAVE\nTRACK 01 AUDIO\nINDEX 01 00:00\n",
+	} {
+		if _, err := Parse(strings.NewReader(sheet)); err == nil {
+			t.Errorf("expected an error for %q", sheet)
+		}
+	}
+}
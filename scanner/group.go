@@ -0,0 +1,178 @@
+package scanner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+
+	"senan.xyz/g/gonic/db"
+)
+
+// groupKey is what the folders of an album have in common
+type groupKey struct {
+	MusicFolderID int
+	TagArtistID   int
+	TagTitle      string
+}
+
+// groupColumns are the columns of a folder which are needed for its key
+const groupColumns = "id, music_folder_id, tag_artist_id, tag_title, tag_album_id"
+
+// groupAlbums finds the album of each folder with tags, when browsing by
+// tags. folders with the same album artist and title, in the same music
+// folder, are one album if they have the same musicbrainz id, or if
+// neither has one and they're in the same folder, eg. "Album/CD1" and
+// "Album/CD2". folders with the same tracks are copies though, eg. one in
+// flac and one in mp3, so they're albums of their own. the album is the
+// first of its folders. untitled folders are albums of their own, since
+// the tracks in them are likely unrelated
+func (s *Scanner) groupAlbums() {
+	for _, key := range s.groupKeys() {
+		var folders []*db.Album
+		s.db.
+			Select("id, parent_id, tag_brainz_id, tag_album_id").
+			Where("music_folder_id=? AND tag_artist_id=? AND tag_title=?",
+				key.MusicFolderID, key.TagArtistID, key.TagTitle).
+			Order("id").
+			Find(&folders)
+		albums := groupFolders(folders, s.folderTracks(folders))
+		s.db.WithTx(func(tx *gorm.DB) {
+			for _, folder := range folders {
+				if folder.TagAlbumID == albums[folder.ID] {
+					continue
+				}
+				tx.
+					Model(folder).
+					UpdateColumn("tag_album_id", albums[folder.ID])
+			}
+		})
+	}
+	s.db.Exec(`
+		UPDATE albums
+		SET tag_album_id=id
+		WHERE tag_artist_id IS NOT NULL
+		AND (tag_title IS NULL OR tag_title='')
+		AND (tag_album_id IS NULL OR tag_album_id!=id)`)
+	s.db.Exec(`
+		UPDATE albums
+		SET tag_album_id=NULL
+		WHERE tag_artist_id IS NULL AND tag_album_id IS NOT NULL`)
+}
+
+// groupKeys are the keys of the folders which need to be grouped again.
+// they're the folders which had tracks saved or deleted in this scan, the
+// ones which were grouped with them, and the ones which haven't been
+// grouped yet or whose album is gone. the rest are left as they are
+func (s *Scanner) groupKeys() []groupKey {
+	var folders []*db.Album
+	s.db.
+		Select(groupColumns).
+		Where("tag_artist_id IS NOT NULL AND tag_title IS NOT NULL AND tag_title!=''").
+		Where(`tag_album_id IS NULL
+		       OR NOT EXISTS ( SELECT 1 FROM albums album
+		                       WHERE album.id=albums.tag_album_id
+		)`).
+		Find(&folders)
+	ids := make([]int, 0, len(s.regroup))
+	for id := range s.regroup {
+		ids = append(ids, id)
+	}
+	// in batches, since sqlite has a limit on the number of variables
+	for len(ids) > 0 {
+		n := len(ids)
+		if n > 400 {
+			n = 400
+		}
+		var changed []*db.Album
+		s.db.
+			Select(groupColumns).
+			Where("tag_artist_id IS NOT NULL AND tag_title IS NOT NULL AND tag_title!=''").
+			Where("id IN (?) OR tag_album_id IN (?)", ids[:n], ids[:n]).
+			Find(&changed)
+		folders = append(folders, changed...)
+		ids = ids[n:]
+	}
+	seen := map[groupKey]struct{}{}
+	var keys []groupKey
+	for _, folder := range folders {
+		key := groupKey{folder.MusicFolderID, folder.TagArtistID, folder.TagTitle}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// folderTracks are the tracks of each folder, by their disc and track
+// numbers and titles, for telling if folders are copies of each other
+func (s *Scanner) folderTracks(folders []*db.Album) map[int][]string {
+	if len(folders) < 2 {
+		return nil
+	}
+	ids := make([]int, len(folders))
+	for i, folder := range folders {
+		ids[i] = folder.ID
+	}
+	var tracks []*db.Track
+	s.db.
+		Select("album_id, tag_disc_number, tag_track_number, tag_title").
+		Where("album_id IN (?)", ids).
+		Find(&tracks)
+	ret := map[int][]string{}
+	for _, track := range tracks {
+		if track.TagTitle == "" {
+			continue
+		}
+		ret[track.AlbumID] = append(ret[track.AlbumID], fmt.Sprintf("%d\x00%d\x00%s",
+			track.TagDiscNumber, track.TagTrackNumber, strings.ToLower(track.TagTitle)))
+	}
+	return ret
+}
+
+// groupFolders finds the album of each of the folders, which have the same
+// key and are in order. it returns the album's folder id, by folder id
+func groupFolders(folders []*db.Album, tracks map[int][]string) map[int]int {
+	ret := make(map[int]int, len(folders))
+	var firsts []*db.Album
+	albumTracks := map[int]map[string]struct{}{}
+	for _, folder := range folders {
+		albumID := folder.ID
+		for _, first := range firsts {
+			if sameRelease(first, folder) && !anyTrackIn(tracks[folder.ID], albumTracks[first.ID]) {
+				albumID = first.ID
+				break
+			}
+		}
+		if albumID == folder.ID {
+			firsts = append(firsts, folder)
+			albumTracks[albumID] = map[string]struct{}{}
+		}
+		for _, track := range tracks[folder.ID] {
+			albumTracks[albumID][track] = struct{}{}
+		}
+		ret[folder.ID] = albumID
+	}
+	return ret
+}
+
+// sameRelease is whether two folders could be part of the same album. they
+// are if they have the same musicbrainz id, or if neither has one and
+// they're in the same folder
+func sameRelease(a, b *db.Album) bool {
+	if a.TagBrainzID != "" || b.TagBrainzID != "" {
+		return a.TagBrainzID == b.TagBrainzID
+	}
+	return a.ParentID == b.ParentID
+}
+
+func anyTrackIn(tracks []string, in map[string]struct{}) bool {
+	for _, track := range tracks {
+		if _, ok := in[track]; ok {
+			return true
+		}
+	}
+	return false
+}
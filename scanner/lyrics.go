@@ -0,0 +1,132 @@
+package scanner
+
+import (
+	"log"
+	"path"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+
+	"senan.xyz/g/gonic/db"
+	"senan.xyz/g/gonic/scanner/lyrics"
+)
+
+// lrcMaxSize is the biggest .lrc file we'll read
+const lrcMaxSize = 1 << 20
+
+// lyricsFolders are the .lrc files of the folders on the stack. they're
+// saved when the folder is done, since they can come before their tracks
+type lyricsFolders map[*db.Album][]*item
+
+func (s *Scanner) handleLRC(it *item) {
+	folder := s.curFolders.Peek()
+	s.curLyrics[folder] = append(s.curLyrics[folder], it)
+}
+
+// saveLyrics saves the .lrc files of folder as the lyrics of the tracks
+// with the same name, once the folder's tracks have been saved. the lyrics
+// of .lrc files which are gone are deleted
+func (s *Scanner) saveLyrics(folder *db.Album) {
+	items := s.curLyrics[folder]
+	delete(s.curLyrics, folder)
+	var hasLRC []int
+	s.db.
+		Model(db.Lyrics{}).
+		Joins("JOIN tracks ON tracks.id=lyrics.track_id").
+		Where("tracks.album_id=? AND lyrics.source=?", folder.ID, db.LyricsSourceLRC).
+		Pluck("track_id", &hasLRC)
+	if len(items) == 0 && len(hasLRC) == 0 {
+		return
+	}
+	byStem := make(map[string]*item, len(items))
+	for _, it := range items {
+		byStem[stem(it.filename)] = it
+	}
+	var tracks []*db.Track
+	s.db.
+		Select("id, filename").
+		Where("album_id=? AND cue_track=0", folder.ID).
+		Find(&tracks)
+	seen := map[int]struct{}{}
+	s.db.WithTx(func(tx *gorm.DB) {
+		for _, track := range tracks {
+			it, ok := byStem[stem(track.Filename)]
+			if !ok {
+				continue
+			}
+			seen[track.ID] = struct{}{}
+			if err := s.saveLRC(tx, track.ID, it); err != nil {
+				log.Printf("error reading lyrics `%s`: %v", it.relPath, err)
+				s.saveError(tx, it.relPath, StageLyrics, err)
+			}
+		}
+		for _, trackID := range hasLRC {
+			if _, ok := seen[trackID]; ok {
+				continue
+			}
+			tx.
+				Where("track_id=? AND source=?", trackID, db.LyricsSourceLRC).
+				Delete(db.Lyrics{})
+		}
+	})
+}
+
+// saveLRC saves the lyrics of an .lrc file, if it has changed since they
+// were last saved
+func (s *Scanner) saveLRC(tx *gorm.DB, trackID int, it *item) error {
+	existing := &db.Lyrics{}
+	err := tx.
+		Where("track_id=? AND source=?", trackID, db.LyricsSourceLRC).
+		First(existing).
+		Error
+	found := !gorm.IsRecordNotFoundError(err)
+	if found && !s.scanOpts.Force && it.modTime.Before(existing.UpdatedAt) {
+		return nil
+	}
+	if it.size > lrcMaxSize {
+		return errors.Errorf("file is bigger than %d bytes", lrcMaxSize)
+	}
+	data, err := s.musicDirs[s.curMusicFolderID].GetFileRange(it.relPath, 0, it.size)
+	if err != nil {
+		return errors.Wrap(err, "reading file")
+	}
+	text := lyrics.Decode(data)
+	if lyrics.Parse(text).Empty() {
+		if found {
+			tx.Delete(existing)
+		}
+		return nil
+	}
+	existing.TrackID = trackID
+	existing.Source = db.LyricsSourceLRC
+	existing.Text = text
+	return tx.Save(existing).Error
+}
+
+// saveTagLyrics saves the lyrics from a track's tags, or deletes the ones
+// saved before if there aren't any now
+func saveTagLyrics(tx *gorm.DB, trackID int, text string) {
+	existing := &db.Lyrics{}
+	err := tx.
+		Where("track_id=? AND source=?", trackID, db.LyricsSourceTags).
+		First(existing).
+		Error
+	found := !gorm.IsRecordNotFoundError(err)
+	if text == "" || lyrics.Parse(text).Empty() {
+		if found {
+			tx.Delete(existing)
+		}
+		return
+	}
+	existing.TrackID = trackID
+	existing.Source = db.LyricsSourceTags
+	existing.Text = text
+	tx.Save(existing)
+}
+
+// stem is the lowercased name of a file without its extension, eg.
+// "01 track" for "01 Track.flac"
+func stem(filename string) string {
+	return strings.ToLower(strings.TrimSuffix(filename, path.Ext(filename)))
+}
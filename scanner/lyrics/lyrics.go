@@ -0,0 +1,152 @@
+// Package lyrics parses lyrics, which are either plain text or LRC, with a
+// timestamp for each line
+package lyrics
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type Line struct {
+	// Start is when the line is sung. it's zero for unsynced lyrics
+	Start time.Duration
+	Value string
+}
+
+type Lyrics struct {
+	// Synced is whether the lines have timestamps
+	Synced bool
+	// Offset is from an LRC [offset:] tag. positive offsets mean the
+	// lines should be shown earlier
+	Offset time.Duration
+	// these are from LRC [ar:], [ti:] and [la:] tags, if there are
+	Artist string
+	Title  string
+	Lang   string
+	Lines  []*Line
+}
+
+var (
+	// a time tag, eg. "[01:23.45]". some taggers use a colon before the
+	// fraction, or leave it out
+	expTime = regexp.MustCompile(`^\[(\d+):(\d{1,2})(?:[.:](\d{1,3}))?\]`)
+	// an id tag, eg. "[ar:Some Artist]"
+	expID = regexp.MustCompile(`^\[([a-zA-Z#]+):(.*)\]$`)
+	// a word time of enhanced LRC, eg. "<01:23.45>"
+	expWordTime = regexp.MustCompile(`<\d+:\d{1,2}(?:[.:]\d{1,3})?>`)
+)
+
+// Parse reads lyrics as LRC if any of their lines have timestamps, or as
+// plain text if none do
+func Parse(text string) *Lyrics {
+	lyrics := &Lyrics{}
+	var plain []*Line
+	text = strings.TrimPrefix(text, "\ufeff")
+	text = strings.Replace(text, "\r\n", "\n", -1)
+	text = strings.Replace(text, "\r", "\n", -1)
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		var starts []time.Duration
+		for {
+			match := expTime.FindStringSubmatch(line)
+			if match == nil {
+				break
+			}
+			starts = append(starts, parseTime(match[1], match[2], match[3]))
+			line = line[len(match[0]):]
+		}
+		if len(starts) == 0 {
+			if match := expID.FindStringSubmatch(line); match != nil {
+				lyrics.setID(strings.ToLower(match[1]), strings.TrimSpace(match[2]))
+				continue
+			}
+			plain = append(plain, &Line{Value: line})
+			continue
+		}
+		value := strings.TrimSpace(expWordTime.ReplaceAllString(line, ""))
+		for _, start := range starts {
+			lyrics.Lines = append(lyrics.Lines, &Line{Start: start, Value: value})
+		}
+	}
+	if len(lyrics.Lines) > 0 {
+		lyrics.Synced = true
+		// lines with more than one time are repeated, eg. a chorus
+		sort.SliceStable(lyrics.Lines, func(i, j int) bool {
+			return lyrics.Lines[i].Start < lyrics.Lines[j].Start
+		})
+		return lyrics
+	}
+	lyrics.Lines = trimBlank(plain)
+	return lyrics
+}
+
+// Decode is the text of an .lrc file. files which aren't utf-8 are read as
+// latin-1, which is what most older ones are
+func Decode(data []byte) string {
+	if utf8.Valid(data) {
+		return string(data)
+	}
+	runes := make([]rune, len(data))
+	for i, b := range data {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+func (l *Lyrics) setID(key, value string) {
+	switch key {
+	case "ar":
+		l.Artist = value
+	case "ti":
+		l.Title = value
+	case "la", "lang":
+		l.Lang = value
+	case "offset":
+		ms, _ := strconv.Atoi(strings.TrimPrefix(value, "+"))
+		l.Offset = time.Duration(ms) * time.Millisecond
+	}
+}
+
+// Plain is the lyrics without timestamps, a line per line
+func (l *Lyrics) Plain() string {
+	values := make([]string, len(l.Lines))
+	for i, line := range l.Lines {
+		values[i] = line.Value
+	}
+	return strings.Join(values, "\n")
+}
+
+// Empty is whether there are no lines with words
+func (l *Lyrics) Empty() bool {
+	for _, line := range l.Lines {
+		if line.Value != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func parseTime(min, sec, frac string) time.Duration {
+	mins, _ := strconv.Atoi(min)
+	secs, _ := strconv.Atoi(sec)
+	// the fraction is hundredths usually, but can be tenths or ms
+	ms, _ := strconv.Atoi((frac + "00")[:3])
+	return time.Duration(mins)*time.Minute +
+		time.Duration(secs)*time.Second +
+		time.Duration(ms)*time.Millisecond
+}
+
+// trimBlank removes the blank lines at the start and end of lines
+func trimBlank(lines []*Line) []*Line {
+	for len(lines) > 0 && lines[0].Value == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1].Value == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
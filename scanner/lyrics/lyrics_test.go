@@ -0,0 +1,53 @@
+package lyrics
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSynced(t *testing.T) {
+	lyrics := Parse("\ufeff[ar:Someone]\r\n[la:eng]\r\n[offset:+250]\r\n" +
+		"[00:12.30]first line\r\n" +
+		"[00:20.5][01:02.00]chorus\r\n" +
+		"[00:15:45]<00:15.45>second <00:16.00>line\r\n" +
+		"not timed\r\n")
+	if !lyrics.Synced {
+		t.Fatalf("expected lyrics with timestamps to be synced")
+	}
+	if lyrics.Artist != "Someone" || lyrics.Lang != "eng" || lyrics.Offset != 250*time.Millisecond {
+		t.Errorf("unexpected tags %q %q %v", lyrics.Artist, lyrics.Lang, lyrics.Offset)
+	}
+	exp := []Line{
+		{12300 * time.Millisecond, "first line"},
+		{15450 * time.Millisecond, "second line"},
+		{20500 * time.Millisecond, "chorus"},
+		{62000 * time.Millisecond, "chorus"},
+	}
+	if len(lyrics.Lines) != len(exp) {
+		t.Fatalf("expected %d lines, got %d", len(exp), len(lyrics.Lines))
+	}
+	for i, line := range lyrics.Lines {
+		if *line != exp[i] {
+			t.Errorf("expected line %d to be %+v, got %+v", i, exp[i], *line)
+		}
+	}
+}
+
+func TestParsePlain(t *testing.T) {
+	lyrics := Parse("\nfirst line\n\nsecond line\n\n")
+	if lyrics.Synced {
+		t.Errorf("expected lyrics without timestamps to be unsynced")
+	}
+	if exp := "first line\n\nsecond line"; lyrics.Plain() != exp {
+		t.Errorf("expected plain lyrics %q, got %q", exp, lyrics.Plain())
+	}
+	if !Parse("\n \n").Empty() {
+		t.Errorf("expected blank lyrics to be empty")
+	}
+}
+
+func TestDecode(t *testing.T) {
+	if text := Decode([]byte("[ar:Bj\xf6rk]")); text != "[ar:Björk]" {
+		t.Errorf("expected latin-1 to be decoded, got %q", text)
+	}
+}
@@ -0,0 +1,224 @@
+package scanner
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+
+	"senan.xyz/g/gonic/db"
+	"senan.xyz/g/gonic/dir"
+	"senan.xyz/g/gonic/scanner/tags"
+)
+
+// contentHashSize is how much of the end of a file is hashed. it's what
+// the tags are read with for most formats, so it doesn't need fetching
+const contentHashSize = tags.TailSize
+
+// movedColumns are the columns of a track which are needed to find where
+// it was moved to
+const movedColumns = "id, album_id, tag_brainz_id, content_hash, tag_title, tag_track_artist, tag_disc_number, tag_track_number, length, cue_track"
+
+// movedCandidates are the tracks which were made in a scan, by id. they
+// only have what movedKeys needs
+type movedCandidates map[int]*db.Track
+
+// contentHash hashes the size of a file and the end of it. the end is used
+// since it's audio for most formats, where the start is often tags, which
+// could be edited while the file is moved
+func contentHash(size int64, tail []byte) string {
+	hash := sha1.New()
+	_ = binary.Write(hash, binary.BigEndian, size)
+	hash.Write(tail)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// readContentHash is the content hash of a track whose tags were read. the
+// end of the file is only fetched if the tags didn't need it. it returns
+// the number of bytes fetched
+func readContentHash(musicDir dir.Dir, it *item, trTags *tags.Tags) (string, int64, error) {
+	if tail := trTags.Tail(); tail != nil {
+		return contentHash(it.size, tail), 0, nil
+	}
+	offset := it.size - contentHashSize
+	if offset < 0 {
+		offset = 0
+	}
+	tail, err := musicDir.GetFileRange(it.relPath, offset, it.size-offset)
+	if err != nil {
+		return "", 0, err
+	}
+	return contentHash(it.size, tail), int64(len(tail)), nil
+}
+
+// movedKeys are the ways a track which is gone can be found again, as one
+// which is new in this scan. a track that was moved or renamed keeps its
+// musicbrainz id, tags, and contents. the keys are empty if the track
+// doesn't have what's needed for them
+func movedKeys(track *db.Track) []string {
+	var keys []string
+	if track.TagBrainzID != "" {
+		keys = append(keys, fmt.Sprintf("brainz\x00%s\x00%d\x00%d\x00%d",
+			track.TagBrainzID, track.TagDiscNumber, track.TagTrackNumber, track.CueTrack))
+	}
+	if track.ContentHash != "" {
+		keys = append(keys, fmt.Sprintf("hash\x00%s\x00%d",
+			track.ContentHash, track.CueTrack))
+	}
+	if track.TagTitle != "" && track.Length > 0 {
+		keys = append(keys, fmt.Sprintf("tags\x00%s\x00%s\x00%d\x00%d\x00%d\x00%d",
+			strings.ToLower(track.TagTitle), strings.ToLower(track.TagTrackArtist),
+			track.TagDiscNumber, track.TagTrackNumber, track.Length, track.CueTrack))
+	}
+	return keys
+}
+
+// noteNewTrack keeps a track which was made in this scan, in case it's one
+// that was moved
+func (s *Scanner) noteNewTrack(track *db.Track) {
+	s.newTracks[track.ID] = &db.Track{
+		ID:             track.ID,
+		AlbumID:        track.AlbumID,
+		TagBrainzID:    track.TagBrainzID,
+		ContentHash:    track.ContentHash,
+		TagTitle:       track.TagTitle,
+		TagTrackArtist: track.TagTrackArtist,
+		TagDiscNumber:  track.TagDiscNumber,
+		TagTrackNumber: track.TagTrackNumber,
+		Length:         track.Length,
+		CueTrack:       track.CueTrack,
+	}
+}
+
+// moveTracks finds the tracks which are new in this scan that the gone
+// tracks were moved or renamed to, and points the playlists, play queues,
+// and plays of the gone tracks and their folders at the new ones. that's
+// done before the gone tracks are deleted, and their plays with them. a
+// gone track is only matched if there's one new track it could be. it
+// returns the number of tracks which were found
+func (s *Scanner) moveTracks(gone []*db.Track) int {
+	if len(gone) == 0 || len(s.newTracks) == 0 {
+		return 0
+	}
+	candidates := map[string][]*db.Track{}
+	for _, track := range s.newTracks {
+		for _, key := range movedKeys(track) {
+			candidates[key] = append(candidates[key], track)
+		}
+	}
+	movedTracks := map[int]int{}  // gone track id -> new track id
+	movedFolders := map[int]int{} // gone folder id -> new folder id
+	for _, track := range gone {
+		for _, key := range movedKeys(track) {
+			matches := candidates[key]
+			if len(matches) != 1 {
+				continue
+			}
+			match := matches[0]
+			if _, ok := s.newTracks[match.ID]; !ok {
+				// another gone track was moved to it
+				continue
+			}
+			delete(s.newTracks, match.ID)
+			movedTracks[track.ID] = match.ID
+			if _, ok := s.seenFolders[track.AlbumID]; !ok && track.AlbumID != match.AlbumID {
+				if _, ok := movedFolders[track.AlbumID]; !ok {
+					movedFolders[track.AlbumID] = match.AlbumID
+				}
+			}
+			break
+		}
+	}
+	if len(movedTracks) == 0 {
+		return 0
+	}
+	s.db.WithTx(func(tx *gorm.DB) {
+		moveItems(tx, movedTracks)
+		movePlays(tx, movedFolders)
+	})
+	log.Printf("found %d tracks which were moved, in %d folders\n",
+		len(movedTracks), len(movedFolders))
+	return len(movedTracks)
+}
+
+// moveItems rewrites the track ids in playlists and play queues
+func moveItems(tx *gorm.DB, moved map[int]int) {
+	replace := func(ids []int) bool {
+		changed := false
+		for i, id := range ids {
+			if to, ok := moved[id]; ok {
+				ids[i] = to
+				changed = true
+			}
+		}
+		return changed
+	}
+	var playlists []*db.Playlist
+	tx.
+		Select("id, items").
+		Find(&playlists)
+	for _, playlist := range playlists {
+		items := playlist.GetItems()
+		if !replace(items) {
+			continue
+		}
+		playlist.SetItems(items)
+		tx.
+			Model(playlist).
+			UpdateColumn("items", playlist.Items)
+	}
+	var queues []*db.PlayQueue
+	tx.
+		Select("id, items, current").
+		Find(&queues)
+	for _, queue := range queues {
+		items := queue.GetItems()
+		current := []int{queue.Current}
+		itemsChanged := replace(items)
+		if currentChanged := replace(current); !itemsChanged && !currentChanged {
+			continue
+		}
+		queue.SetItems(items)
+		tx.
+			Model(queue).
+			UpdateColumns(map[string]interface{}{
+				"items":   queue.Items,
+				"current": current[0],
+			})
+	}
+}
+
+// movePlays moves the plays of gone folders to the folders their tracks
+// were moved to. if the user has played the new folder too, the plays
+// are added to it
+func movePlays(tx *gorm.DB, moved map[int]int) {
+	for from, to := range moved {
+		var plays []*db.Play
+		tx.
+			Where("album_id=?", from).
+			Find(&plays)
+		for _, play := range plays {
+			existing := &db.Play{}
+			err := tx.
+				Where("album_id=? AND user_id=?", to, play.UserID).
+				First(existing).
+				Error
+			if gorm.IsRecordNotFoundError(err) {
+				tx.
+					Model(play).
+					UpdateColumn("album_id", to)
+				continue
+			}
+			existing.Count += play.Count
+			if play.Time.After(existing.Time) {
+				existing.Time = play.Time
+			}
+			tx.Save(existing)
+			tx.Delete(play)
+		}
+	}
+}
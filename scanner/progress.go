@@ -0,0 +1,149 @@
+package scanner
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Phase is the part of a scan which is running
+type Phase string
+
+const (
+	PhaseWalk  Phase = "walk"
+	PhaseClean Phase = "clean"
+)
+
+// Progress is a snapshot of the current scan, or of the last one if
+// Scanning is false
+type Progress struct {
+	// ID is the ScanID of the scan's errors
+	ID       int64
+	Scanning bool
+	// Phase is empty once the scan is done
+	Phase Phase
+	// Folders and Tracks are how many have been walked past. the tracks
+	// which were new, changed, or couldn't be read are counted too
+	Folders       int
+	Tracks        int
+	TracksNew     int
+	TracksUpdated int
+	TracksErr     int
+	// Expected is how many tracks there were in the db when the scan
+	// started, if the whole library is being scanned. it's what the ETA
+	// is based on. Force is whether everything is being read again
+	Expected    int
+	Force       bool
+	CurrentPath string
+	Started     time.Time
+	Elapsed     time.Duration
+	// ETA is how long the walk should take still, or zero if it isn't
+	// known
+	ETA time.Duration
+	// Cancelled is whether the scan was stopped before it finished
+	Cancelled bool
+}
+
+var (
+	ErrCancelled       = errors.New("scan cancelled")
+	errAlreadyScanning = errors.New("already scanning")
+	errNotScanning     = errors.New("not scanning")
+	errShutdown        = errors.New("scanner is shut down")
+)
+
+func (s *Scanner) IsScanning() bool {
+	return atomic.LoadInt32(&s.scanning) == 1
+}
+
+// startScanning makes sure we don't have more than one scan going on at a
+// time, and starts a new Progress. the scan's context is derived from
+// ctx, so that it can be cancelled. the returned func finishes it
+func (s *Scanner) startScanning(ctx context.Context, expected int, opts ScanOptions) (func(), error) {
+	s.progressMu.Lock()
+	defer s.progressMu.Unlock()
+	if s.shutdown {
+		return nil, errShutdown
+	}
+	if !atomic.CompareAndSwapInt32(&s.scanning, 0, 1) {
+		return nil, errAlreadyScanning
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s.ctx = ctx
+	s.cancel = cancel
+	s.scans.Add(1)
+	started := time.Now()
+	s.scanID = started.UnixNano()
+	s.scanOpts = opts
+	s.progress = Progress{
+		ID:       s.scanID,
+		Scanning: true,
+		Phase:    PhaseWalk,
+		Expected: expected,
+		Force:    opts.Force,
+		Started:  started,
+	}
+	return func() {
+		s.pruneScanErrors()
+		s.updateProgress(func(p *Progress) {
+			p.Scanning = false
+			p.Phase = ""
+			p.CurrentPath = ""
+			p.Elapsed = time.Since(p.Started).Round(time.Second)
+			p.Cancelled = ctx.Err() != nil
+			s.cancel = nil
+		})
+		cancel()
+		atomic.StoreInt32(&s.scanning, 0)
+		s.scans.Done()
+	}, nil
+}
+
+// Cancel stops the current scan. it returns before the scan has stopped
+func (s *Scanner) Cancel() error {
+	s.progressMu.Lock()
+	defer s.progressMu.Unlock()
+	if s.cancel == nil {
+		return errNotScanning
+	}
+	s.cancel()
+	return nil
+}
+
+// Shutdown cancels the current scan and waits for it to stop. no more
+// scans can be started after
+func (s *Scanner) Shutdown() {
+	s.progressMu.Lock()
+	s.shutdown = true
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.progressMu.Unlock()
+	s.scans.Wait()
+}
+
+// updateProgress changes the current Progress. it's only called from the
+// goroutine doing the scan
+func (s *Scanner) updateProgress(fn func(p *Progress)) {
+	s.progressMu.Lock()
+	defer s.progressMu.Unlock()
+	fn(&s.progress)
+}
+
+// Progress returns a snapshot of the current scan, or of the last one
+func (s *Scanner) Progress() Progress {
+	s.progressMu.Lock()
+	p := s.progress
+	s.progressMu.Unlock()
+	if !p.Scanning {
+		return p
+	}
+	elapsed := time.Since(p.Started)
+	p.Elapsed = elapsed.Round(time.Second)
+	if p.Phase == PhaseWalk && p.Tracks > 0 && p.Expected > p.Tracks {
+		perTrack := elapsed / time.Duration(p.Tracks)
+		p.ETA = (perTrack * time.Duration(p.Expected-p.Tracks)).Round(time.Second)
+	}
+	return p
+}
@@ -1,13 +1,15 @@
 package scanner
 
 import (
-	"io/ioutil"
+	"context"
 	"log"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
-	"sync/atomic"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
@@ -20,106 +22,425 @@ import (
 	"senan.xyz/g/gonic/scanner/tags"
 )
 
-// isScanning acts as an atomic boolean semaphore. we don't
-// want to have more than one scan going on at a time
-var isScanning int32
-
-func IsScanning() bool {
-	return atomic.LoadInt32(&isScanning) == 1
-}
-
-func SetScanning() func() {
-	atomic.StoreInt32(&isScanning, 1)
-	return func() {
-		atomic.StoreInt32(&isScanning, 0)
-	}
+// Options are the settings of a Scanner
+type Options struct {
+	// Concurrency is how many tracks can have their tags read at once.
+	// reading is the slow part, especially for remote music folders.
+	// the db is still written to by one goroutine, in walk order
+	Concurrency int
+	// MultiValueDelimiters are the characters which separate the values
+	// of artist and genre tags. eg. ";" for "Jazz; Soul"
+	MultiValueDelimiters string
+	// VariousArtists is the name of the artist compilations are filed
+	// under, when they don't have an album artist tag. it's "Various
+	// Artists" if it's empty
+	VariousArtists string
 }
 
 type Scanner struct {
-	db        *db.DB
-	musicDir  dir.Dir
-	// these two are for the transaction we do for every folder.
-	// the boolean is there so we dont begin or commit multiple
-	// times in the handle folder or post children callback
-	trTx     *gorm.DB
-	trTxOpen bool
+	db          *db.DB
+	musicDirs   map[int]dir.Dir // music folder id -> dir
+	concurrency int
+	delims      string
+	various     string
+	// scanning acts as an atomic boolean semaphore. progress is read by
+	// other goroutines while we scan, so it's behind a mutex, along with
+	// what's needed to stop the scan
+	scanning   int32
+	progressMu sync.Mutex
+	progress   Progress
+	cancel     context.CancelFunc
+	shutdown   bool
+	scans      sync.WaitGroup
+	// the context, id, and options of the current scan, and the music
+	// folder being walked
+	ctx              context.Context
+	scanID           int64
+	scanOpts         ScanOptions
+	curMusicFolderID int
+	// these are the tracks of the current folder whose tags are being
+	// read, in walk order. they're written to the db in one transaction
+	// when the folder is done. tagReads limits the reads in flight
+	curTracks []*trackRead
+	tagReads  chan struct{}
 	// these two are for keeping state between noted in the tree.
 	// eg. keep track of a parents folder or the path to a cover
 	// we just saw that we need to commit in the post children
 	// callback
 	curFolders *stack.Stack
 	curCover   string
+	curCues    cueFolders
+	curLyrics  lyricsFolders
 	// then the rest are for stats and cleanup at the very end
-	seenTracks    map[int]struct{} // set of p keys
-	seenFolders   map[int]struct{} // set of p keys
-	seenTracksNew int              // n tracks not seen before
-	seenTracksErr int              // n tracks we we couldn't scan
+	seenTracks  map[int]struct{} // set of p keys
+	seenFolders map[int]struct{} // set of p keys
+	// the folders which had tracks saved or deleted, which need to be
+	// grouped again. see groupAlbums
+	regroup map[int]struct{}
+	// the tracks which were made in this scan, which could be where gone
+	// ones were moved to. see moveTracks
+	newTracks movedCandidates
+	// for telling how much we read to get the tags of the tracks
+	// which were new or changed
+	seenTracksRead  int   // n tracks we read tags from
+	seenTracksBytes int64 // n bytes fetched reading those tags
 }
 
-func New(db *db.DB, musicDir dir.Dir) *Scanner {
+func New(db *db.DB, musicDirs map[int]dir.Dir, opts Options) *Scanner {
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 1
+	}
+	if opts.VariousArtists == "" {
+		opts.VariousArtists = "Various Artists"
+	}
 	return &Scanner{
 		db:          db,
-		musicDir:    musicDir,
+		musicDirs:   musicDirs,
+		concurrency: opts.Concurrency,
+		delims:      opts.MultiValueDelimiters,
+		various:     opts.VariousArtists,
+		tagReads:    make(chan struct{}, opts.Concurrency),
 		seenTracks:  make(map[int]struct{}),
 		seenFolders: make(map[int]struct{}),
+		regroup:     make(map[int]struct{}),
+		newTracks:   make(movedCandidates),
 		curFolders:  &stack.Stack{},
+		curCues:     make(cueFolders),
+		curLyrics:   make(lyricsFolders),
 	}
 }
 
-func (s *Scanner) Start() error {
-	if IsScanning() {
-		return errors.New("already scanning")
+// ScanOptions change how a single scan goes
+type ScanOptions struct {
+	// Force reads everything again, even the folders and tracks which
+	// haven't been modified since they were last read. eg. for when the
+	// way tags are read changes, or a file was copied with its mod time
+	Force bool
+}
+
+// Start scans every music folder. if ctx is done, or Cancel is called,
+// the scan stops after the track it's on, and ErrCancelled is returned.
+// nothing is cleaned up after a cancelled scan, since it didn't see
+// everything
+func (s *Scanner) Start(ctx context.Context, opts ScanOptions) error {
+	var expected int
+	s.db.
+		Model(db.Track{}).
+		Count(&expected)
+	finish, err := s.startScanning(ctx, expected, opts)
+	if err != nil {
+		return err
 	}
-	unSet := SetScanning()
-	defer unSet()
-	// reset tracking variables when finished
-	defer func() {
-		s.seenTracks = make(map[int]struct{})
-		s.seenFolders = make(map[int]struct{})
-		s.curFolders = &stack.Stack{}
-		s.seenTracksNew = 0
-		s.seenTracksErr = 0
-	}()
+	defer finish()
+	defer s.reset()
 	// ** begin being walking
 	start := time.Now()
-	err := s.musicDir.Walk(s.callbackItem, s.callbackPost)
-	if err != nil {
-		return errors.Wrap(err, "walking music directory")
+	musicFolderIDs := make([]int, 0, len(s.musicDirs))
+	for id := range s.musicDirs {
+		musicFolderIDs = append(musicFolderIDs, id)
 	}
-	log.Printf("finished scan in %s, +%d/%d tracks (%d err)\n",
+	sort.Ints(musicFolderIDs)
+	for _, id := range musicFolderIDs {
+		s.curMusicFolderID = id
+		err := s.musicDirs[id].Walk(s.callbackItem, s.callbackPost)
+		if err != nil {
+			return s.walkError(err, "walking music folder %d", id)
+		}
+	}
+	progress := s.Progress()
+	log.Printf("finished scan in %s, +%d ~%d/%d tracks (%d err), %.1f tracks/s\n",
 		time.Since(start),
-		s.seenTracksNew,
+		progress.TracksNew,
+		progress.TracksUpdated,
 		len(s.seenTracks),
-		s.seenTracksErr,
+		progress.TracksErr,
+		perSecond(len(s.seenTracks), time.Since(start)),
 	)
+	s.logTagReads(time.Since(start))
 
 	// ** begin cleaning
+	s.updateProgress(func(p *Progress) {
+		p.Phase = PhaseClean
+		p.CurrentPath = ""
+	})
 	start = time.Now()
 	var deleted uint
 
-	// delete tracks not on filesystem
+	// only the music folders that were walked are cleaned. the ones which
+	// aren't configured anymore are kept until they're deleted
+	walked := s.db.
+		Model(db.Album{}).
+		Select("id").
+		Where("music_folder_id IN (?)", musicFolderIDs)
+
+	// find where tracks not on filesystem were moved to, then delete them
+	var tracks, gone []*db.Track
+	s.db.
+		Select(movedColumns).
+		Where("album_id IN (?)", walked.QueryExpr()).
+		Find(&tracks)
+	for _, track := range tracks {
+		if _, ok := s.seenTracks[track.ID]; !ok {
+			gone = append(gone, track)
+		}
+	}
+	moved := s.moveTracks(gone)
 	s.db.WithTx(func(tx *gorm.DB) {
-		var tracks []*db.Track
-		tx.Select("id").Find(&tracks)
-		for _, track := range tracks {
-			if _, ok := s.seenTracks[track.ID]; !ok {
-				tx.Delete(track)
-				deleted++
-			}
+		for _, track := range gone {
+			tx.Delete(track)
+			s.regroup[track.AlbumID] = struct{}{}
+			deleted++
 		}
 	})
 
 	// delete folders not on filesystem
 	s.db.WithTx(func(tx *gorm.DB) {
 		var folders []*db.Album
-		tx.Select("id").Find(&folders)
+		tx.
+			Select("id").
+			Where("id IN (?)", walked.QueryExpr()).
+			Find(&folders)
+		for _, folder := range folders {
+			if _, ok := s.seenFolders[folder.ID]; !ok {
+				tx.Delete(folder)
+			}
+		}
+	})
+
+	s.cleanTags()
+	s.groupAlbums()
+	// finish up
+	strNow := strconv.FormatInt(time.Now().Unix(), 10)
+	s.db.SetSetting("last_scan_time", strNow)
+
+	//
+	log.Printf("finished clean in %s, -%d tracks, %d moved\n",
+		time.Since(start),
+		deleted,
+		moved,
+	)
+	return nil
+}
+
+// StartPaths is like Start, but only walks the folders at relPaths in the
+// given music folder. "." is the whole music folder. tracks and folders
+// which were under those paths but aren't anymore are removed, and
+// nothing else is
+func (s *Scanner) StartPaths(ctx context.Context, musicFolderID int, relPaths []string, opts ScanOptions) error {
+	musicDir, ok := s.musicDirs[musicFolderID]
+	if !ok {
+		return errors.Errorf("no music folder with id `%d`", musicFolderID)
+	}
+	// the ETA isn't known, since we don't know how much is under the paths
+	finish, err := s.startScanning(ctx, 0, opts)
+	if err != nil {
+		return err
+	}
+	defer finish()
+	defer s.reset()
+	// ** begin being walking
+	start := time.Now()
+	s.curMusicFolderID = musicFolderID
+	var deleted uint
+	walkRoots := make([]string, 0, len(relPaths))
+	for _, relPath := range relPaths {
+		relPath, parent := s.walkRoot(relPath)
+		s.curFolders = &stack.Stack{}
+		if parent != nil {
+			// so that the walk root gets the right parent
+			s.curFolders.Push(parent)
+		}
+		err := musicDir.WalkPath(relPath, s.callbackItem, s.callbackPost)
+		if err != nil {
+			return s.walkError(err, "walking `%s` in music folder %d", relPath, musicFolderID)
+		}
+		walkRoots = append(walkRoots, relPath)
+	}
+	// every path is walked before any are cleaned, since a track which is
+	// gone from one could have been moved to another
+	s.updateProgress(func(p *Progress) { p.Phase = PhaseClean })
+	for _, relPath := range walkRoots {
+		deleted += s.cleanPath(relPath)
+	}
+	s.cleanTags()
+	s.groupAlbums()
+	progress := s.Progress()
+	log.Printf("finished scan of %d paths in %s, +%d ~%d/%d tracks (%d err), -%d tracks, %.1f tracks/s\n",
+		len(relPaths),
+		time.Since(start),
+		progress.TracksNew,
+		progress.TracksUpdated,
+		len(s.seenTracks),
+		progress.TracksErr,
+		deleted,
+		perSecond(len(s.seenTracks), time.Since(start)),
+	)
+	s.logTagReads(time.Since(start))
+	return nil
+}
+
+// walkError is what to return when a walk stops early. the tracks of the
+// folder it was in are dropped rather than written, since the folder
+// wasn't finished
+func (s *Scanner) walkError(err error, format string, args ...interface{}) error {
+	s.dropTracks()
+	s.dropFolders()
+	if s.ctx.Err() != nil {
+		log.Printf("cancelled scan at `%s`\n", s.Progress().CurrentPath)
+		return ErrCancelled
+	}
+	err = errors.Wrapf(err, format, args...)
+	s.saveError(s.db.DB, s.Progress().CurrentPath, StageWalk, err)
+	return err
+}
+
+// stages of a scan that ScanErrors can come from
+const (
+	StageWalk   = "walk"
+	StageTags   = "tags"
+	StageCue    = "cue"
+	StageLyrics = "lyrics"
+)
+
+// scanErrorsKept is how many of the last scans with errors keep them
+const scanErrorsKept = 10
+
+// saveError records an error with relPath in the current music folder, so
+// that it can be looked at after the scan
+func (s *Scanner) saveError(tx *gorm.DB, relPath, stage string, err error) {
+	tx.Create(&db.ScanError{
+		ScanID:        s.scanID,
+		MusicFolderID: s.curMusicFolderID,
+		Path:          relPath,
+		Stage:         stage,
+		Error:         err.Error(),
+	})
+}
+
+// pruneScanErrors deletes the errors of all but the last few scans
+func (s *Scanner) pruneScanErrors() {
+	s.db.Exec(`
+		DELETE FROM scan_errors
+		WHERE scan_id NOT IN ( SELECT scan_id FROM (
+		                       SELECT DISTINCT scan_id FROM scan_errors
+		                       ORDER BY scan_id DESC
+		                       LIMIT ?
+		                     ) AS kept
+		)`, scanErrorsKept)
+}
+
+// logTagReads logs how much we had to fetch to read the tags of the new
+// or changed tracks in the last walk, which took elapsed
+func (s *Scanner) logTagReads(elapsed time.Duration) {
+	if s.seenTracksRead == 0 {
+		return
+	}
+	log.Printf("read tags of %d tracks with %d workers, %.1f tracks/s, fetched %d bytes, %d per track\n",
+		s.seenTracksRead,
+		s.concurrency,
+		perSecond(s.seenTracksRead, elapsed),
+		s.seenTracksBytes,
+		s.seenTracksBytes/int64(s.seenTracksRead),
+	)
+}
+
+func perSecond(n int, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(n) / elapsed.Seconds()
+}
+
+// reset clears the tracking variables after a scan
+func (s *Scanner) reset() {
+	s.seenTracks = make(map[int]struct{})
+	s.seenFolders = make(map[int]struct{})
+	s.regroup = make(map[int]struct{})
+	s.newTracks = make(movedCandidates)
+	s.curFolders = &stack.Stack{}
+	s.curCues = make(cueFolders)
+	s.curLyrics = make(lyricsFolders)
+	s.curTracks = nil
+	s.seenTracksRead = 0
+	s.seenTracksBytes = 0
+}
+
+// walkRoot finds where to start walking to rescan relPath. the folder
+// above the walk root needs to be in the db already so that what we find
+// can be attached to it, so climb up until there is one
+func (s *Scanner) walkRoot(relPath string) (string, *db.Album) {
+	relPath = path.Clean(relPath)
+	for relPath != "." {
+		parentDirectory, parentFilename := path.Split(path.Dir(relPath))
+		parent := &db.Album{}
+		err := s.db.
+			Select("id").
+			Where("music_folder_id=? AND left_path=? AND right_path=?",
+				s.curMusicFolderID, parentDirectory, parentFilename).
+			First(parent).
+			Error
+		if err == nil {
+			return relPath, parent
+		}
+		relPath = path.Dir(relPath)
+	}
+	return relPath, nil
+}
+
+// cleanPath deletes the tracks and folders at or under relPath which
+// weren't seen in the last walk. it returns the number of tracks deleted
+func (s *Scanner) cleanPath(relPath string) uint {
+	directory, filename := path.Split(relPath)
+	q := s.db.
+		Model(db.Album{}).
+		Select("id").
+		Where("music_folder_id=?", s.curMusicFolderID)
+	if relPath != "." {
+		// the folder itself, or anything with a left path inside it
+		prefix := relPath + "/"
+		q = q.Where(`
+			(left_path=? AND right_path=?)
+			OR substr(left_path, 1, ?)=?`,
+			directory, filename,
+			utf8.RuneCountInString(prefix), prefix)
+	}
+	var deleted uint
+	var tracks, gone []*db.Track
+	s.db.
+		Select(movedColumns).
+		Where("album_id IN (?)", q.QueryExpr()).
+		Find(&tracks)
+	for _, track := range tracks {
+		if _, ok := s.seenTracks[track.ID]; !ok {
+			gone = append(gone, track)
+		}
+	}
+	s.moveTracks(gone)
+	s.db.WithTx(func(tx *gorm.DB) {
+		for _, track := range gone {
+			tx.Delete(track)
+			s.regroup[track.AlbumID] = struct{}{}
+			deleted++
+		}
+	})
+	s.db.WithTx(func(tx *gorm.DB) {
+		var folders []*db.Album
+		tx.
+			Select("id").
+			Where("id IN (?)", q.QueryExpr()).
+			Find(&folders)
 		for _, folder := range folders {
 			if _, ok := s.seenFolders[folder.ID]; !ok {
 				tx.Delete(folder)
 			}
 		}
 	})
+	return deleted
+}
 
+// cleanTags deletes the tag based albums and artists which were left
+// without anything in them
+func (s *Scanner) cleanTags() {
 	// delete albums without tracks
 	s.db.Exec(`
 		DELETE FROM albums
@@ -128,22 +449,58 @@ func (s *Scanner) Start() error {
 		                 WHERE tracks.album_id=albums.id
 		)`)
 
+	// find new covers for albums whose cover track is gone
+	s.db.Exec(`
+		UPDATE albums
+		SET cover_track_id=( SELECT id FROM tracks
+		                     WHERE tracks.album_id=albums.id
+		                     AND tracks.embedded_cover=?
+		                     ORDER BY tag_disc_number, tag_track_number, filename
+		                     LIMIT 1
+		)
+		WHERE cover_track_id IS NOT NULL AND cover_track_id!=0
+		AND NOT EXISTS ( SELECT 1 FROM tracks
+		                 WHERE tracks.id=albums.cover_track_id
+		)`, true)
+
+	// delete the artists and genres of albums and tracks which are gone
+	s.db.Exec(`
+		DELETE FROM album_artists
+		WHERE NOT EXISTS ( SELECT 1 FROM albums
+		                   WHERE albums.id=album_artists.album_id
+		)`)
+	s.db.Exec(`
+		DELETE FROM album_genres
+		WHERE NOT EXISTS ( SELECT 1 FROM albums
+		                   WHERE albums.id=album_genres.album_id
+		)`)
+	s.db.Exec(`
+		DELETE FROM track_genres
+		WHERE NOT EXISTS ( SELECT 1 FROM tracks
+		                   WHERE tracks.id=track_genres.track_id
+		)`)
+	s.db.Exec(`
+		DELETE FROM lyrics
+		WHERE NOT EXISTS ( SELECT 1 FROM tracks
+		                   WHERE tracks.id=lyrics.track_id
+		)`)
+
 	// delete artists without albums
 	s.db.Exec(`
 		DELETE FROM artists
-		WHERE NOT EXISTS ( SELECT 1 from albums
-		                   WHERE albums.tag_artist_id=artists.id
+		WHERE NOT EXISTS ( SELECT 1 from album_artists
+		                   WHERE album_artists.artist_id=artists.id
 		)`)
-	// finish up
-	strNow := strconv.FormatInt(time.Now().Unix(), 10)
-	s.db.SetSetting("last_scan_time", strNow)
 
-	//
-	log.Printf("finished clean in %s, -%d tracks\n",
-		time.Since(start),
-		deleted,
-	)
-	return nil
+	// delete genres without albums or tracks
+	s.db.Exec(`
+		DELETE FROM genres
+		WHERE NOT EXISTS ( SELECT 1 from album_genres
+		                   WHERE album_genres.genre_id=genres.id
+		)
+		AND NOT EXISTS ( SELECT 1 from track_genres
+		                 WHERE track_genres.genre_id=genres.id
+		)`)
 }
 
 // items are passed to the handle*() functions
@@ -175,6 +532,9 @@ var coverFilenames = map[string]struct{}{
 // ## begin callbacks
 
 func (s *Scanner) callbackItem(relPath string, fileSize int64, modTime time.Time, isDirectory bool) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
 	directory, filename := path.Split(relPath)
 	it := &item{
 		relPath: relPath,
@@ -201,6 +561,14 @@ func (s *Scanner) callbackItem(relPath string, fileSize int64, modTime time.Time
 	if _, ok := mime.Types[strings.ToLower(ext[1:])]; ok {
 		return s.handleTrack(it)
 	}
+	if strings.EqualFold(ext, ".cue") {
+		s.handleCue(it)
+		return nil
+	}
+	if strings.EqualFold(ext, ".lrc") {
+		s.handleLRC(it)
+		return nil
+	}
 
 	log.Printf("Extension of `%s` is unsupported\n", filename);
 	return nil
@@ -210,19 +578,27 @@ func (s *Scanner) callbackPost(_ string) error {
 	defer func() {
 		s.curCover = ""
 	}()
-	if s.trTxOpen {
-		s.trTx.Commit()
-		s.trTxOpen = false
-	}
+	s.flushTracks()
+	s.saveCues(s.curFolders.Peek())
+	s.saveLyrics(s.curFolders.Peek())
+	s.saveCompilation(s.curFolders.Peek())
 
 	// begin taking the current folder off the stack and add it's
 	// parent, cover that we found, etc.
 	folder := s.curFolders.Pop()
 	if !folder.ReceivedPaths {
+		if folder.SavedTracks {
+			// a track's picture could have changed, without the folder
+			// having changed
+			s.db.
+				Model(folder).
+				UpdateColumn("cover_track_id", s.coverTrackID(folder.ID))
+		}
 		return nil
 	}
 	folder.ParentID = s.curFolders.PeekID()
 	folder.Cover = s.curCover
+	folder.CoverTrackID = s.coverTrackID(folder.ID)
 	s.db.Save(folder)
 
 	// we only log changed folders
@@ -231,6 +607,18 @@ func (s *Scanner) callbackPost(_ string) error {
 	return nil
 }
 
+// coverTrackID returns the first track in the folder with an embedded
+// picture, or zero if there isn't one
+func (s *Scanner) coverTrackID(folderID int) int {
+	track := &db.Track{}
+	s.db.
+		Select("id").
+		Where("album_id=? AND embedded_cover=?", folderID, true).
+		Order("tag_disc_number, tag_track_number, filename").
+		First(track)
+	return track.ID
+}
+
 // decoded converts a string to it's latin equivalent. it will
 // be used by the model's *UDec fields, and is only set if it
 // differs from the original. the fields are used for searching
@@ -247,13 +635,13 @@ func decoded(in string) string {
 // ## begin handlers
 
 func (s *Scanner) handleFolder(it *item) error {
-	if s.trTxOpen {
-		// a transaction still being open when we handle a folder can
-		// happen if there is a folder that contains /both/ tracks and
-		// sub folders
-		s.trTx.Commit()
-		s.trTxOpen = false
-	}
+	// tracks still waiting when we handle a folder can happen if
+	// there is a folder that contains /both/ tracks and sub folders
+	s.flushTracks()
+	s.updateProgress(func(p *Progress) {
+		p.Folders++
+		p.CurrentPath = it.relPath
+	})
 	folder := &db.Album{}
 	defer func() {
 		// folder's id will come from early return
@@ -262,19 +650,23 @@ func (s *Scanner) handleFolder(it *item) error {
 		s.curFolders.Push(folder)
 	}()
 	err := s.db.
-		Select("id, updated_at").
-		Where(db.Album{
-			LeftPath:  it.directory,
-			RightPath: it.filename,
-		}).
+		// the measured loudness is kept, see saveTrack. and the tag album
+		// until it's found again, see groupAlbums
+		Select("id, updated_at, replay_gain_album_gain, replay_gain_album_peak, replay_gain_analysed, tag_album_id").
+		// not using a struct here, since an empty left path
+		// (top level folders) would be left out of the query
+		Where("music_folder_id=? AND left_path=? AND right_path=?",
+			s.curMusicFolderID, it.directory, it.filename).
 		First(folder).
 		Error
 	if !gorm.IsRecordNotFoundError(err) &&
+		!s.scanOpts.Force &&
 		it.modTime.Before(folder.UpdatedAt) {
 		// we found the record but it hasn't changed
 		log.Printf("Folder `%s/%s` hasn't changed, so not updating it", it.directory, it.filename)
 		return nil
 	}
+	folder.MusicFolderID = s.curMusicFolderID
 	folder.LeftPath = it.directory
 	folder.RightPath = it.filename
 	folder.RightPathUDec = decoded(it.filename)
@@ -284,60 +676,140 @@ func (s *Scanner) handleFolder(it *item) error {
 	return nil
 }
 
+// trackRead is a track whose tags are being read by a worker. done is
+// closed once tags, fetched, and err are set
+type trackRead struct {
+	it      *item
+	track   *db.Track
+	folder  *db.Album
+	tags    *tags.Tags
+	hash    string
+	fetched int64
+	err     error
+	done    chan struct{}
+}
+
 func (s *Scanner) handleTrack(it *item) error {
-	if !s.trTxOpen {
-		s.trTx = s.db.Begin()
-		s.trTxOpen = true
-	}
 	log.Printf("Handling track `%s`", it.relPath)
+	s.updateProgress(func(p *Progress) {
+		p.Tracks++
+		p.CurrentPath = it.relPath
+	})
 
 	// ** begin set track basics
 	track := &db.Track{}
-	err := s.trTx.
-		Select("id, updated_at").
-		Where(db.Track{
-			AlbumID:  s.curFolders.PeekID(),
-			Filename: it.filename,
-		}).
+	err := s.db.
+		Select("id, updated_at, replay_gain_track_gain, replay_gain_track_peak, replay_gain_analysed, content_hash").
+		Where("album_id=? AND filename=? AND cue_track=0",
+			s.curFolders.PeekID(), it.filename).
 		First(track).
 		Error
-	if !gorm.IsRecordNotFoundError(err) && it.modTime.Before(track.UpdatedAt) {
-		// we found the record but it hasn't changed
+	if gorm.IsRecordNotFoundError(err) && s.handleSplitTrack(it, s.curFolders.Peek()) {
+		return nil
+	}
+	if !gorm.IsRecordNotFoundError(err) && !s.scanOpts.Force && it.modTime.Before(track.UpdatedAt) &&
+		track.ContentHash != "" {
+		// we found the record but it hasn't changed. tracks from before
+		// there were content hashes are read again, once, to get one
 		s.seenTracks[track.ID] = struct{}{}
 		return nil
 	}
 
-	track.Filename = it.filename
-	track.FilenameUDec = decoded(it.filename)
-	track.Size = int(it.size)
-	track.AlbumID = s.curFolders.PeekID()
+	// ** begin read the tags in the background
+	// only the parts of the file with tags in them are read, rather than
+	// the whole thing. that matters a lot when the file is remote. this
+	// blocks the walk if there are already as many reads as workers
+	read := &trackRead{
+		it:     it,
+		track:  track,
+		folder: s.curFolders.Peek(),
+		done:   make(chan struct{}),
+	}
+	s.curTracks = append(s.curTracks, read)
+	musicDir := s.musicDirs[s.curMusicFolderID]
+	s.tagReads <- struct{}{}
+	go func() {
+		defer func() {
+			<-s.tagReads
+			close(read.done)
+		}()
+		read.tags, read.fetched, read.err = tags.NewFromRanges(it.relPath, it.size,
+			func(offset, length int64) ([]byte, error) {
+				return musicDir.GetFileRange(it.relPath, offset, length)
+			})
+		if read.err != nil {
+			return
+		}
+		// for finding the track again if it's moved, see moveTracks
+		hash, fetched, err := readContentHash(musicDir, it, read.tags)
+		if err != nil {
+			log.Printf("error hashing `%s`: %v", it.relPath, err)
+			return
+		}
+		read.hash = hash
+		read.fetched += fetched
+	}()
+	return nil
+}
 
-	_, reader, err := s.musicDir.GetFile(it.relPath)
-	if err != nil {
-		// not returning the error here because we don't want
-		// the entire walk to stop if we can't read the tags
-		// of a single file
-		log.Printf("error retrieving file `%v`: %v", it.relPath, err)
-		s.seenTracksErr++
-		return nil
+// flushTracks waits for the tags of the current folder's tracks, and
+// writes them to the db in the order they were walked
+func (s *Scanner) flushTracks() {
+	if len(s.curTracks) == 0 {
+		return
 	}
-	data, err := ioutil.ReadAll(reader)
-	if err != nil {
-		log.Printf("error reading file `%v`: %v", it.relPath, err)
-		s.seenTracksErr++
-		return nil
+	tx := s.db.Begin()
+	for _, read := range s.curTracks {
+		<-read.done
+		s.saveTrack(tx, read)
 	}
-	if err := reader.Close(); err != nil {
-		log.Printf("error closing file `%v`: %v", it.relPath, err)
-		s.seenTracksErr++
-		return nil
+	tx.Commit()
+	s.curTracks = nil
+}
+
+// dropFolders forgets the folders which the walk was still in. the ones
+// which were new or changed were saved with the time they were scanned,
+// so it's reset for the next scan to read them again, rather than it
+// thinking they haven't changed and leaving them without their tracks
+func (s *Scanner) dropFolders() {
+	s.db.WithTx(func(tx *gorm.DB) {
+		for folder := s.curFolders.Pop(); folder != nil; folder = s.curFolders.Pop() {
+			if !folder.ReceivedPaths {
+				continue
+			}
+			tx.
+				Model(folder).
+				UpdateColumn("updated_at", time.Time{})
+		}
+	})
+}
+
+// dropTracks waits for the reads of the current folder's tracks to finish,
+// then forgets them
+func (s *Scanner) dropTracks() {
+	for _, read := range s.curTracks {
+		<-read.done
 	}
-	trTags, err := tags.NewFromBytes(it.relPath, data)
-	if err != nil {
-		log.Printf("error reading tags `%s`: %v", it.relPath, err)
-		s.seenTracksErr++
-		return nil
+	s.curTracks = nil
+}
+
+func (s *Scanner) saveTrack(tx *gorm.DB, read *trackRead) {
+	it, track, trTags := read.it, read.track, read.tags
+	s.seenTracksRead++
+	s.seenTracksBytes += read.fetched
+	if read.err != nil {
+		// not returning the error here because we don't want
+		// the entire walk to stop if we can't read the tags
+		// of a single file
+		log.Printf("error reading tags `%s`: %v", it.relPath, read.err)
+		s.saveError(tx, it.relPath, StageTags, read.err)
+		s.updateProgress(func(p *Progress) { p.TracksErr++ })
+		return
 	}
+	track.Filename = it.filename
+	track.FilenameUDec = decoded(it.filename)
+	track.Size = int(it.size)
+	track.AlbumID = read.folder.ID
 	track.TagTitle = trTags.Title()
 	track.TagTitleUDec = decoded(trTags.Title())
 	track.TagTrackArtist = trTags.Artist()
@@ -346,66 +818,149 @@ func (s *Scanner) handleTrack(it *item) error {
 	track.TagBrainzID = trTags.BrainzID()
 	track.Length = trTags.Length()   // these two should be calculated
 	track.Bitrate = trTags.Bitrate() // ...from the file instead of tags
+	track.EmbeddedCover = trTags.HasPicture()
+	track.TagComposer = trTags.Composer()
+	track.TagConductor = trTags.Conductor()
+	track.TagComment = trTags.Comment()
+	track.TagBPM = trTags.BPM()
+	track.TagArtistSort = trTags.ArtistSort()
+	track.ContentHash = read.hash
+	if gain := trTags.TrackGain(); gain != nil || !track.ReplayGainAnalysed {
+		// keep what was measured if there are no tags to replace it
+		track.ReplayGainTrackGain = gain
+		track.ReplayGainTrackPeak = trTags.TrackPeak()
+		track.ReplayGainAnalysed = false
+	}
 
-	// ** begin set album artist basics
-	artistName := func() string {
-		if r := trTags.AlbumArtist(); r != "" {
-			return r
-		}
-		if r := trTags.Artist(); r != "" {
-			return r
+	// ** begin set album artists basics
+	artistNames := trTags.AlbumArtists(s.delims)
+	if len(artistNames) == 0 {
+		artistNames = trTags.Artists(s.delims)
+	}
+	if len(artistNames) == 0 {
+		artistNames = []string{"Unknown Artist"}
+	}
+	artistIDs := findArtists(tx, artistNames)
+	track.ArtistID = artistIDs[0]
+	if !read.folder.ReceivedPaths {
+		// the folder hasn't changed, so its tracks were filed under its
+		// album artist when it last did. see saveCompilation
+		if artistID := compilationArtistID(tx, read.folder.ID); artistID != 0 {
+			track.ArtistID = artistID
 		}
-		return "Unknown Artist"
-	}()
-	artist := &db.Artist{}
-	err = s.trTx.
-		Select("id").
-		Where("name=?", artistName).
-		First(artist).
-		Error
-	if gorm.IsRecordNotFoundError(err) {
-		artist.Name = artistName
-		artist.NameUDec = decoded(artistName)
-		s.trTx.Save(artist)
 	}
-	track.ArtistID = artist.ID
 
-	// ** begin set genre
-	genreName := func() string {
-		if r := trTags.Genre(); r != "" {
-			return r
-		}
-		return "Unknown Genre"
-	}()
-	genre := &db.Genre{}
-	err = s.trTx.
-		Select("id").
-		Where("name=?", genreName).
-		First(genre).
-		Error
-	if gorm.IsRecordNotFoundError(err) {
-		genre.Name = genreName
-		s.trTx.Save(genre)
+	// ** begin set genres
+	genreNames := trTags.Genres(s.delims)
+	if len(genreNames) == 0 {
+		genreNames = []string{"Unknown Genre"}
 	}
-	track.TagGenreID = genre.ID
+	genreIDs := findGenres(tx, genreNames)
+	track.TagGenreID = genreIDs[0]
 
 	// ** begin save the track
-	s.trTx.Save(track)
+	isNew := track.ID == 0
+	tx.Save(track)
+	if isNew {
+		s.noteNewTrack(track)
+	}
+	tx.Where("track_id=?", track.ID).Delete(db.TrackGenre{})
+	for _, genreID := range genreIDs {
+		tx.Create(&db.TrackGenre{TrackID: track.ID, GenreID: genreID})
+	}
+	saveTagLyrics(tx, track.ID, trTags.Lyrics())
 	s.seenTracks[track.ID] = struct{}{}
-	s.seenTracksNew++
+	s.regroup[track.AlbumID] = struct{}{}
+	read.folder.SavedTracks = true
+	s.updateProgress(func(p *Progress) {
+		if isNew {
+			p.TracksNew++
+			return
+		}
+		p.TracksUpdated++
+	})
 
 	// ** begin set album if this is the first track in the folder
-	folder := s.curFolders.Peek()
+	folder := read.folder
+	if folder.ReceivedTags && trTags.Compilation() {
+		// any of the tracks being flagged makes it a compilation, not just
+		// the first. see saveCompilation
+		folder.TagCompilation = true
+	}
 	if !folder.ReceivedPaths || folder.ReceivedTags {
 		// the folder hasn't been modified or already has it's tags
-		return nil
+		return
 	}
 	folder.TagTitle = trTags.Album()
 	folder.TagTitleUDec = decoded(trTags.Album())
 	folder.TagBrainzID = trTags.AlbumBrainzID()
 	folder.TagYear = trTags.Year()
-	folder.TagArtistID = artist.ID
-	folder.TagGenreID = genre.ID
+	folder.TagTitleSort = trTags.AlbumSort()
+	folder.TagArtistSort = trTags.AlbumArtistSort()
+	folder.TagLabel = trTags.Label()
+	folder.TagCatalogNumber = trTags.CatalogNumber()
+	folder.TagOriginalDate = trTags.OriginalDate()
+	folder.TagOriginalYear = trTags.OriginalYear()
+	folder.TagReleaseTypes = strings.Join(trTags.ReleaseTypes(), "; ")
+	folder.TagCompilation = trTags.Compilation()
+	folder.ReceivedAlbumArtist = len(trTags.AlbumArtists(s.delims)) > 0
+	folder.TagArtistID = artistIDs[0]
+	folder.TagGenreID = genreIDs[0]
+	if gain := trTags.AlbumGain(); gain != nil || !folder.ReplayGainAnalysed {
+		folder.ReplayGainAlbumGain = gain
+		folder.ReplayGainAlbumPeak = trTags.AlbumPeak()
+		folder.ReplayGainAnalysed = false
+	}
+	if folder.TagAlbumID == 0 {
+		folder.TagAlbumID = folder.ID
+	}
 	folder.ReceivedTags = true
-	return nil
+	tx.Where("album_id=?", folder.ID).Delete(db.AlbumArtist{})
+	for _, artistID := range artistIDs {
+		tx.Create(&db.AlbumArtist{AlbumID: folder.ID, ArtistID: artistID})
+	}
+	tx.Where("album_id=?", folder.ID).Delete(db.AlbumGenre{})
+	for _, genreID := range genreIDs {
+		tx.Create(&db.AlbumGenre{AlbumID: folder.ID, GenreID: genreID})
+	}
+}
+
+// findArtists returns the ids of the artists with the names, in the same
+// order. the ones which aren't in the db yet are made
+func findArtists(tx *gorm.DB, names []string) []int {
+	ids := make([]int, 0, len(names))
+	for _, name := range names {
+		artist := &db.Artist{}
+		err := tx.
+			Select("id").
+			Where("name=?", name).
+			First(artist).
+			Error
+		if gorm.IsRecordNotFoundError(err) {
+			artist.Name = name
+			artist.NameUDec = decoded(name)
+			tx.Save(artist)
+		}
+		ids = append(ids, artist.ID)
+	}
+	return ids
+}
+
+// findGenres is like findArtists, for genres
+func findGenres(tx *gorm.DB, names []string) []int {
+	ids := make([]int, 0, len(names))
+	for _, name := range names {
+		genre := &db.Genre{}
+		err := tx.
+			Select("id").
+			Where("name=?", name).
+			First(genre).
+			Error
+		if gorm.IsRecordNotFoundError(err) {
+			genre.Name = name
+			tx.Save(genre)
+		}
+		ids = append(ids, genre.ID)
+	}
+	return ids
 }
@@ -1,13 +1,23 @@
 package scanner
 
 import (
+	"context"
 	"io/ioutil"
 	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
 
 	"senan.xyz/g/gonic/db"
+	"senan.xyz/g/gonic/dir"
+	"senan.xyz/g/gonic/scanner/cue"
+	"senan.xyz/g/gonic/scanner/tags"
 )
 
 var testScanner *Scanner
@@ -19,7 +29,8 @@ func init() {
 	}
 	// benchmarks aren't real code are they? >:)
 	// here is an absolute path to my music directory
-	testScanner = New(db, "/home/senan/music")
+	musicDir, _ := dir.NewLocalDir("/home/senan/music")
+	testScanner = New(db, map[int]dir.Dir{1: musicDir}, Options{Concurrency: 4})
 	log.SetOutput(ioutil.Discard)
 }
 
@@ -29,6 +40,10 @@ func resetTables(db *db.DB) {
 	tx.Exec("delete from tracks")
 	tx.Exec("delete from artists")
 	tx.Exec("delete from albums")
+	tx.Exec("delete from genres")
+	tx.Exec("delete from album_artists")
+	tx.Exec("delete from album_genres")
+	tx.Exec("delete from track_genres")
 }
 
 func resetTablesPause(db *db.DB, b *testing.B) {
@@ -40,17 +55,17 @@ func resetTablesPause(db *db.DB, b *testing.B) {
 func BenchmarkScanFresh(b *testing.B) {
 	for n := 0; n < b.N; n++ {
 		resetTablesPause(testScanner.db, b)
-		testScanner.Start()
+		testScanner.Start(context.Background(), ScanOptions{})
 	}
 }
 
 func BenchmarkScanIncremental(b *testing.B) {
 	// do a full scan and reset
-	testScanner.Start()
+	testScanner.Start(context.Background(), ScanOptions{})
 	b.ResetTimer()
 	// do the inc scans
 	for n := 0; n < b.N; n++ {
-		testScanner.Start()
+		testScanner.Start(context.Background(), ScanOptions{})
 	}
 }
 
@@ -63,3 +78,460 @@ func BenchmarkScanIncremental(b *testing.B) {
 // 100 times / 1.9
 // 100 times / 1.5
 // 100 times / 1.48
+
+func TestPruneScanErrors(t *testing.T) {
+	testScanner.db.Exec("DELETE FROM scan_errors")
+	testScanner.db.SetMusicFolders([]*db.MusicFolder{{Name: "music", Location: "/music"}})
+	var folder db.MusicFolder
+	testScanner.db.First(&folder)
+	for scanID := int64(1); scanID <= scanErrorsKept+2; scanID++ {
+		for i := 0; i < 2; i++ {
+			testScanner.db.Create(&db.ScanError{
+				ScanID:        scanID,
+				MusicFolderID: folder.ID,
+				Path:          "a/01.flac",
+				Stage:         StageTags,
+				Error:         "bad",
+			})
+		}
+	}
+	testScanner.pruneScanErrors()
+	var scanIDs []int64
+	testScanner.db.
+		Model(db.ScanError{}).
+		Order("scan_id").
+		Pluck("DISTINCT scan_id", &scanIDs)
+	if len(scanIDs) != scanErrorsKept || scanIDs[0] != 3 {
+		t.Errorf("expected the last %d scans to be kept, got %v", scanErrorsKept, scanIDs)
+	}
+}
+
+func TestCleanTagsMultiValue(t *testing.T) {
+	resetTables(testScanner.db)
+	tx := testScanner.db.DB
+	artistIDs := findArtists(tx, []string{"A", "B", "C"})
+	genreIDs := findGenres(tx, []string{"Jazz", "Soul", "Funk"})
+	// the first album has a track, and two artists and genres. the second
+	// one, with the last artist and genre, has been emptied
+	for _, album := range []*db.Album{
+		{ID: 10, LeftPath: "", RightPath: "x", TagArtistID: artistIDs[0], TagGenreID: genreIDs[0]},
+		{ID: 11, LeftPath: "", RightPath: "y", TagArtistID: artistIDs[2], TagGenreID: genreIDs[2]},
+	} {
+		tx.Create(album)
+	}
+	tx.Create(&db.Track{ID: 100, Filename: "01.flac", AlbumID: 10, ArtistID: artistIDs[0], Size: 1})
+	tx.Create(&db.AlbumArtist{AlbumID: 10, ArtistID: artistIDs[0]})
+	tx.Create(&db.AlbumArtist{AlbumID: 10, ArtistID: artistIDs[1]})
+	tx.Create(&db.AlbumArtist{AlbumID: 11, ArtistID: artistIDs[2]})
+	tx.Create(&db.AlbumGenre{AlbumID: 10, GenreID: genreIDs[0]})
+	tx.Create(&db.AlbumGenre{AlbumID: 11, GenreID: genreIDs[2]})
+	tx.Create(&db.TrackGenre{TrackID: 100, GenreID: genreIDs[0]})
+	tx.Create(&db.TrackGenre{TrackID: 100, GenreID: genreIDs[1]})
+	testScanner.cleanTags()
+	var artists, genres []string
+	tx.Model(db.Artist{}).Order("name").Pluck("name", &artists)
+	tx.Model(db.Genre{}).Order("name").Pluck("name", &genres)
+	if exp := []string{"A", "B"}; !reflect.DeepEqual(artists, exp) {
+		t.Errorf("expected artists %q, got %q", exp, artists)
+	}
+	if exp := []string{"Jazz", "Soul"}; !reflect.DeepEqual(genres, exp) {
+		t.Errorf("expected genres %q, got %q", exp, genres)
+	}
+	var albumArtists int
+	tx.Model(db.AlbumArtist{}).Count(&albumArtists)
+	if albumArtists != 2 {
+		t.Errorf("expected the emptied album's artists to be deleted, got %d left", albumArtists)
+	}
+}
+
+func TestSplitTrack(t *testing.T) {
+	resetTables(testScanner.db)
+	tx := testScanner.db.DB
+	artistIDs := findArtists(tx, []string{"A"})
+	genreIDs := findGenres(tx, []string{"Jazz"})
+	folder := &db.Album{ID: 10, RightPath: "x", TagArtistID: artistIDs[0], TagGenreID: genreIDs[0]}
+	tx.Create(folder)
+	tx.Create(&db.Track{ID: 100, Filename: "album.flac", AlbumID: 10, ArtistID: artistIDs[0],
+		TagGenreID: genreIDs[0], Length: 300, Size: 3000})
+	tx.Create(&db.TrackGenre{TrackID: 100, GenreID: genreIDs[0]})
+	sheet, err := cue.Parse(strings.NewReader(`PERFORMER "B"
+FILE "album.wav" WAVE
+  TRACK 01 AUDIO
+    TITLE "one"
+    INDEX 01 00:00:00
+  TRACK 02 AUDIO
+    TITLE "two"
+    INDEX 01 01:40:00
+`))
+	if err != nil {
+		t.Fatalf("parsing sheet: %v", err)
+	}
+	if filename := testScanner.cueFilename(folder, sheet.Files[0].Name); filename != "album.flac" {
+		t.Fatalf("expected the sheet's file to be found, got %q", filename)
+	}
+	var user db.User
+	tx.First(&user)
+	tx.Exec("DELETE FROM playlists")
+	playlist := &db.Playlist{UserID: user.ID, Name: "p"}
+	playlist.SetItems([]int{100})
+	tx.Create(playlist)
+	sheetItem := &item{filename: "album.cue", modTime: time.Now().Add(-time.Hour)}
+	testScanner.reset()
+	testScanner.splitTrack(tx, folder, "album.flac", sheetItem, sheet, sheet.Files[0])
+	var tracks []*db.Track
+	tx.Order("cue_track").Find(&tracks)
+	if len(tracks) != 2 {
+		t.Fatalf("expected the file to be split into two tracks, got %d", len(tracks))
+	}
+	tx.First(playlist, playlist.ID)
+	if items := playlist.GetItems(); len(items) != 1 || items[0] != tracks[0].ID {
+		t.Errorf("expected the first track to take the whole file's place in playlists, got %v", items)
+	}
+	if _, ok := testScanner.regroup[folder.ID]; !ok {
+		t.Errorf("expected the folder to be grouped again")
+	}
+	if tracks[0].TagTitle != "one" || tracks[0].CueStart != 0 || tracks[0].CueEnd != 100000 || tracks[0].Length != 100 {
+		t.Errorf("unexpected first track %+v", tracks[0])
+	}
+	if tracks[1].CueTrack != 2 || tracks[1].CueEnd != 0 || tracks[1].Length != 200 || tracks[1].Size != 2000 {
+		t.Errorf("unexpected last track %+v", tracks[1])
+	}
+	var artist db.Artist
+	tx.First(&artist, folder.TagArtistID)
+	if artist.Name != "B" {
+		t.Errorf("expected the sheet's performer to be the album artist, got %q", artist.Name)
+	}
+	// splitting again with the same sheet keeps the tracks
+	ids := []int{tracks[0].ID, tracks[1].ID}
+	testScanner.splitTrack(tx, folder, "album.flac", sheetItem, sheet, sheet.Files[0])
+	tracks = nil
+	tx.Order("cue_track").Find(&tracks)
+	if len(tracks) != 2 || tracks[0].ID != ids[0] || tracks[1].ID != ids[1] {
+		t.Errorf("expected the split tracks to be kept, got %+v", tracks)
+	}
+	// the sheet is gone, and the file was read again as a whole track
+	tx.Exec("DELETE FROM tracks")
+	tx.Create(&db.Track{ID: 101, Filename: "album.flac", AlbumID: 10, ArtistID: artistIDs[0], Size: 1})
+	playlist.SetItems(ids)
+	tx.Save(playlist)
+	testScanner.moveUnsplitTracks(folder, map[string][]int{"album.flac": ids})
+	tx.First(playlist, playlist.ID)
+	if items := playlist.GetItems(); !reflect.DeepEqual(items, []int{101, 101}) {
+		t.Errorf("expected the whole track to take the split tracks' places in playlists, got %v", items)
+	}
+}
+
+func TestSaveLyrics(t *testing.T) {
+	resetTables(testScanner.db)
+	testScanner.db.Exec("DELETE FROM lyrics")
+	tmp, err := ioutil.TempDir("", "gonic-lyrics")
+	if err != nil {
+		t.Fatalf("making temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+	lrc := "[00:01.00]first line\n[00:02.50]second line\n"
+	if err := ioutil.WriteFile(filepath.Join(tmp, "01 Track.lrc"), []byte(lrc), 0644); err != nil {
+		t.Fatalf("writing lrc: %v", err)
+	}
+	musicDir, _ := dir.NewLocalDir(tmp)
+	s := New(testScanner.db, map[int]dir.Dir{1: musicDir}, Options{})
+	s.curMusicFolderID = 1
+	tx := s.db.DB
+	artistIDs := findArtists(tx, []string{"A"})
+	folder := &db.Album{ID: 10, RightPath: "x", TagArtistID: artistIDs[0]}
+	tx.Create(folder)
+	tx.Create(&db.Track{ID: 100, Filename: "01 track.flac", AlbumID: 10, ArtistID: artistIDs[0], Size: 1})
+	tx.Create(&db.Track{ID: 101, Filename: "02 track.flac", AlbumID: 10, ArtistID: artistIDs[0], Size: 1})
+	// the second track's lrc file is gone
+	tx.Create(&db.Lyrics{TrackID: 101, Source: db.LyricsSourceLRC, Text: "[00:01.00]gone"})
+	s.curLyrics[folder] = []*item{{
+		relPath:  "01 Track.lrc",
+		filename: "01 Track.lrc",
+		size:     int64(len(lrc)),
+		modTime:  time.Now().Add(-time.Hour),
+	}}
+	s.saveLyrics(folder)
+	var rows []*db.Lyrics
+	tx.Find(&rows)
+	if len(rows) != 1 || rows[0].TrackID != 100 || rows[0].Text != lrc {
+		t.Errorf("expected the lrc file to be saved for the first track only, got %+v", rows[0])
+	}
+}
+
+func TestGroupAlbums(t *testing.T) {
+	resetTables(testScanner.db)
+	tx := testScanner.db.DB
+	artistIDs := findArtists(tx, []string{"A", "B"})
+	testScanner.db.SetMusicFolders([]*db.MusicFolder{{Name: "music", Location: "/music"}})
+	var musicFolder db.MusicFolder
+	tx.First(&musicFolder)
+	for _, album := range []*db.Album{
+		// two discs of an album, and an album by someone else with the
+		// same title. the parent folder has no tags
+		{ID: 10, RightPath: "album"},
+		{ID: 11, LeftPath: "album/", RightPath: "cd1", ParentID: 10, TagArtistID: artistIDs[0], TagTitle: "Album"},
+		{ID: 12, LeftPath: "album/", RightPath: "cd2", ParentID: 10, TagArtistID: artistIDs[0], TagTitle: "Album"},
+		{ID: 13, RightPath: "other", TagArtistID: artistIDs[1], TagTitle: "Album"},
+		// a reissue with another musicbrainz id, and untitled folders
+		{ID: 14, RightPath: "reissue", TagArtistID: artistIDs[0], TagTitle: "Album", TagBrainzID: "x"},
+		{ID: 15, RightPath: "misc1", TagArtistID: artistIDs[0]},
+		{ID: 16, RightPath: "misc2", TagArtistID: artistIDs[0]},
+		// different compilations with the same title in different folders,
+		// and copies of an album in the same folder
+		{ID: 17, RightPath: "comps1"},
+		{ID: 18, RightPath: "comps2"},
+		{ID: 19, LeftPath: "comps1/", RightPath: "hits", ParentID: 17, TagArtistID: artistIDs[1], TagTitle: "Hits"},
+		{ID: 20, LeftPath: "comps2/", RightPath: "hits", ParentID: 18, TagArtistID: artistIDs[1], TagTitle: "Hits"},
+		{ID: 21, RightPath: "copy flac", TagArtistID: artistIDs[1], TagTitle: "Copy"},
+		{ID: 22, RightPath: "copy mp3", TagArtistID: artistIDs[1], TagTitle: "Copy"},
+		// already grouped folders which weren't changed in the scan
+		{ID: 23, RightPath: "later1", TagArtistID: artistIDs[1], TagTitle: "Later", TagAlbumID: 23},
+		{ID: 24, RightPath: "later2", TagArtistID: artistIDs[1], TagTitle: "Later", TagAlbumID: 24},
+	} {
+		album.MusicFolderID = musicFolder.ID
+		tx.Create(album)
+	}
+	for _, folderID := range []int{21, 22} {
+		for i, title := range []string{"One", "Two"} {
+			tx.Create(&db.Track{
+				Filename:       strconv.Itoa(i),
+				AlbumID:        folderID,
+				ArtistID:       artistIDs[1],
+				TagTitle:       title,
+				TagTrackNumber: i + 1,
+				Size:           1,
+			})
+		}
+	}
+	tx.Exec("UPDATE albums SET tag_artist_id=NULL WHERE id IN (10, 17, 18)")
+	check := func(exp map[int]int) {
+		t.Helper()
+		var albums []*db.Album
+		tx.Order("id").Find(&albums)
+		for _, album := range albums {
+			if album.TagAlbumID != exp[album.ID] {
+				t.Errorf("expected folder %d to be part of album %d, got %d",
+					album.ID, exp[album.ID], album.TagAlbumID)
+			}
+		}
+	}
+	testScanner.reset()
+	testScanner.groupAlbums()
+	check(map[int]int{
+		10: 0, 11: 11, 12: 11, 13: 13, 14: 14, 15: 15, 16: 16,
+		17: 0, 18: 0, 19: 19, 20: 20, 21: 21, 22: 22, 23: 23, 24: 24,
+	})
+	// the second disc is gone, and a track of the unchanged album was saved
+	tx.Exec("DELETE FROM albums WHERE id=11")
+	testScanner.regroup[24] = struct{}{}
+	testScanner.groupAlbums()
+	check(map[int]int{
+		10: 0, 12: 12, 13: 13, 14: 14, 15: 15, 16: 16,
+		17: 0, 18: 0, 19: 19, 20: 20, 21: 21, 22: 22, 23: 23, 24: 23,
+	})
+}
+
+func TestSaveCompilation(t *testing.T) {
+	resetTables(testScanner.db)
+	tx := testScanner.db.DB
+	artistIDs := findArtists(tx, []string{"A", "B", "C"})
+	add := func(id int, artists ...string) *db.Album {
+		folder := &db.Album{ID: id, RightPath: strconv.Itoa(id), TagArtistID: artistIDs[0]}
+		tx.Create(folder)
+		for i, artist := range artists {
+			tx.Create(&db.Track{
+				Filename:       strconv.Itoa(i),
+				AlbumID:        id,
+				ArtistID:       artistIDs[0],
+				TagTrackArtist: artist,
+				Size:           1,
+			})
+		}
+		folder.ReceivedPaths = true
+		folder.ReceivedTags = true
+		return folder
+	}
+	// an album with a guest, a compilation, one flagged as a compilation,
+	// a compilation with an album artist tag, and a split release
+	guest := add(10, "A", "A feat. B", "a")
+	mixed := add(11, "A", "B", "C")
+	flagged := add(12, "A", "A")
+	flagged.TagCompilation = true
+	tagged := add(13, "A", "B", "C")
+	tagged.ReceivedAlbumArtist = true
+	split := add(14, "A", "A", "B", "B")
+	for _, folder := range []*db.Album{guest, mixed, flagged, tagged, split} {
+		testScanner.saveCompilation(folder)
+	}
+	various := findArtists(tx, []string{"Various Artists"})[0]
+	cases := []struct {
+		folder      *db.Album
+		compilation bool
+		artistID    int
+	}{
+		{guest, false, artistIDs[0]},
+		{mixed, true, various},
+		{flagged, true, various},
+		{tagged, true, artistIDs[0]},
+		{split, true, various},
+	}
+	for _, tc := range cases {
+		if tc.folder.TagCompilation != tc.compilation || tc.folder.TagArtistID != tc.artistID {
+			t.Errorf("folder %d: expected compilation %t by %d, got %t by %d", tc.folder.ID,
+				tc.compilation, tc.artistID, tc.folder.TagCompilation, tc.folder.TagArtistID)
+		}
+		var count int
+		tx.
+			Model(db.Track{}).
+			Where("album_id=? AND artist_id!=?", tc.folder.ID, tc.artistID).
+			Count(&count)
+		if count != 0 {
+			t.Errorf("folder %d: expected its tracks to be by %d", tc.folder.ID, tc.artistID)
+		}
+	}
+}
+
+func TestMoveTracks(t *testing.T) {
+	resetTables(testScanner.db)
+	tx := testScanner.db.DB
+	tx.Exec("DELETE FROM plays")
+	tx.Exec("DELETE FROM playlists")
+	artistIDs := findArtists(tx, []string{"A"})
+	var user db.User
+	tx.First(&user)
+	for _, id := range []int{10, 11} {
+		tx.Create(&db.Album{ID: id, RightPath: strconv.Itoa(id), TagArtistID: artistIDs[0]})
+	}
+	add := func(id, folderID int, track db.Track) *db.Track {
+		track.ID = id
+		track.AlbumID = folderID
+		track.ArtistID = artistIDs[0]
+		track.Filename = strconv.Itoa(id)
+		track.Size = 1
+		tx.Create(&track)
+		return &track
+	}
+	// the folder 10 was moved to 11. the tracks are found by musicbrainz
+	// id, content hash, and tags. the last two have the same tags, so
+	// they can't be told apart
+	gone := []*db.Track{
+		add(100, 10, db.Track{TagBrainzID: "x", TagTrackNumber: 1}),
+		add(101, 10, db.Track{ContentHash: "y"}),
+		add(102, 10, db.Track{TagTitle: "Z", Length: 60}),
+		add(103, 10, db.Track{TagTitle: "Dup", Length: 60}),
+		add(104, 10, db.Track{TagTitle: "Dup", Length: 60}),
+	}
+	s := New(testScanner.db, nil, Options{})
+	s.seenFolders[11] = struct{}{}
+	for _, track := range []*db.Track{
+		add(200, 11, db.Track{TagBrainzID: "x", TagTrackNumber: 1}),
+		add(201, 11, db.Track{ContentHash: "y"}),
+		add(202, 11, db.Track{TagTitle: "z", Length: 60}),
+		add(203, 11, db.Track{TagTitle: "Dup", Length: 60}),
+		add(204, 11, db.Track{TagTitle: "Dup", Length: 60}),
+	} {
+		s.noteNewTrack(track)
+	}
+	playlist := &db.Playlist{UserID: user.ID, Name: "p"}
+	playlist.SetItems([]int{100, 101, 102, 103, 1})
+	tx.Create(playlist)
+	tx.Create(&db.Play{UserID: user.ID, AlbumID: 10, Count: 3, Time: time.Now()})
+	if moved := s.moveTracks(gone); moved != 3 {
+		t.Errorf("expected 3 tracks to be found, got %d", moved)
+	}
+	tx.First(playlist, playlist.ID)
+	if act, exp := playlist.GetItems(), []int{200, 201, 202, 103, 1}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("expected playlist items %v, got %v", exp, act)
+	}
+	var play db.Play
+	tx.First(&play)
+	if play.AlbumID != 11 || play.Count != 3 {
+		t.Errorf("expected the plays to be moved to the new folder, got %+v", play)
+	}
+}
+
+func TestReadContentHash(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "gonic-hash")
+	if err != nil {
+		t.Fatalf("making temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+	data := []byte(strings.Repeat("audio", 5000))
+	if err := ioutil.WriteFile(filepath.Join(tmp, "a.m4a"), data, 0600); err != nil {
+		t.Fatalf("writing track: %v", err)
+	}
+	musicDir, _ := dir.NewLocalDir(tmp)
+	it := &item{relPath: "a.m4a", size: int64(len(data))}
+	exp := contentHash(it.size, data[len(data)-contentHashSize:])
+	// the tags didn't need the end of the file, so it's fetched
+	hash, fetched, err := readContentHash(musicDir, it, &tags.Tags{})
+	if err != nil || hash != exp || fetched != contentHashSize {
+		t.Errorf("expected hash %q with %d bytes fetched, got %q with %d, err %v",
+			exp, contentHashSize, hash, fetched, err)
+	}
+}
+
+func TestStartKeepsUnconfiguredFolders(t *testing.T) {
+	resetTables(testScanner.db)
+	tmp, err := ioutil.TempDir("", "gonic-unconfigured")
+	if err != nil {
+		t.Fatalf("making temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+	musicDir, _ := dir.NewLocalDir(tmp)
+	tx := testScanner.db.DB
+	err = testScanner.db.SetMusicFolders([]*db.MusicFolder{
+		{Name: "music", Location: tmp},
+		{Name: "old", Location: "/old"},
+	})
+	if err != nil {
+		t.Fatalf("setting music folders: %v", err)
+	}
+	artistIDs := findArtists(tx, []string{"A"})
+	var folders []*db.MusicFolder
+	tx.Order("id").Find(&folders)
+	for _, folder := range folders {
+		album := &db.Album{MusicFolderID: folder.ID, RightPath: "album"}
+		tx.Create(album)
+		tx.Create(&db.Track{Filename: "01.flac", AlbumID: album.ID, ArtistID: artistIDs[0], Size: 1})
+	}
+	// the old folder was removed from the config, so only the other is walked
+	s := New(testScanner.db, map[int]dir.Dir{folders[0].ID: musicDir}, Options{})
+	if err := s.Start(context.Background(), ScanOptions{}); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	var albums, tracks int
+	tx.Model(db.Album{}).Where("music_folder_id=?", folders[1].ID).Count(&albums)
+	tx.Model(db.Track{}).Count(&tracks)
+	if albums != 1 || tracks != 1 {
+		t.Errorf("expected the old folder's album and track to be kept, got %d and %d", albums, tracks)
+	}
+	var walked int
+	tx.Model(db.Album{}).Where("music_folder_id=? AND right_path=?", folders[0].ID, "album").Count(&walked)
+	if walked != 0 {
+		t.Errorf("expected the walked folder's missing album to be deleted")
+	}
+}
+
+func TestDropFolders(t *testing.T) {
+	resetTables(testScanner.db)
+	tx := testScanner.db.DB
+	s := New(testScanner.db, nil, Options{})
+	// an unchanged folder, with a changed one in it that the walk stopped in
+	unchanged := &db.Album{ID: 10, RightPath: "a"}
+	changed := &db.Album{ID: 11, LeftPath: "a/", RightPath: "b", ParentID: 10}
+	for _, folder := range []*db.Album{unchanged, changed} {
+		tx.Create(folder)
+		s.curFolders.Push(folder)
+	}
+	changed.ReceivedPaths = true
+	s.dropFolders()
+	if s.curFolders.Peek() != nil {
+		t.Errorf("expected the folders to be dropped")
+	}
+	var folders []*db.Album
+	tx.Order("id").Find(&folders)
+	if len(folders) != 2 || folders[0].UpdatedAt.IsZero() || !folders[1].UpdatedAt.IsZero() {
+		t.Errorf("expected only the changed folder to be read again by the next scan")
+	}
+}
@@ -0,0 +1,468 @@
+package tags
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Picture is an image embedded in the tags of a track, eg. an ID3v2 APIC
+// frame or a FLAC picture block
+type Picture struct {
+	MIME string
+	Data []byte
+}
+
+// pictureTypeFront is the type of the front cover. it's the one we want
+// if there's more than one picture
+const pictureTypeFront = 3
+
+var ErrNoPicture = errors.New("no embedded picture")
+
+// ReadPicture reads the embedded picture of a file of the given size. like
+// NewFromRanges, only the parts of the file which are needed are fetched
+func ReadPicture(filename string, size int64, read RangeFunc) (*Picture, error) {
+	if size <= 0 {
+		return nil, errors.New("empty file")
+	}
+	file := &sparseFile{
+		size: size,
+		read: read,
+	}
+	start, err := file.skipID3v2()
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching id3v2 tag")
+	}
+	if pic := id3v2Picture(file.bytes(0, start)); pic != nil {
+		return pic, nil
+	}
+	switch strings.ToLower(strings.TrimPrefix(path.Ext(filename), ".")) {
+	case "flac":
+		return file.flacPicture(start)
+	case "m4a", "m4b", "mp4":
+		if err := file.fetchMP4Moov(); err != nil {
+			return nil, errors.Wrap(err, "fetching moov atom")
+		}
+		if pic := mp4Picture(file.bytes(file.moov.start, file.moov.end)); pic != nil {
+			return pic, nil
+		}
+	case "ogg", "oga", "opus":
+		end, err := file.fetchOggHeaders(start)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetching ogg headers")
+		}
+		if pic := oggPicture(file.bytes(start, end)); pic != nil {
+			return pic, nil
+		}
+	}
+	return nil, ErrNoPicture
+}
+
+// pictureChooser keeps the front cover, or the first picture if there
+// isn't one
+type pictureChooser struct {
+	pic   *Picture
+	front bool
+}
+
+func (c *pictureChooser) add(pic *Picture, picType int) {
+	if pic == nil || len(pic.Data) == 0 || c.front {
+		return
+	}
+	if c.pic == nil || picType == pictureTypeFront {
+		c.pic = pic
+		c.front = picType == pictureTypeFront
+	}
+}
+
+// ** begin id3v2
+
+// id3v2Picture finds the APIC frame, or PIC in ID3v2.2, in tag. tag is the
+// whole tag with its header, or nothing if the file doesn't have one
+func id3v2Picture(tag []byte) *Picture {
+	if len(tag) < 10 || !bytes.HasPrefix(tag, []byte("ID3")) {
+		return nil
+	}
+	version, flags := tag[3], tag[5]
+	body := tag[10:]
+	if version < 4 && flags&0x80 != 0 {
+		// the whole tag is unsynchronised
+		body = unsynchronise(body)
+	}
+	if version >= 3 && flags&0x40 != 0 && len(body) >= 4 {
+		// skip the extended header. it's size includes itself in 2.4
+		extSize := int(binary.BigEndian.Uint32(body[:4]))
+		if version == 4 {
+			extSize = syncsafe(body[:4])
+		} else {
+			extSize += 4
+		}
+		if extSize > len(body) {
+			return nil
+		}
+		body = body[extSize:]
+	}
+	idSize, headerSize := 4, 10
+	if version == 2 {
+		idSize, headerSize = 3, 6
+	}
+	chooser := &pictureChooser{}
+	for len(body) >= headerSize && body[0] != 0 {
+		id := string(body[:idSize])
+		var frameSize int
+		var formatFlags byte
+		switch version {
+		case 2:
+			frameSize = int(body[3])<<16 | int(body[4])<<8 | int(body[5])
+		case 3:
+			frameSize = int(binary.BigEndian.Uint32(body[4:8]))
+			formatFlags = body[9]
+		default:
+			frameSize = syncsafe(body[4:8])
+			formatFlags = body[9]
+		}
+		if frameSize < 0 || headerSize+frameSize > len(body) {
+			break
+		}
+		frame := body[headerSize : headerSize+frameSize]
+		body = body[headerSize+frameSize:]
+		if id != "APIC" && id != "PIC" {
+			continue
+		}
+		switch version {
+		case 3:
+			// compressed or encrypted frames are left out
+			if formatFlags&0xc0 != 0 {
+				continue
+			}
+			if formatFlags&0x20 != 0 && len(frame) > 0 {
+				// the group id
+				frame = frame[1:]
+			}
+		case 4:
+			if formatFlags&0x0c != 0 {
+				continue
+			}
+			if formatFlags&0x40 != 0 && len(frame) > 0 {
+				frame = frame[1:]
+			}
+			if formatFlags&0x01 != 0 && len(frame) >= 4 {
+				// the data length indicator
+				frame = frame[4:]
+			}
+			if formatFlags&0x02 != 0 {
+				frame = unsynchronise(frame)
+			}
+		}
+		chooser.add(parseAPIC(frame, version == 2))
+	}
+	return chooser.pic
+}
+
+// parseAPIC parses the body of an APIC frame, or a PIC frame if isPIC,
+// which has a three letter format instead of a mime type
+func parseAPIC(frame []byte, isPIC bool) (*Picture, int) {
+	if len(frame) < 2 {
+		return nil, 0
+	}
+	encoding := frame[0]
+	frame = frame[1:]
+	var mime string
+	if isPIC {
+		if len(frame) < 3 {
+			return nil, 0
+		}
+		switch strings.ToUpper(string(frame[:3])) {
+		case "PNG":
+			mime = "image/png"
+		default:
+			mime = "image/jpeg"
+		}
+		frame = frame[3:]
+	} else {
+		end := bytes.IndexByte(frame, 0)
+		if end == -1 {
+			return nil, 0
+		}
+		mime = string(frame[:end])
+		frame = frame[end+1:]
+	}
+	if len(frame) < 1 {
+		return nil, 0
+	}
+	picType := int(frame[0])
+	frame = frame[1:]
+	// then the description, which ends with a nul in the frame's encoding
+	if encoding == 1 || encoding == 2 {
+		for i := 0; ; i += 2 {
+			if i+1 >= len(frame) {
+				return nil, 0
+			}
+			if frame[i] == 0 && frame[i+1] == 0 {
+				frame = frame[i+2:]
+				break
+			}
+		}
+	} else {
+		end := bytes.IndexByte(frame, 0)
+		if end == -1 {
+			return nil, 0
+		}
+		frame = frame[end+1:]
+	}
+	return &Picture{MIME: pictureMIME(mime, frame), Data: frame}, picType
+}
+
+// unsynchronise undoes ID3v2 unsynchronisation, where a zero is put after
+// every 0xff
+func unsynchronise(data []byte) []byte {
+	return bytes.Replace(data, []byte{0xff, 0x00}, []byte{0xff}, -1)
+}
+
+func syncsafe(b []byte) int {
+	return int(b[0])<<21 | int(b[1])<<14 | int(b[2])<<7 | int(b[3])
+}
+
+// ** begin flac
+
+// flacPicture fetches and parses the picture blocks of a FLAC stream
+// starting at offset
+func (f *sparseFile) flacPicture(offset int64) (*Picture, error) {
+	magic, err := f.readAt(offset, 4)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(magic, []byte("fLaC")) {
+		return nil, errors.New("no flac stream marker")
+	}
+	offset += 4
+	chooser := &pictureChooser{}
+	for offset < f.size {
+		header, err := f.readAt(offset, flacBlockHeaderSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(header) < flacBlockHeaderSize {
+			return nil, errors.New("short flac block header")
+		}
+		isLast := header[0]&0x80 != 0
+		blockType := header[0] & 0x7f
+		blockSize := int64(header[1])<<16 | int64(header[2])<<8 | int64(header[3])
+		offset += flacBlockHeaderSize
+		if blockType == flacBlockTypePicture {
+			block, err := f.readAt(offset, blockSize)
+			if err != nil {
+				return nil, err
+			}
+			chooser.add(parseFLACPicture(block))
+		}
+		offset += blockSize
+		if isLast {
+			break
+		}
+	}
+	if chooser.pic == nil {
+		return nil, ErrNoPicture
+	}
+	return chooser.pic, nil
+}
+
+// parseFLACPicture parses a FLAC picture block. the same structure is
+// base64 encoded in the METADATA_BLOCK_PICTURE comments of Ogg files
+func parseFLACPicture(block []byte) (*Picture, int) {
+	next := func(n int) []byte {
+		if n < 0 || n > len(block) {
+			block = nil
+			return nil
+		}
+		ret := block[:n]
+		block = block[n:]
+		return ret
+	}
+	nextInt := func() int {
+		b := next(4)
+		if b == nil {
+			return -1
+		}
+		return int(binary.BigEndian.Uint32(b))
+	}
+	picType := nextInt()
+	mime := string(next(nextInt()))
+	next(nextInt()) // description
+	next(16)        // width, height, depth, and colours
+	data := next(nextInt())
+	if data == nil {
+		return nil, 0
+	}
+	return &Picture{MIME: pictureMIME(mime, data), Data: data}, picType
+}
+
+// ** begin mp4
+
+// mp4Picture finds the covr atom in moov
+func mp4Picture(moov []byte) *Picture {
+	covr := mp4Atom(moov, "moov", "udta", "meta", "ilst", "covr")
+	if covr == nil {
+		return nil
+	}
+	chooser := &pictureChooser{}
+	for len(covr) >= 16 {
+		size := int(binary.BigEndian.Uint32(covr[:4]))
+		if size < 16 || size > len(covr) {
+			break
+		}
+		if string(covr[4:8]) == "data" {
+			// a version and type, which is 13 for jpeg and 14 for png,
+			// then a locale
+			var mime string
+			if covr[11] == 14 {
+				mime = "image/png"
+			}
+			data := covr[16:size]
+			chooser.add(&Picture{MIME: pictureMIME(mime, data), Data: data}, 0)
+		}
+		covr = covr[size:]
+	}
+	return chooser.pic
+}
+
+// mp4Atom returns the body of the atom at the path in data
+func mp4Atom(data []byte, atomPath ...string) []byte {
+	for _, want := range atomPath {
+		var found []byte
+		for len(data) >= 8 {
+			size := int(binary.BigEndian.Uint32(data[:4]))
+			if size < 8 || size > len(data) {
+				return nil
+			}
+			if string(data[4:8]) == want {
+				found = data[8:size]
+				break
+			}
+			data = data[size:]
+		}
+		if found == nil {
+			return nil
+		}
+		if want == "meta" {
+			// it has a version and flags before its children
+			if len(found) < 4 {
+				return nil
+			}
+			found = found[4:]
+		}
+		data = found
+	}
+	return data
+}
+
+// ** begin ogg
+
+// oggPicture finds METADATA_BLOCK_PICTURE in the comment header of the
+// Ogg pages in data. the comments are the second packet, for both Vorbis
+// and Opus
+func oggPicture(data []byte) *Picture {
+	packets := oggPackets(data, 2)
+	if len(packets) < 2 {
+		return nil
+	}
+	comments := packets[1]
+	switch {
+	case bytes.HasPrefix(comments, []byte("\x03vorbis")):
+		comments = comments[7:]
+	case bytes.HasPrefix(comments, []byte("OpusTags")):
+		comments = comments[8:]
+	default:
+		return nil
+	}
+	next := func() []byte {
+		if len(comments) < 4 {
+			return nil
+		}
+		n := int(binary.LittleEndian.Uint32(comments[:4]))
+		if n < 0 || 4+n > len(comments) {
+			comments = nil
+			return nil
+		}
+		ret := comments[4 : 4+n]
+		comments = comments[4+n:]
+		return ret
+	}
+	next() // vendor
+	if len(comments) < 4 {
+		return nil
+	}
+	count := int(binary.LittleEndian.Uint32(comments[:4]))
+	comments = comments[4:]
+	const key = "metadata_block_picture="
+	chooser := &pictureChooser{}
+	for i := 0; i < count; i++ {
+		comment := next()
+		if comment == nil {
+			break
+		}
+		if len(comment) < len(key) || !strings.EqualFold(string(comment[:len(key)]), key) {
+			continue
+		}
+		block, err := base64.StdEncoding.DecodeString(string(comment[len(key):]))
+		if err != nil {
+			continue
+		}
+		chooser.add(parseFLACPicture(block))
+	}
+	return chooser.pic
+}
+
+// oggPackets joins the segments of the pages in data into the first n
+// packets
+func oggPackets(data []byte, n int) [][]byte {
+	const pageHeaderSize = 27
+	var packets [][]byte
+	var packet []byte
+	for len(data) >= pageHeaderSize && bytes.HasPrefix(data, []byte("OggS")) {
+		numSegments := int(data[26])
+		if pageHeaderSize+numSegments > len(data) {
+			break
+		}
+		segments := data[pageHeaderSize : pageHeaderSize+numSegments]
+		body := data[pageHeaderSize+numSegments:]
+		for _, segment := range segments {
+			if int(segment) > len(body) {
+				return packets
+			}
+			packet = append(packet, body[:segment]...)
+			body = body[segment:]
+			if segment < 255 {
+				packets = append(packets, packet)
+				packet = nil
+				if len(packets) == n {
+					return packets
+				}
+			}
+		}
+		data = body
+	}
+	return packets
+}
+
+// pictureMIME returns mime, or what data looks like if the tag didn't say
+func pictureMIME(mime string, data []byte) string {
+	mime = strings.ToLower(strings.TrimSpace(mime))
+	switch mime {
+	case "image/jpeg", "image/png", "image/gif", "image/bmp", "image/webp":
+		return mime
+	case "image/jpg":
+		return "image/jpeg"
+	}
+	switch {
+	case bytes.HasPrefix(data, []byte("\x89PNG")):
+		return "image/png"
+	case bytes.HasPrefix(data, []byte("GIF8")):
+		return "image/gif"
+	}
+	return "image/jpeg"
+}
@@ -0,0 +1,193 @@
+package tags
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+)
+
+var (
+	testJPEG = append([]byte{0xff, 0xd8, 0xff, 0xe0}, bytes.Repeat([]byte{0xff, 0x00, 7}, 100)...)
+	testPNG  = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{8}, 100)...)
+)
+
+func readTestPicture(t *testing.T, filename string, data []byte) *Picture {
+	t.Helper()
+	counter := &rangeCounter{data: data}
+	pic, err := ReadPicture(filename, int64(len(data)), counter.read)
+	if err != nil {
+		t.Fatalf("reading picture: %v", err)
+	}
+	return pic
+}
+
+func expectPicture(t *testing.T, pic *Picture, mime string, data []byte) {
+	t.Helper()
+	if pic.MIME != mime {
+		t.Errorf("expected mime %q, got %q", mime, pic.MIME)
+	}
+	if !bytes.Equal(pic.Data, data) {
+		t.Errorf("expected the picture's data, got %d bytes", len(pic.Data))
+	}
+}
+
+func id3v2Tag(version byte, frames ...[]byte) []byte {
+	body := bytes.Join(frames, nil)
+	body = append(body, make([]byte, 64)...) // padding
+	size := len(body)
+	tag := []byte{'I', 'D', '3', version, 0, 0,
+		byte(size >> 21 & 0x7f), byte(size >> 14 & 0x7f),
+		byte(size >> 7 & 0x7f), byte(size & 0x7f)}
+	return append(tag, body...)
+}
+
+func id3v2Frame(version byte, id string, body []byte) []byte {
+	size := len(body)
+	frame := []byte(id)
+	if version == 4 {
+		frame = append(frame, byte(size>>21&0x7f), byte(size>>14&0x7f), byte(size>>7&0x7f), byte(size&0x7f))
+	} else {
+		frame = append(frame, byte(size>>24), byte(size>>16), byte(size>>8), byte(size))
+	}
+	return append(append(frame, 0, 0), body...)
+}
+
+func apic(encoding byte, mime string, picType byte, desc []byte, data []byte) []byte {
+	body := append([]byte{encoding}, mime...)
+	body = append(body, 0, picType)
+	body = append(body, desc...)
+	return append(body, data...)
+}
+
+func TestReadPictureID3v2(t *testing.T) {
+	for _, version := range []byte{3, 4} {
+		tag := id3v2Tag(version,
+			id3v2Frame(version, "TIT2", []byte("\x00title")),
+			// a back cover, then the front one with a utf-16 description
+			id3v2Frame(version, "APIC", apic(0, "image/png", 4, []byte("back\x00"), testPNG)),
+			id3v2Frame(version, "APIC", apic(1, "image/jpeg", pictureTypeFront, []byte{0xff, 0xfe, 'f', 0, 0, 0}, testJPEG)),
+		)
+		data := append(tag, filler(100*1024)...)
+		expectPicture(t, readTestPicture(t, "track.mp3", data), "image/jpeg", testJPEG)
+		file, _ := fetchRegions(t, "track.mp3", data)
+		if !file.picture {
+			t.Errorf("expected the picture to be noticed in an id3v2.%d tag", version)
+		}
+	}
+}
+
+func TestReadPictureFLAC(t *testing.T) {
+	block := func(blockType byte, last bool, body []byte) []byte {
+		if last {
+			blockType |= 0x80
+		}
+		size := len(body)
+		return append([]byte{blockType, byte(size >> 16), byte(size >> 8), byte(size)}, body...)
+	}
+	var data []byte
+	data = append(data, "fLaC"...)
+	data = append(data, block(0, false, bytes.Repeat([]byte{1}, 34))...)
+	data = append(data, block(6, true, flacPictureBlock(pictureTypeFront, "", testPNG))...)
+	data = append(data, filler(1024*1024)...)
+	pic := readTestPicture(t, "track.flac", data)
+	expectPicture(t, pic, "image/png", testPNG)
+}
+
+func flacPictureBlock(picType uint32, mime string, data []byte) []byte {
+	var buf bytes.Buffer
+	writeInt := func(n int) { _ = binary.Write(&buf, binary.BigEndian, uint32(n)) }
+	writeInt(int(picType))
+	writeInt(len(mime))
+	buf.WriteString(mime)
+	writeInt(4)
+	buf.WriteString("desc")
+	buf.Write(make([]byte, 16))
+	writeInt(len(data))
+	buf.Write(data)
+	return buf.Bytes()
+}
+
+func TestReadPictureMP4(t *testing.T) {
+	atom := func(atomType string, body ...[]byte) []byte {
+		joined := bytes.Join(body, nil)
+		header := make([]byte, 8)
+		binary.BigEndian.PutUint32(header, uint32(8+len(joined)))
+		copy(header[4:], atomType)
+		return append(header, joined...)
+	}
+	dataAtom := atom("data", []byte{0, 0, 0, 14, 0, 0, 0, 0}, testPNG)
+	moov := atom("moov",
+		atom("mvhd", make([]byte, 100)),
+		atom("udta",
+			atom("meta", []byte{0, 0, 0, 0},
+				atom("hdlr", make([]byte, 25)),
+				atom("ilst",
+					atom("\xa9nam", atom("data", []byte{0, 0, 0, 1, 0, 0, 0, 0}, []byte("title"))),
+					atom("covr", dataAtom)))))
+	var data []byte
+	data = append(data, atom("ftyp", []byte("M4A "))...)
+	data = append(data, atom("mdat", filler(1024*1024))...)
+	data = append(data, moov...)
+	expectPicture(t, readTestPicture(t, "track.m4a", data), "image/png", testPNG)
+	file, _ := fetchRegions(t, "track.m4a", data)
+	if !file.picture {
+		t.Errorf("expected the picture to be noticed in the moov atom")
+	}
+}
+
+func TestReadPictureOgg(t *testing.T) {
+	// a comment packet big enough to span pages
+	var comments bytes.Buffer
+	writeInt := func(n int) { _ = binary.Write(&comments, binary.LittleEndian, uint32(n)) }
+	comments.WriteString("\x03vorbis")
+	writeInt(6)
+	comments.WriteString("vendor")
+	fields := []string{
+		"TITLE=title",
+		"metadata_block_picture=" + base64.StdEncoding.EncodeToString(
+			flacPictureBlock(pictureTypeFront, "image/jpeg", bytes.Repeat(testJPEG, 100))),
+	}
+	writeInt(len(fields))
+	for _, field := range fields {
+		writeInt(len(field))
+		comments.WriteString(field)
+	}
+	packet := comments.Bytes()
+	page := func(granule uint64, segments []byte, body []byte) []byte {
+		header := make([]byte, 27)
+		copy(header, "OggS")
+		binary.LittleEndian.PutUint64(header[6:14], granule)
+		header[26] = byte(len(segments))
+		return append(append(header, segments...), body...)
+	}
+	lacing := func(n int) []byte {
+		var segments []byte
+		for ; n >= 255; n -= 255 {
+			segments = append(segments, 255)
+		}
+		return append(segments, byte(n))
+	}
+	var data []byte
+	data = append(data, page(0, lacing(30), bytes.Repeat([]byte{1}, 30))...)
+	// the comments, split in two after 100 segments
+	split := 100 * 255
+	data = append(data, page(^uint64(0), bytes.Repeat([]byte{255}, 100), packet[:split])...)
+	data = append(data, page(0, lacing(len(packet)-split), packet[split:])...)
+	for i := 1; i < 100; i++ {
+		data = append(data, page(uint64(i*4096), lacing(4000), filler(4000))...)
+	}
+	expectPicture(t, readTestPicture(t, "track.ogg", data), "image/jpeg", bytes.Repeat(testJPEG, 100))
+	file, _ := fetchRegions(t, "track.ogg", data)
+	if !file.picture {
+		t.Errorf("expected the picture to be noticed in the comments")
+	}
+}
+
+func TestReadPictureNone(t *testing.T) {
+	data := append(id3v2Tag(3, id3v2Frame(3, "TIT2", []byte("\x00title"))), filler(1024)...)
+	counter := &rangeCounter{data: data}
+	if _, err := ReadPicture("track.mp3", int64(len(data)), counter.read); err != ErrNoPicture {
+		t.Errorf("expected no picture, got %v", err)
+	}
+}
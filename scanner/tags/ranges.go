@@ -0,0 +1,455 @@
+package tags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/02strich/audiotags"
+	"github.com/pkg/errors"
+)
+
+const (
+	// minFetch is the smallest range we ask for. parsing headers makes lots
+	// of small reads close together, which would be a request each otherwise
+	minFetch = 64 * 1024
+	// mpegFrameWindow is read after the ID3v2 tag, so that the first frame
+	// and its Xing or VBRI header are there for the audio properties
+	mpegFrameWindow = 8 * 1024
+	// tailSize is read from the end of MP3s and FLACs. it holds the ID3v1
+	// or APE tags, and the last few frames
+	tailSize = 8 * 1024
+	// TailSize is how much of the end of the file Tags.Tail has
+	TailSize = tailSize
+	// oggTailSize is the largest an Ogg page can be. the last page has the
+	// granule position the length is worked out from
+	oggTailSize = 65307
+)
+
+const (
+	flacBlockTypePicture = 6
+	flacBlockHeaderSize  = 4
+)
+
+// RangeFunc reads up to length bytes of a file, starting at offset
+type RangeFunc func(offset, length int64) ([]byte, error)
+
+// NewFromRanges reads the tags of a file of the given size, only fetching
+// the parts of it that have tags or audio properties in them. those are
+// written to a sparse temporary file for taglib to read, with the rest of
+// the file left as a hole, so that neither the file nor a copy of it needs
+// to be in memory. it also returns the number of bytes that were fetched
+func NewFromRanges(filename string, size int64, read RangeFunc) (*Tags, int64, error) {
+	if size <= 0 {
+		return nil, 0, errors.New("empty file")
+	}
+	file := &sparseFile{
+		size: size,
+		read: read,
+	}
+	if err := file.fetchRegions(filename); err != nil {
+		return nil, file.fetched, errors.Wrap(err, "fetching tag regions")
+	}
+	tmpPath, err := file.writeTemp(path.Ext(filename))
+	if err != nil {
+		return nil, file.fetched, errors.Wrap(err, "writing tag regions")
+	}
+	defer os.Remove(tmpPath)
+	raw, props, err := audiotags.Read(tmpPath)
+	if err != nil {
+		return nil, file.fetched, errors.Wrap(err, "audiotags module")
+	}
+	return &Tags{
+		raw:     raw,
+		props:   props,
+		picture: file.picture,
+		tail:    file.tail(),
+	}, file.fetched, nil
+}
+
+// span is a range of the file, from start up to end
+type span struct {
+	start, end int64
+}
+
+// chunk is a part of the file that we have, starting at start
+type chunk struct {
+	start int64
+	data  []byte
+}
+
+func (c chunk) end() int64 {
+	return c.start + int64(len(c.data))
+}
+
+// sparseFile is a file which has only the parts we asked for fetched. only
+// those are kept, so it takes up about as much memory as was fetched
+type sparseFile struct {
+	size    int64
+	read    RangeFunc
+	chunks  []chunk // sorted and merged
+	fetched int64
+	// moov is where the moov atom of an MP4 is. picture is whether we
+	// saw an embedded picture while fetching the regions
+	moov    span
+	picture bool
+}
+
+// readAt returns length bytes at offset, fetching what we don't already
+// have. the returned slice is shorter at the end of the file
+func (f *sparseFile) readAt(offset, length int64) ([]byte, error) {
+	if offset < 0 {
+		offset = 0
+	}
+	end := offset + length
+	if end > f.size {
+		end = f.size
+	}
+	if offset >= end {
+		return nil, nil
+	}
+	for _, gap := range f.gaps(offset, end) {
+		if err := f.fetch(gap); err != nil {
+			return nil, err
+		}
+	}
+	return f.bytes(offset, end), nil
+}
+
+// bytes returns what we have from start to end. the parts we don't have
+// are zeros, like they are in the file taglib reads
+func (f *sparseFile) bytes(start, end int64) []byte {
+	for _, c := range f.chunks {
+		if c.start <= start && end <= c.end() {
+			return c.data[start-c.start : end-c.start]
+		}
+	}
+	ret := make([]byte, end-start)
+	for _, c := range f.chunks {
+		if c.end() <= start || c.start >= end {
+			continue
+		}
+		from, to := c.start, c.end()
+		if from < start {
+			from = start
+		}
+		if to > end {
+			to = end
+		}
+		copy(ret[from-start:to-start], c.data[from-c.start:to-c.start])
+	}
+	return ret
+}
+
+// gaps returns the parts of start to end that we don't have yet
+func (f *sparseFile) gaps(start, end int64) []span {
+	var gaps []span
+	for _, have := range f.chunks {
+		if have.end() <= start {
+			continue
+		}
+		if have.start >= end {
+			break
+		}
+		if have.start > start {
+			gaps = append(gaps, span{start, have.start})
+		}
+		start = have.end()
+	}
+	if start < end {
+		gaps = append(gaps, span{start, end})
+	}
+	return gaps
+}
+
+func (f *sparseFile) fetch(gap span) error {
+	// read a bit more than we need if we can, up to the next part we have
+	limit := f.size
+	for _, have := range f.chunks {
+		if have.start >= gap.end {
+			limit = have.start
+			break
+		}
+	}
+	if gap.end-gap.start < minFetch {
+		gap.end = gap.start + minFetch
+	}
+	if gap.end > limit {
+		gap.end = limit
+	}
+	data, err := f.read(gap.start, gap.end-gap.start)
+	if err != nil {
+		return err
+	}
+	f.fetched += int64(len(data))
+	if int64(len(data)) > gap.end-gap.start {
+		data = data[:gap.end-gap.start]
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	f.add(chunk{gap.start, data})
+	return nil
+}
+
+// add keeps a chunk, merging it with the ones next to it. merged chunks
+// are copied, so the data from read is never written to
+func (f *sparseFile) add(c chunk) {
+	f.chunks = append(f.chunks, c)
+	sort.Slice(f.chunks, func(i, j int) bool {
+		return f.chunks[i].start < f.chunks[j].start
+	})
+	merged := f.chunks[:1]
+	for _, have := range f.chunks[1:] {
+		last := &merged[len(merged)-1]
+		if have.start > last.end() {
+			merged = append(merged, have)
+			continue
+		}
+		if have.end() > last.end() {
+			data := make([]byte, have.end()-last.start)
+			copy(data, last.data)
+			copy(data[have.start-last.start:], have.data)
+			last.data = data
+		}
+	}
+	f.chunks = merged
+}
+
+// writeTemp writes the file to a temporary one with the given extension,
+// which taglib uses to tell its format, and returns its path. the parts we
+// don't have are left as holes, which don't take up any disk space on
+// most filesystems
+func (f *sparseFile) writeTemp(ext string) (string, error) {
+	tmp, err := ioutil.TempFile("", "gonic-tags-*"+ext)
+	if err != nil {
+		return "", errors.Wrap(err, "creating temp file")
+	}
+	defer tmp.Close()
+	for _, c := range f.chunks {
+		if _, err := tmp.WriteAt(c.data, c.start); err != nil {
+			os.Remove(tmp.Name())
+			return "", errors.Wrap(err, "writing temp file")
+		}
+	}
+	if err := tmp.Truncate(f.size); err != nil {
+		os.Remove(tmp.Name())
+		return "", errors.Wrap(err, "truncating temp file")
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", errors.Wrap(err, "closing temp file")
+	}
+	return tmp.Name(), nil
+}
+
+// tail is a copy of the last TailSize bytes of the file, if they were
+// fetched for the tags. it's nil otherwise
+func (f *sparseFile) tail() []byte {
+	start := f.size - TailSize
+	if start < 0 {
+		start = 0
+	}
+	if len(f.gaps(start, f.size)) > 0 {
+		return nil
+	}
+	return append([]byte(nil), f.bytes(start, f.size)...)
+}
+
+func (f *sparseFile) readTail(length int64) error {
+	_, err := f.readAt(f.size-length, length)
+	return err
+}
+
+// fetchRegions fetches what taglib will need to read the tags and audio
+// properties of the file, depending on it's format. formats we don't know
+// about are fetched whole
+func (f *sparseFile) fetchRegions(filename string) error {
+	start, err := f.skipID3v2()
+	if err != nil {
+		return err
+	}
+	f.picture = id3v2Picture(f.bytes(0, start)) != nil
+	switch strings.ToLower(strings.TrimPrefix(path.Ext(filename), ".")) {
+	case "mp3":
+		if _, err := f.readAt(start, mpegFrameWindow); err != nil {
+			return err
+		}
+		return f.readTail(tailSize)
+	case "flac":
+		if err := f.fetchFLACBlocks(start); err != nil {
+			return err
+		}
+		return f.readTail(tailSize)
+	case "m4a", "m4b", "mp4":
+		if err := f.fetchMP4Moov(); err != nil {
+			return err
+		}
+		f.picture = f.picture || mp4Picture(f.bytes(f.moov.start, f.moov.end)) != nil
+		return nil
+	case "ogg", "oga", "opus":
+		end, err := f.fetchOggHeaders(start)
+		if err != nil {
+			return err
+		}
+		f.picture = f.picture || oggPicture(f.bytes(start, end)) != nil
+		return f.readTail(oggTailSize)
+	default:
+		_, err := f.readAt(0, f.size)
+		return err
+	}
+}
+
+// skipID3v2 fetches the ID3v2 tag at the start of the file if there is one,
+// and returns the offset of what comes after it
+func (f *sparseFile) skipID3v2() (int64, error) {
+	header, err := f.readAt(0, 10)
+	if err != nil {
+		return 0, err
+	}
+	if len(header) < 10 || !bytes.HasPrefix(header, []byte("ID3")) {
+		return 0, nil
+	}
+	// the size is 4 syncsafe bytes, which have their high bit unset
+	tagSize := int64(header[6])<<21 |
+		int64(header[7])<<14 |
+		int64(header[8])<<7 |
+		int64(header[9])
+	tagSize += 10
+	if header[5]&0x10 != 0 {
+		// there's a footer too
+		tagSize += 10
+	}
+	if _, err := f.readAt(0, tagSize); err != nil {
+		return 0, err
+	}
+	return tagSize, nil
+}
+
+// fetchFLACBlocks fetches the metadata blocks that come before the frames.
+// pictures are left out, we only note that they're there
+func (f *sparseFile) fetchFLACBlocks(offset int64) error {
+	magic, err := f.readAt(offset, 4)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(magic, []byte("fLaC")) {
+		return errors.New("no flac stream marker")
+	}
+	offset += 4
+	for offset < f.size {
+		header, err := f.readAt(offset, flacBlockHeaderSize)
+		if err != nil {
+			return err
+		}
+		if len(header) < flacBlockHeaderSize {
+			return errors.New("short flac block header")
+		}
+		isLast := header[0]&0x80 != 0
+		blockType := header[0] & 0x7f
+		blockSize := int64(header[1])<<16 | int64(header[2])<<8 | int64(header[3])
+		offset += flacBlockHeaderSize
+		if blockType == flacBlockTypePicture {
+			f.picture = true
+		} else {
+			if _, err := f.readAt(offset, blockSize); err != nil {
+				return err
+			}
+		}
+		offset += blockSize
+		if isLast {
+			break
+		}
+	}
+	// and the first frame header, which taglib looks for
+	_, err = f.readAt(offset, 16)
+	return err
+}
+
+// fetchMP4Moov fetches the header of every top level atom, and all of the
+// moov atom, which has the tags and audio properties. the mdat atom with the
+// audio data in it is the one we want to skip
+func (f *sparseFile) fetchMP4Moov() error {
+	var offset int64
+	for offset+8 <= f.size {
+		header, err := f.readAt(offset, 16)
+		if err != nil {
+			return err
+		}
+		if len(header) < 8 {
+			break
+		}
+		atomSize := int64(binary.BigEndian.Uint32(header[:4]))
+		atomType := string(header[4:8])
+		switch atomSize {
+		case 0:
+			// the atom goes on to the end of the file
+			atomSize = f.size - offset
+		case 1:
+			// the size is a 64 bit one after the type
+			if len(header) < 16 {
+				return errors.New("short mp4 atom header")
+			}
+			atomSize = int64(binary.BigEndian.Uint64(header[8:16]))
+		}
+		if atomSize < 8 {
+			return errors.Errorf("invalid size for mp4 atom %q", atomType)
+		}
+		if atomType == "moov" {
+			moov, err := f.readAt(offset, atomSize)
+			if err != nil {
+				return err
+			}
+			f.moov = span{offset, offset + int64(len(moov))}
+		}
+		offset += atomSize
+	}
+	return nil
+}
+
+// fetchOggHeaders fetches the pages at the start of the stream which have
+// the codec's header packets. they're the pages with a zero granule position
+// which come before the first audio page. it returns where they end
+func (f *sparseFile) fetchOggHeaders(offset int64) (int64, error) {
+	const (
+		pageHeaderSize = 27
+		noGranule      = ^uint64(0)
+	)
+	for offset < f.size {
+		header, err := f.readAt(offset, pageHeaderSize)
+		if err != nil {
+			return 0, err
+		}
+		if len(header) < pageHeaderSize || !bytes.HasPrefix(header, []byte("OggS")) {
+			return 0, errors.New("invalid ogg page")
+		}
+		granule := binary.LittleEndian.Uint64(header[6:14])
+		numSegments := int64(header[26])
+		segments, err := f.readAt(offset+pageHeaderSize, numSegments)
+		if err != nil {
+			return 0, err
+		}
+		pageSize := pageHeaderSize + numSegments
+		for _, segment := range segments {
+			pageSize += int64(segment)
+		}
+		if _, err := f.readAt(offset, pageSize); err != nil {
+			return 0, err
+		}
+		offset += pageSize
+		// a granule position of -1 means no packet ended on this page,
+		// which happens when a big comment header spans a few pages
+		if granule != 0 && granule != noGranule {
+			// the first audio page. taglib wants to see it too
+			break
+		}
+	}
+	if offset > f.size {
+		offset = f.size
+	}
+	return offset, nil
+}
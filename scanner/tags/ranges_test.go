@@ -0,0 +1,277 @@
+package tags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// rangeCounter serves ranges of data, and remembers how much was asked for
+type rangeCounter struct {
+	data    []byte
+	fetched int64
+}
+
+func (r *rangeCounter) read(offset, length int64) ([]byte, error) {
+	end := offset + length
+	if end > int64(len(r.data)) {
+		end = int64(len(r.data))
+	}
+	r.fetched += end - offset
+	return r.data[offset:end], nil
+}
+
+func fetchRegions(t *testing.T, filename string, data []byte) (*sparseFile, *rangeCounter) {
+	t.Helper()
+	counter := &rangeCounter{data: data}
+	file := &sparseFile{
+		size: int64(len(data)),
+		read: counter.read,
+	}
+	if err := file.fetchRegions(filename); err != nil {
+		t.Fatalf("fetching regions: %v", err)
+	}
+	if file.fetched != counter.fetched {
+		t.Errorf("file counted %d bytes fetched, but %d were", file.fetched, counter.fetched)
+	}
+	return file, counter
+}
+
+// filler is audio data we don't want to be fetched
+func filler(n int) []byte {
+	return bytes.Repeat([]byte{0xaa}, n)
+}
+
+func TestSparseFileReadAt(t *testing.T) {
+	data := make([]byte, 300*1024)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	counter := &rangeCounter{data: data}
+	file := &sparseFile{
+		size: int64(len(data)),
+		read: counter.read,
+	}
+	// small reads are rounded up
+	if _, err := file.readAt(10, 10); err != nil {
+		t.Fatalf("reading: %v", err)
+	}
+	if counter.fetched != minFetch {
+		t.Errorf("expected %d bytes fetched, got %d", minFetch, counter.fetched)
+	}
+	// reading what we have doesn't fetch anything
+	if _, err := file.readAt(100, 1000); err != nil {
+		t.Fatalf("reading: %v", err)
+	}
+	if counter.fetched != minFetch {
+		t.Errorf("expected no more fetched, got %d", counter.fetched-minFetch)
+	}
+	// reads past the end are cut short
+	got, err := file.readAt(int64(len(data))-5, 10)
+	if err != nil {
+		t.Fatalf("reading: %v", err)
+	}
+	if !bytes.Equal(got, data[len(data)-5:]) {
+		t.Errorf("expected the last 5 bytes, got %v", got)
+	}
+	// a read spanning what we have only fetches the gaps
+	before := counter.fetched
+	got, err = file.readAt(0, 200*1024)
+	if err != nil {
+		t.Fatalf("reading: %v", err)
+	}
+	if !bytes.Equal(got, data[:200*1024]) {
+		t.Errorf("read data doesn't match")
+	}
+	if fetched := counter.fetched - before; fetched != 200*1024-minFetch {
+		t.Errorf("expected %d bytes fetched, got %d", 200*1024-minFetch, fetched)
+	}
+}
+
+func TestFetchRegionsMP3(t *testing.T) {
+	// an ID3v2 tag with a 100 KiB body, then 5 MiB of frames
+	var data []byte
+	data = append(data, 'I', 'D', '3', 4, 0, 0)
+	tagSize := 100 * 1024
+	data = append(data,
+		byte(tagSize>>21&0x7f), byte(tagSize>>14&0x7f),
+		byte(tagSize>>7&0x7f), byte(tagSize&0x7f))
+	data = append(data, filler(tagSize)...)
+	data = append(data, filler(5*1024*1024)...)
+	file, counter := fetchRegions(t, "track.mp3", data)
+	if counter.fetched > 300*1024 {
+		t.Errorf("expected only the head and tail to be fetched, got %d bytes", counter.fetched)
+	}
+	// the whole tag and the first frames are there
+	if !bytes.Equal(file.bytes(0, int64(10+tagSize+mpegFrameWindow)), data[:10+tagSize+mpegFrameWindow]) {
+		t.Errorf("expected the tag and first frames to be fetched")
+	}
+	if !bytes.Equal(file.bytes(file.size-tailSize, file.size), data[len(data)-tailSize:]) {
+		t.Errorf("expected the tail to be fetched")
+	}
+	if !bytes.Equal(file.tail(), data[len(data)-TailSize:]) {
+		t.Errorf("expected the tail to be kept")
+	}
+}
+
+func TestFetchRegionsFLAC(t *testing.T) {
+	block := func(blockType byte, last bool, body []byte) []byte {
+		if last {
+			blockType |= 0x80
+		}
+		size := len(body)
+		return append([]byte{blockType, byte(size >> 16), byte(size >> 8), byte(size)}, body...)
+	}
+	var data []byte
+	data = append(data, "fLaC"...)
+	data = append(data, block(0, false, bytes.Repeat([]byte{1}, 34))...)
+	// a big picture, which shouldn't be fetched
+	data = append(data, block(6, false, filler(2*1024*1024))...)
+	comments := bytes.Repeat([]byte{2}, 1000)
+	data = append(data, block(4, true, comments)...)
+	data = append(data, filler(10*1024*1024)...)
+	file, counter := fetchRegions(t, "track.flac", data)
+	if counter.fetched > 300*1024 {
+		t.Errorf("expected the picture and frames to be skipped, got %d bytes", counter.fetched)
+	}
+	commentsStart := 4 + 4 + 34 + 4 + 2*1024*1024 + 4
+	if !bytes.Equal(file.bytes(int64(commentsStart), int64(commentsStart+len(comments))), comments) {
+		t.Errorf("expected the comments block to be fetched")
+	}
+}
+
+func TestFetchRegionsMP4(t *testing.T) {
+	atom := func(atomType string, body []byte) []byte {
+		header := make([]byte, 8)
+		binary.BigEndian.PutUint32(header, uint32(8+len(body)))
+		copy(header[4:], atomType)
+		return append(header, body...)
+	}
+	// moov at the end, after the audio
+	var data []byte
+	data = append(data, atom("ftyp", []byte("M4A "))...)
+	data = append(data, atom("mdat", filler(8*1024*1024))...)
+	moov := atom("moov", bytes.Repeat([]byte{3}, 200*1024))
+	data = append(data, moov...)
+	file, counter := fetchRegions(t, "track.m4a", data)
+	if counter.fetched > 500*1024 {
+		t.Errorf("expected mdat to be skipped, got %d bytes", counter.fetched)
+	}
+	if !bytes.Equal(file.bytes(file.size-int64(len(moov)), file.size), moov) {
+		t.Errorf("expected moov to be fetched")
+	}
+	if !bytes.Equal(file.tail(), data[len(data)-TailSize:]) {
+		t.Errorf("expected the tail to be kept")
+	}
+	// moov at the start, so the tail isn't needed
+	data = nil
+	data = append(data, atom("ftyp", []byte("M4A "))...)
+	data = append(data, moov...)
+	data = append(data, atom("mdat", filler(8*1024*1024))...)
+	file, _ = fetchRegions(t, "track.m4a", data)
+	if file.tail() != nil {
+		t.Errorf("expected no tail, since it wasn't fetched")
+	}
+}
+
+func TestFetchRegionsOgg(t *testing.T) {
+	page := func(granule uint64, body []byte) []byte {
+		header := make([]byte, 27)
+		copy(header, "OggS")
+		binary.LittleEndian.PutUint64(header[6:14], granule)
+		var segments []byte
+		for left := len(body); ; left -= 255 {
+			if left < 255 {
+				segments = append(segments, byte(left))
+				break
+			}
+			segments = append(segments, 255)
+		}
+		header[26] = byte(len(segments))
+		return append(append(header, segments...), body...)
+	}
+	var data []byte
+	data = append(data, page(0, bytes.Repeat([]byte{1}, 30))...)
+	// a comment header spanning pages
+	data = append(data, page(^uint64(0), bytes.Repeat([]byte{2}, 60000))...)
+	data = append(data, page(0, bytes.Repeat([]byte{2}, 60000))...)
+	headersEnd := len(data)
+	for i := 1; i < 1000; i++ {
+		data = append(data, page(uint64(i*4096), filler(4000))...)
+	}
+	file, counter := fetchRegions(t, "track.ogg", data)
+	if counter.fetched > 400*1024 {
+		t.Errorf("expected the audio pages to be skipped, got %d bytes", counter.fetched)
+	}
+	if !bytes.Equal(file.bytes(0, int64(headersEnd)), data[:headersEnd]) {
+		t.Errorf("expected the header pages to be fetched")
+	}
+	if !bytes.Equal(file.bytes(file.size-oggTailSize, file.size), data[len(data)-oggTailSize:]) {
+		t.Errorf("expected the last page to be fetched")
+	}
+}
+
+func TestFetchRegionsMemory(t *testing.T) {
+	// the same ID3v2 tag, with a small and a big file of frames after it
+	alloc := func(frames int) uint64 {
+		var data []byte
+		data = append(data, 'I', 'D', '3', 4, 0, 0, 0, 0, 0x10, 0)
+		data = append(data, filler(2048)...)
+		data = append(data, filler(frames)...)
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		fetchRegions(t, "track.mp3", data)
+		runtime.ReadMemStats(&after)
+		return after.TotalAlloc - before.TotalAlloc
+	}
+	small, big := alloc(1024*1024), alloc(64*1024*1024)
+	if big > 1024*1024 || big > 2*small {
+		t.Errorf("expected what's allocated to not depend on the file's size, got %d bytes for 1 MiB and %d for 64 MiB",
+			small, big)
+	}
+}
+
+func TestSparseFileWriteTemp(t *testing.T) {
+	data := make([]byte, 1024*1024)
+	for i := range data {
+		data[i] = byte(i%255 + 1)
+	}
+	counter := &rangeCounter{data: data}
+	file := &sparseFile{
+		size: int64(len(data)),
+		read: counter.read,
+	}
+	if _, err := file.readAt(0, 10); err != nil {
+		t.Fatalf("reading: %v", err)
+	}
+	if err := file.readTail(10); err != nil {
+		t.Fatalf("reading: %v", err)
+	}
+	tmpPath, err := file.writeTemp(".mp3")
+	if err != nil {
+		t.Fatalf("writing temp file: %v", err)
+	}
+	defer os.Remove(tmpPath)
+	if filepath.Ext(tmpPath) != ".mp3" {
+		t.Errorf("expected the temp file to keep the extension, got %q", tmpPath)
+	}
+	written, err := ioutil.ReadFile(tmpPath)
+	if err != nil {
+		t.Fatalf("reading temp file: %v", err)
+	}
+	if len(written) != len(data) {
+		t.Fatalf("expected %d bytes, got %d", len(data), len(written))
+	}
+	// the head and tail are there, and the rest is zeros
+	if !bytes.Equal(written[:minFetch], data[:minFetch]) ||
+		!bytes.Equal(written[len(data)-10:], data[len(data)-10:]) {
+		t.Errorf("expected the fetched parts to be written")
+	}
+	if !bytes.Equal(written[minFetch:minFetch+100], make([]byte, 100)) {
+		t.Errorf("expected what wasn't fetched to be zeros")
+	}
+}
@@ -11,6 +11,10 @@ import (
 type Tags struct {
 	raw   map[string]string
 	props *audiotags.AudioProperties
+	// picture is whether there's an embedded picture, and tail is the
+	// end of the file. they're only known for tags from NewFromRanges
+	picture bool
+	tail    []byte
 }
 
 func NewFromPath(path string) (*Tags, error) {
@@ -44,6 +48,26 @@ func (t *Tags) firstTag(keys ...string) string {
 	return ""
 }
 
+// values splits the first of keys into its values. they're separated by
+// any of the characters in delims, or by nul characters, which is how some
+// taggers store more than one value in a single frame
+func (t *Tags) values(delims string, keys ...string) []string {
+	fields := strings.FieldsFunc(t.firstTag(keys...), func(r rune) bool {
+		return r == 0 || strings.ContainsRune(delims, r)
+	})
+	seen := make(map[string]struct{}, len(fields))
+	ret := make([]string, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if _, ok := seen[field]; ok || field == "" {
+			continue
+		}
+		seen[field] = struct{}{}
+		ret = append(ret, field)
+	}
+	return ret
+}
+
 func (t *Tags) Title() string         { return t.firstTag("title") }
 func (t *Tags) BrainzID() string      { return t.firstTag("musicbrainz_trackid") }
 func (t *Tags) Artist() string        { return t.firstTag("artist") }
@@ -56,6 +80,113 @@ func (t *Tags) TrackNumber() int      { return intSep(t.firstTag("tracknumber"),
 func (t *Tags) DiscNumber() int       { return intSep(t.firstTag("discnumber"), "/") }   // eg. 1/2
 func (t *Tags) Length() int           { return t.props.Length }
 func (t *Tags) Bitrate() int          { return t.props.Bitrate }
+func (t *Tags) HasPicture() bool      { return t.picture }
+
+// Tail is the last TailSize bytes of the file, or all of it if it's smaller.
+// it's nil if they weren't fetched to read the tags, which is often the
+// case for MP4s
+func (t *Tags) Tail() []byte { return t.tail }
+
+// these are stored, but aren't used for browsing
+
+func (t *Tags) Composer() string      { return t.firstTag("composer") }
+func (t *Tags) Conductor() string     { return t.firstTag("conductor") }
+func (t *Tags) Label() string         { return t.firstTag("label", "publisher", "organization") }
+func (t *Tags) CatalogNumber() string { return t.firstTag("catalognumber", "catalog number") }
+func (t *Tags) Comment() string       { return t.firstTag("comment", "description") }
+func (t *Tags) BPM() int              { return intSep(t.firstTag("bpm"), ".") } // eg. 120.5
+func (t *Tags) OriginalDate() string  { return t.firstTag("originaldate", "originalyear") }
+func (t *Tags) OriginalYear() int     { return intSep(t.OriginalDate(), "-") }
+
+// the sort tags are names to sort by instead, eg. "Beatles, The"
+
+func (t *Tags) ArtistSort() string      { return t.firstTag("artistsort", "artist sort") }
+func (t *Tags) AlbumArtistSort() string { return t.firstTag("albumartistsort", "album artist sort") }
+func (t *Tags) AlbumSort() string       { return t.firstTag("albumsort", "album sort") }
+
+// ReleaseTypes are the musicbrainz types of the release, lowercased, eg.
+// "album" and "live". they're often written as "Album/Live" or "album; live"
+func (t *Tags) ReleaseTypes() []string {
+	types := t.values(";/,", "releasetype", "musicbrainz_albumtype", "musicbrainz album type")
+	for i, typ := range types {
+		types[i] = strings.ToLower(typ)
+	}
+	return types
+}
+
+// Compilation is whether the track is flagged as being from a compilation.
+// it's TCMP in id3, cpil in mp4, and COMPILATION in the others. taggers
+// write "1", but some write "true"
+func (t *Tags) Compilation() bool {
+	switch strings.ToLower(strings.TrimSpace(t.firstTag("compilation", "itunescompilation", "tcmp"))) {
+	case "1", "true", "yes":
+		return true
+	}
+	return false
+}
+
+// Lyrics are from USLT frames in id3, and LYRICS fields in the others.
+// they're plain text, or LRC if the tagger synced them
+func (t *Tags) Lyrics() string {
+	return t.firstTag("lyrics", "unsyncedlyrics", "unsynced lyrics")
+}
+
+// these are split with values(). the plural tags some taggers write are
+// preferred, since they don't need splitting
+
+func (t *Tags) Artists(delims string) []string {
+	return t.values(delims, "artists", "artist")
+}
+
+func (t *Tags) AlbumArtists(delims string) []string {
+	return t.values(delims, "albumartists", "album artists", "albumartist", "album artist")
+}
+
+func (t *Tags) Genres(delims string) []string {
+	return t.values(delims, "genre")
+}
+
+// replaygain gains are in dB, eg. "-6.54 dB", and peaks are linear, eg.
+// "0.988547". they're nil if the track isn't tagged with them. opus files
+// have R128_*_GAIN tags instead, which are relative to -23 LUFS rather
+// than replaygain's -18, in 1/256 dB
+
+func (t *Tags) TrackGain() *float64 {
+	return gain(t.firstTag("replaygain_track_gain"), t.firstTag("r128_track_gain"))
+}
+
+func (t *Tags) TrackPeak() *float64 {
+	return float(t.firstTag("replaygain_track_peak"))
+}
+
+func (t *Tags) AlbumGain() *float64 {
+	return gain(t.firstTag("replaygain_album_gain"), t.firstTag("r128_album_gain"))
+}
+
+func (t *Tags) AlbumPeak() *float64 {
+	return float(t.firstTag("replaygain_album_peak"))
+}
+
+func gain(replayGain, r128 string) *float64 {
+	if gain := float(strings.TrimSuffix(
+		strings.TrimSpace(strings.ToLower(replayGain)), "db")); gain != nil {
+		return gain
+	}
+	q78, err := strconv.Atoi(strings.TrimSpace(r128))
+	if err != nil {
+		return nil
+	}
+	gain := float64(q78)/256 + 5
+	return &gain
+}
+
+func float(in string) *float64 {
+	out, err := strconv.ParseFloat(strings.TrimSpace(in), 64)
+	if err != nil {
+		return nil
+	}
+	return &out
+}
 
 func intSep(in, sep string) int {
 	if in == "" {
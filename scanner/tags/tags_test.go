@@ -0,0 +1,111 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValues(t *testing.T) {
+	cases := []struct {
+		raw    map[string]string
+		delims string
+		exp    []string
+	}{
+		{map[string]string{"genre": "Jazz"}, ";", []string{"Jazz"}},
+		{map[string]string{"genre": "Jazz;Soul"}, ";", []string{"Jazz", "Soul"}},
+		{map[string]string{"genre": "Jazz; Soul/Funk"}, ";/", []string{"Jazz", "Soul", "Funk"}},
+		{map[string]string{"genre": "Jazz; Soul"}, "", []string{"Jazz; Soul"}},
+		{map[string]string{"genre": "Jazz\x00Soul"}, "", []string{"Jazz", "Soul"}},
+		{map[string]string{"genre": "Jazz;;jazz; Jazz ;"}, ";", []string{"Jazz", "jazz"}},
+		{map[string]string{"genre": " ; "}, ";", []string{}},
+		{map[string]string{}, ";", []string{}},
+	}
+	for _, tc := range cases {
+		tags := &Tags{raw: tc.raw}
+		if act := tags.Genres(tc.delims); !reflect.DeepEqual(act, tc.exp) {
+			t.Errorf("genre %q with %q: expected %q, got %q",
+				tc.raw["genre"], tc.delims, tc.exp, act)
+		}
+	}
+}
+
+func TestArtistsPreferPlural(t *testing.T) {
+	tags := &Tags{raw: map[string]string{
+		"artist":      "A feat. B",
+		"artists":     "A;B",
+		"albumartist": "A",
+	}}
+	if act, exp := tags.Artists(";"), []string{"A", "B"}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("expected artists %q, got %q", exp, act)
+	}
+	if act, exp := tags.AlbumArtists(";"), []string{"A"}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("expected album artists %q, got %q", exp, act)
+	}
+}
+
+func TestReplayGain(t *testing.T) {
+	tags := &Tags{raw: map[string]string{
+		"replaygain_track_gain": "-6.54 dB",
+		"replaygain_track_peak": "0.988547",
+		"replaygain_album_gain": "+1.5dB",
+		"r128_album_gain":       "-512",
+	}}
+	expect := func(name string, act *float64, exp float64) {
+		t.Helper()
+		if act == nil || *act != exp {
+			t.Errorf("expected %s %v, got %v", name, exp, act)
+		}
+	}
+	expect("track gain", tags.TrackGain(), -6.54)
+	expect("track peak", tags.TrackPeak(), 0.988547)
+	expect("album gain", tags.AlbumGain(), 1.5)
+	if act := tags.AlbumPeak(); act != nil {
+		t.Errorf("expected no album peak, got %v", *act)
+	}
+	// r128 gains are relative to -23 LUFS, in 1/256 dB
+	opus := &Tags{raw: map[string]string{"r128_track_gain": "-512"}}
+	expect("r128 track gain", opus.TrackGain(), 3)
+	bad := &Tags{raw: map[string]string{"replaygain_track_gain": "loud"}}
+	if act := bad.TrackGain(); act != nil {
+		t.Errorf("expected no gain from a bad tag, got %v", *act)
+	}
+}
+
+func TestExtendedTags(t *testing.T) {
+	tags := &Tags{raw: map[string]string{
+		"bpm":          "120.5",
+		"originaldate": "1973-03-01",
+		"releasetype":  "Album/Live",
+		"organization": "Harvest",
+	}}
+	if bpm := tags.BPM(); bpm != 120 {
+		t.Errorf("expected a bpm of 120, got %d", bpm)
+	}
+	if year := tags.OriginalYear(); year != 1973 {
+		t.Errorf("expected an original year of 1973, got %d", year)
+	}
+	if act, exp := tags.ReleaseTypes(), []string{"album", "live"}; !reflect.DeepEqual(act, exp) {
+		t.Errorf("expected release types %q, got %q", exp, act)
+	}
+	if label := tags.Label(); label != "Harvest" {
+		t.Errorf("expected the label from the organization tag, got %q", label)
+	}
+}
+
+func TestCompilation(t *testing.T) {
+	cases := []struct {
+		raw map[string]string
+		exp bool
+	}{
+		{map[string]string{"compilation": "1"}, true},
+		{map[string]string{"itunescompilation": "True"}, true},
+		{map[string]string{"compilation": "0"}, false},
+		{map[string]string{}, false},
+	}
+	for _, tc := range cases {
+		tags := &Tags{raw: tc.raw}
+		if act := tags.Compilation(); act != tc.exp {
+			t.Errorf("compilation %v: expected %t, got %t", tc.raw, tc.exp, act)
+		}
+	}
+}
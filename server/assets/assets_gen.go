@@ -8,569 +8,97 @@ type EmbeddedAsset struct {
 	Bytes []byte
 }
 var Bytes = map[string]*EmbeddedAsset{
-"pages/change_password.tmpl": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
-	Bytes: []byte{
-0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,
-0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,
-0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,
-0x64,0x69,0x2d,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,0x2d,0x6b,0x65,0x79,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x63,0x68,0x61,
-0x6e,0x67,0x69,0x6e,0x67,0x20,0x7b,0x7b,0x20,0x2e,0x53,0x65,0x6c,0x65,0x63,0x74,0x65,0x64,0x55,0x73,0x65,0x72,0x2e,0x4e,
-0x61,0x6d,0x65,0x20,0x7d,0x7d,0x27,0x73,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,
-0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,
-0x6f,0x63,0x6b,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x72,0x69,0x6e,0x74,0x66,0x20,0x22,
-0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,0x68,0x61,0x6e,0x67,0x65,0x5f,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x64,
-0x6f,0x3f,0x75,0x73,0x65,0x72,0x3d,0x25,0x73,0x22,0x20,0x2e,0x53,0x65,0x6c,0x65,0x63,0x74,0x65,0x64,0x55,0x73,0x65,0x72,
-0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7c,0x20,0x70,0x61,0x74,0x68,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,
-0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,
-0x79,0x70,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,
-0x6f,0x72,0x64,0x5f,0x6f,0x6e,0x65,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,
-0x6f,0x6e,0x65,0x22,0x20,0x70,0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x6e,0x65,0x77,0x20,0x70,0x61,
-0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,
-0x74,0x79,0x70,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,
-0x77,0x6f,0x72,0x64,0x5f,0x74,0x77,0x6f,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,
-0x5f,0x74,0x77,0x6f,0x22,0x20,0x70,0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x76,0x65,0x72,0x69,0x66,
-0x79,0x20,0x6e,0x65,0x77,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,
-0x6c,0x75,0x65,0x3d,0x22,0x63,0x68,0x61,0x6e,0x67,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,
-0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
-}},
-"pages/login.tmpl": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
-	Bytes: []byte{
-0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x22,0x20,0x7d,0x7d,0x0a,0x3c,
-0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,
-0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,
-0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,
-0x69,0x20,0x6d,0x64,0x69,0x2d,0x6c,0x6f,0x67,0x69,0x6e,0x2d,0x76,0x61,0x72,0x69,0x61,0x6e,0x74,0x22,0x3e,0x3c,0x2f,0x69,
-0x3e,0x20,0x6c,0x6f,0x67,0x69,0x6e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,
-0x66,0x6f,0x72,0x6d,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,
-0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x6c,0x6f,0x67,0x69,0x6e,
-0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x74,0x65,0x78,
-0x74,0x22,0x20,0x69,0x64,0x3d,0x22,0x75,0x73,0x65,0x72,0x6e,0x61,0x6d,0x65,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x75,
-0x73,0x65,0x72,0x6e,0x61,0x6d,0x65,0x22,0x20,0x70,0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x75,0x73,
-0x65,0x72,0x6e,0x61,0x6d,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,
-0x74,0x79,0x70,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,
-0x77,0x6f,0x72,0x64,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x20,0x70,0x6c,
-0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x3e,0x0a,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,
-0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x6c,0x6f,0x67,0x69,0x6e,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,
-0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
-}},
-"pages/home.tmpl": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
-	Bytes: []byte{
-0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,
-0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,
-0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,
-0x64,0x69,0x2d,0x63,0x68,0x61,0x72,0x74,0x2d,0x61,0x72,0x63,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x73,0x74,0x61,0x74,0x73,
-0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,
-0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x69,0x64,0x3d,0x22,0x73,0x74,0x61,0x74,0x73,0x22,0x20,0x63,0x6c,0x61,0x73,
-0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x3c,0x74,0x64,0x3e,0x61,0x72,0x74,0x69,0x73,0x74,0x73,0x3a,0x3c,0x2f,0x74,0x64,0x3e,0x20,0x3c,0x74,0x64,0x3e,0x7b,
-0x7b,0x20,0x2e,0x41,0x72,0x74,0x69,0x73,0x74,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x3c,0x74,0x64,0x3e,0x61,0x6c,0x62,0x75,0x6d,0x73,0x3a,0x3c,0x2f,0x74,0x64,0x3e,0x20,0x3c,0x74,0x64,0x3e,0x7b,
-0x7b,0x20,0x2e,0x41,0x6c,0x62,0x75,0x6d,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x3c,0x74,0x64,0x3e,0x74,0x72,0x61,0x63,0x6b,0x73,0x3a,0x3c,0x2f,0x74,0x64,0x3e,0x20,0x3c,0x74,0x64,0x3e,0x7b,0x7b,
-0x20,0x2e,0x54,0x72,0x61,0x63,0x6b,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
-0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,
-0x3e,0x0a,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,
-0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,
-0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,
-0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x6c,0x61,0x73,0x74,0x66,0x6d,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x6c,0x61,
-0x73,0x74,0x2e,0x66,0x6d,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,
-0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x64,0x65,0x73,0x63,0x72,0x69,0x70,0x74,0x69,0x6f,0x6e,
-0x20,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,
-0x3e,0x67,0x6f,0x6e,0x69,0x63,0x20,0x63,0x61,0x6e,0x20,0x73,0x63,0x72,0x6f,0x62,0x62,0x6c,0x65,0x20,0x74,0x6f,0x20,0x3c,
-0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x68,0x74,0x74,0x70,0x73,0x3a,0x2f,0x2f,0x77,0x77,0x77,0x2e,0x6c,0x61,0x73,0x74,
-0x2e,0x66,0x6d,0x2f,0x22,0x20,0x74,0x61,0x72,0x67,0x65,0x74,0x3d,0x22,0x5f,0x62,0x6c,0x61,0x6e,0x6b,0x22,0x3e,0x6c,0x61,
-0x73,0x74,0x2e,0x66,0x6d,0x3c,0x2f,0x61,0x3e,0x20,0x66,0x6f,0x72,0x20,0x61,0x6e,0x79,0x20,0x75,0x73,0x65,0x72,0x20,0x28,
-0x62,0x75,0x74,0x20,0x74,0x68,0x65,0x20,0x61,0x64,0x6d,0x69,0x6e,0x20,0x6d,0x75,0x73,0x74,0x20,0x73,0x65,0x74,0x20,0x61,
-0x20,0x67,0x6c,0x6f,0x62,0x61,0x6c,0x20,0x61,0x70,0x69,0x20,0x6b,0x65,0x79,0x29,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,
-0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,
-0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,
-0x69,0x66,0x20,0x2e,0x43,0x75,0x72,0x72,0x65,0x6e,0x74,0x4c,0x61,0x73,0x74,0x46,0x4d,0x41,0x50,0x49,0x4b,0x65,0x79,0x20,
-0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,
-0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x63,0x75,0x72,0x72,0x65,0x6e,0x74,0x20,
-0x73,0x74,0x61,0x74,0x75,0x73,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,0x55,0x73,0x65,0x72,0x2e,0x4c,0x61,0x73,0x74,0x46,0x4d,0x53,0x65,0x73,0x73,
-0x69,0x6f,0x6e,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x6c,
-0x69,0x6e,0x6b,0x65,0x64,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,
-0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x26,
-0x23,0x31,0x32,0x34,0x3b,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,
-0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x75,0x6e,0x6c,0x69,0x6e,0x6b,0x5f,0x6c,0x61,0x73,0x74,0x66,0x6d,
-0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,
-0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,
-0x75,0x6e,0x6c,0x69,0x6e,0x6b,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,
-0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x61,0x6e,0x67,0x72,0x79,0x22,0x3e,0x75,0x6e,0x6c,0x69,
-0x6e,0x6b,0x65,0x64,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x24,0x63,0x62,0x50,0x61,0x74,0x68,0x20,0x3a,0x3d,0x20,0x70,0x61,0x74,0x68,0x20,0x22,
-0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x6c,0x69,0x6e,0x6b,0x5f,0x6c,0x61,0x73,0x74,0x66,0x6d,0x5f,0x64,0x6f,0x22,0x20,0x7d,
-0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x24,0x63,0x62,
-0x55,0x52,0x4c,0x20,0x3a,0x3d,0x20,0x70,0x72,0x69,0x6e,0x74,0x66,0x20,0x22,0x25,0x73,0x25,0x73,0x22,0x20,0x2e,0x52,0x65,
-0x71,0x75,0x65,0x73,0x74,0x52,0x6f,0x6f,0x74,0x20,0x24,0x63,0x62,0x50,0x61,0x74,0x68,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x68,0x74,
-0x74,0x70,0x73,0x3a,0x2f,0x2f,0x77,0x77,0x77,0x2e,0x6c,0x61,0x73,0x74,0x2e,0x66,0x6d,0x2f,0x61,0x70,0x69,0x2f,0x61,0x75,
-0x74,0x68,0x2f,0x3f,0x61,0x70,0x69,0x5f,0x6b,0x65,0x79,0x3d,0x7b,0x7b,0x20,0x2e,0x43,0x75,0x72,0x72,0x65,0x6e,0x74,0x4c,
-0x61,0x73,0x74,0x46,0x4d,0x41,0x50,0x49,0x4b,0x65,0x79,0x20,0x7d,0x7d,0x26,0x63,0x62,0x3d,0x7b,0x7b,0x20,0x24,0x63,0x62,
-0x55,0x52,0x4c,0x20,0x7d,0x7d,0x22,0x3e,0x6c,0x69,0x6e,0x6b,0x26,0x23,0x38,0x32,0x33,0x30,0x3b,0x3c,0x2f,0x61,0x3e,0x0a,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,
-0x74,0x22,0x3e,0x61,0x70,0x69,0x20,0x6b,0x65,0x79,0x20,0x6e,0x6f,0x74,0x20,0x73,0x65,0x74,0x3c,0x2f,0x70,0x3e,0x0a,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x6e,0x6f,0x74,0x20,0x2e,0x55,0x73,
-0x65,0x72,0x2e,0x49,0x73,0x41,0x64,0x6d,0x69,0x6e,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,
-0x68,0x74,0x22,0x3e,0x70,0x6c,0x65,0x61,0x73,0x65,0x20,0x61,0x73,0x6b,0x20,0x79,0x6f,0x75,0x72,0x20,0x61,0x64,0x6d,0x69,
-0x6e,0x20,0x74,0x6f,0x20,0x73,0x65,0x74,0x20,0x69,0x74,0x3c,0x2f,0x70,0x3e,0x0a,0x09,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,
-0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,0x55,0x73,0x65,0x72,0x2e,0x49,0x73,0x41,
-0x64,0x6d,0x69,0x6e,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x3c,
-0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,
-0x75,0x70,0x64,0x61,0x74,0x65,0x5f,0x6c,0x61,0x73,0x74,0x66,0x6d,0x5f,0x61,0x70,0x69,0x5f,0x6b,0x65,0x79,0x22,0x20,0x7d,
-0x7d,0x22,0x3e,0x75,0x70,0x64,0x61,0x74,0x65,0x20,0x61,0x70,0x69,0x20,0x6b,0x65,0x79,0x26,0x23,0x38,0x32,0x33,0x30,0x3b,
-0x3c,0x2f,0x61,0x3e,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,
-0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x64,0x69,
-0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,
-0x20,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,0x55,0x73,0x65,0x72,0x2e,0x49,0x73,0x41,0x64,0x6d,0x69,0x6e,0x20,0x7d,0x7d,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2f,0x2a,0x20,0x61,0x64,0x6d,0x69,0x6e,0x20,0x70,0x61,0x6e,0x65,
-0x6c,0x20,0x74,0x6f,0x20,0x6d,0x61,0x6e,0x61,0x67,0x65,0x20,0x61,0x6c,0x6c,0x20,0x75,0x73,0x65,0x72,0x73,0x20,0x2a,0x2f,
-0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,
-0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
-0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,
-0x2d,0x6d,0x75,0x6c,0x74,0x69,0x70,0x6c,0x65,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x75,0x73,0x65,0x72,0x73,0x0a,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,
-0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x24,0x75,0x73,0x65,0x72,0x20,0x3a,0x3d,0x20,
-0x2e,0x41,0x6c,0x6c,0x55,0x73,0x65,0x72,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x3c,0x69,0x3e,0x7b,0x7b,0x20,0x24,0x75,0x73,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x69,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,
-0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x20,0x6e,0x6f,0x2d,0x73,0x6d,0x61,0x6c,0x6c,0x22,0x3e,0x7b,
-0x7b,0x20,0x24,0x75,0x73,0x65,0x72,0x2e,0x43,0x72,0x65,0x61,0x74,0x65,0x64,0x41,0x74,0x20,0x7c,0x20,0x64,0x61,0x74,0x65,
-0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
-0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,
-0x26,0x23,0x31,0x32,0x34,0x3b,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x72,0x69,0x6e,0x74,0x66,0x20,0x22,0x2f,0x61,
-0x64,0x6d,0x69,0x6e,0x2f,0x63,0x68,0x61,0x6e,0x67,0x65,0x5f,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x3f,0x75,0x73,0x65,
-0x72,0x3d,0x25,0x73,0x22,0x20,0x24,0x75,0x73,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7c,0x20,0x70,0x61,0x74,0x68,0x20,
-0x7d,0x7d,0x22,0x3e,0x63,0x68,0x61,0x6e,0x67,0x65,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x26,0x23,0x38,0x32,0x33,
-0x30,0x3b,0x3c,0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,
-0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x26,0x23,0x31,0x32,
-0x34,0x3b,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,
-0x20,0x69,0x66,0x20,0x24,0x75,0x73,0x65,0x72,0x2e,0x49,0x73,0x41,0x64,0x6d,0x69,0x6e,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,
-0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x64,0x65,0x6c,0x65,0x74,0x65,0x26,0x23,0x38,0x32,
-0x33,0x30,0x3b,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,
-0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x72,0x69,0x6e,0x74,0x66,0x20,0x22,0x2f,0x61,
-0x64,0x6d,0x69,0x6e,0x2f,0x64,0x65,0x6c,0x65,0x74,0x65,0x5f,0x75,0x73,0x65,0x72,0x3f,0x75,0x73,0x65,0x72,0x3d,0x25,0x73,
-0x22,0x20,0x24,0x75,0x73,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7c,0x20,0x70,0x61,0x74,0x68,0x20,0x7d,0x7d,0x22,0x3e,
-0x64,0x65,0x6c,0x65,0x74,0x65,0x26,0x23,0x38,0x32,0x33,0x30,0x3b,0x3c,0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x3c,0x62,0x72,0x2f,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,
-0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,
-0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,0x72,0x65,0x61,0x74,0x65,0x5f,0x75,0x73,0x65,0x72,
-0x22,0x20,0x7d,0x7d,0x22,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x75,0x74,0x74,0x6f,0x6e,0x22,0x3e,0x63,0x72,0x65,
-0x61,0x74,0x65,0x20,0x6e,0x65,0x77,0x26,0x23,0x38,0x32,0x33,0x30,0x3b,0x3c,0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2f,0x2a,0x20,0x75,0x73,0x65,0x72,0x20,0x70,0x61,0x6e,0x65,0x6c,
-0x20,0x74,0x6f,0x20,0x6d,0x61,0x6e,0x61,0x67,0x65,0x20,0x74,0x68,0x65,0x6d,0x73,0x65,0x6c,0x76,0x65,0x73,0x20,0x2a,0x2f,
-0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,
-0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
-0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,
-0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x79,0x6f,0x75,0x72,0x20,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,0x0a,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,
-0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,
-0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,0x68,0x61,0x6e,0x67,0x65,0x5f,0x6f,0x77,0x6e,0x5f,0x70,0x61,0x73,0x73,
-0x77,0x6f,0x72,0x64,0x22,0x20,0x7d,0x7d,0x22,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x75,0x74,0x74,0x6f,0x6e,0x22,
-0x3e,0x63,0x68,0x61,0x6e,0x67,0x65,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x26,0x23,0x38,0x32,0x33,0x30,0x3b,0x3c,
-0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x7b,
-0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,
-0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,
-0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x66,
-0x6f,0x6c,0x64,0x65,0x72,0x2d,0x6d,0x75,0x6c,0x74,0x69,0x70,0x6c,0x65,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x72,0x65,0x63,
-0x65,0x6e,0x74,0x20,0x66,0x6f,0x6c,0x64,0x65,0x72,0x73,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,
-0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x2d,0x72,0x69,0x67,
-0x68,0x74,0x20,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x28,0x6c,0x65,0x6e,0x20,0x2e,0x52,0x65,0x63,0x65,0x6e,0x74,0x46,0x6f,0x6c,
-0x64,0x65,0x72,0x73,0x29,0x20,0x30,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
-0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,
-0x6e,0x6f,0x20,0x66,0x6f,0x6c,0x64,0x65,0x72,0x73,0x20,0x79,0x65,0x74,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x69,0x64,0x3d,0x22,0x72,0x65,0x63,0x65,0x6e,0x74,0x2d,0x66,0x6f,0x6c,0x64,0x65,0x72,
-0x73,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x63,0x6f,0x6c,0x67,0x72,0x6f,0x75,0x70,0x3e,0x0a,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x63,0x6f,0x6c,0x20,0x77,0x69,0x64,0x74,0x68,0x3d,0x22,0x38,
-0x30,0x25,0x22,0x20,0x2f,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x63,0x6f,0x6c,0x20,
-0x77,0x69,0x64,0x74,0x68,0x3d,0x22,0x30,0x25,0x22,0x20,0x2f,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,
-0x63,0x6f,0x6c,0x67,0x72,0x6f,0x75,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,
-0x67,0x65,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x20,0x3a,0x3d,0x20,0x2e,0x52,0x65,0x63,0x65,0x6e,0x74,0x46,0x6f,0x6c,
-0x64,0x65,0x72,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,
-0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x20,0x74,0x65,0x78,0x74,0x2d,0x74,0x72,0x75,0x6e,0x63,0x22,0x3e,0x7b,
-0x7b,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x2e,0x52,0x69,0x67,0x68,0x74,0x50,0x61,0x74,0x68,0x20,0x7d,0x7d,0x3c,0x2f,
-0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x73,0x70,0x61,
-0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x20,0x74,0x69,0x74,
-0x6c,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x2e,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x41,
-0x74,0x20,0x7d,0x7d,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x2e,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,
-0x64,0x41,0x74,0x20,0x7c,0x20,0x64,0x61,0x74,0x65,0x48,0x75,0x6d,0x61,0x6e,0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,
-0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,
-0x69,0x66,0x20,0x61,0x6e,0x64,0x20,0x28,0x6e,0x6f,0x74,0x20,0x2e,0x49,0x73,0x53,0x63,0x61,0x6e,0x6e,0x69,0x6e,0x67,0x29,
-0x20,0x28,0x2e,0x55,0x73,0x65,0x72,0x2e,0x49,0x73,0x41,0x64,0x6d,0x69,0x6e,0x29,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x69,0x66,0x20,0x6e,0x6f,0x74,0x20,0x2e,0x4c,0x61,0x73,
-0x74,0x53,0x63,0x61,0x6e,0x54,0x69,0x6d,0x65,0x2e,0x49,0x73,0x5a,0x65,0x72,0x6f,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,
-0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x20,0x74,0x69,0x74,0x6c,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x2e,0x4c,0x61,
-0x73,0x74,0x53,0x63,0x61,0x6e,0x54,0x69,0x6d,0x65,0x20,0x7d,0x7d,0x22,0x3e,0x73,0x63,0x61,0x6e,0x6e,0x65,0x64,0x20,0x7b,
-0x7b,0x20,0x2e,0x4c,0x61,0x73,0x74,0x53,0x63,0x61,0x6e,0x54,0x69,0x6d,0x65,0x20,0x7c,0x20,0x64,0x61,0x74,0x65,0x48,0x75,
-0x6d,0x61,0x6e,0x20,0x7d,0x7d,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,
-0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,
-0x72,0x6d,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,
-0x69,0x6e,0x2f,0x73,0x74,0x61,0x72,0x74,0x5f,0x73,0x63,0x61,0x6e,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,
-0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,
-0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x73,0x74,0x61,0x72,0x74,0x20,0x73,0x63,0x61,0x6e,0x22,
-0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,
-0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,
-0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,
-0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,
-0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x66,0x69,
-0x6c,0x65,0x2d,0x6d,0x75,0x73,0x69,0x63,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x74,0x72,0x61,0x6e,0x73,0x63,0x6f,0x64,0x69,
-0x6e,0x67,0x20,0x64,0x65,0x76,0x69,0x63,0x65,0x20,0x70,0x72,0x6f,0x66,0x69,0x6c,0x65,0x73,0x0a,0x20,0x20,0x20,0x20,0x3c,
-0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,
-0x78,0x2d,0x64,0x65,0x73,0x63,0x72,0x69,0x70,0x74,0x69,0x6f,0x6e,0x20,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,
-0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x79,0x6f,0x75,0x20,0x63,0x61,0x6e,0x20,0x66,0x69,
-0x6e,0x64,0x20,0x79,0x6f,0x75,0x72,0x20,0x64,0x65,0x76,0x69,0x63,0x65,0x27,0x73,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x20,
-0x6e,0x61,0x6d,0x65,0x20,0x69,0x6e,0x20,0x74,0x68,0x65,0x20,0x67,0x6f,0x6e,0x69,0x63,0x20,0x6c,0x6f,0x67,0x73,0x2e,0x3c,
-0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x73,0x6f,0x6d,0x65,0x20,0x63,0x6f,0x6d,0x6d,
-0x6f,0x6e,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x20,0x6e,0x61,0x6d,0x65,0x73,0x20,0x61,0x72,0x65,0x20,0x3c,0x73,0x70,0x61,
-0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x65,0x6d,0x70,0x22,0x3e,0x44,0x53,0x75,0x62,0x3c,
-0x2f,0x73,0x70,0x61,0x6e,0x3e,0x2c,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,
-0x74,0x2d,0x65,0x6d,0x70,0x22,0x3e,0x4a,0x61,0x6d,0x73,0x74,0x61,0x73,0x68,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x2c,0x20,
-0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x65,0x6d,0x70,0x22,0x3e,0x53,
-0x6f,0x75,0x6e,0x64,0x77,0x61,0x76,0x65,0x73,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x2c,0x20,0x6f,0x72,0x20,0x75,0x73,0x65,
-0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x65,0x6d,0x70,0x22,0x3e,
-0x2a,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x20,0x61,0x73,0x20,0x66,0x61,0x6c,0x6c,0x62,0x61,0x63,0x6b,0x20,0x72,0x75,0x6c,
-0x65,0x20,0x66,0x6f,0x72,0x20,0x61,0x6e,0x79,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,
-0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,
-0x62,0x6c,0x6f,0x63,0x6b,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,
-0x61,0x62,0x6c,0x65,0x20,0x69,0x64,0x3d,0x22,0x74,0x72,0x61,0x6e,0x73,0x63,0x6f,0x64,0x65,0x2d,0x70,0x72,0x65,0x66,0x65,
-0x72,0x65,0x6e,0x63,0x65,0x73,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,
-0x65,0x20,0x24,0x70,0x72,0x65,0x66,0x20,0x3a,0x3d,0x20,0x2e,0x54,0x72,0x61,0x6e,0x73,0x63,0x6f,0x64,0x65,0x50,0x72,0x65,
-0x66,0x65,0x72,0x65,0x6e,0x63,0x65,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,
-0x24,0x66,0x6f,0x72,0x6d,0x53,0x75,0x66,0x66,0x69,0x78,0x20,0x3a,0x3d,0x20,0x6b,0x65,0x62,0x61,0x62,0x63,0x61,0x73,0x65,
-0x20,0x24,0x70,0x72,0x65,0x66,0x2e,0x43,0x6c,0x69,0x65,0x6e,0x74,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x69,0x64,0x3d,0x22,0x74,0x72,0x61,0x6e,0x73,
-0x63,0x6f,0x64,0x65,0x2d,0x70,0x72,0x65,0x66,0x2d,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x72,0x6d,0x53,0x75,0x66,0x66,0x69,0x78,
-0x20,0x7d,0x7d,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x72,0x69,0x6e,0x74,0x66,0x20,0x22,
-0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x64,0x65,0x6c,0x65,0x74,0x65,0x5f,0x74,0x72,0x61,0x6e,0x73,0x63,0x6f,0x64,0x65,0x5f,
-0x70,0x72,0x65,0x66,0x5f,0x64,0x6f,0x3f,0x63,0x6c,0x69,0x65,0x6e,0x74,0x3d,0x25,0x73,0x22,0x20,0x24,0x70,0x72,0x65,0x66,
-0x2e,0x43,0x6c,0x69,0x65,0x6e,0x74,0x20,0x7c,0x20,0x70,0x61,0x74,0x68,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,
-0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x72,0x65,0x66,0x2e,0x43,0x6c,
-0x69,0x65,0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x72,0x65,0x66,0x2e,0x50,0x72,0x6f,0x66,0x69,0x6c,
-0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x66,0x6f,0x72,0x6d,0x3d,0x22,0x74,0x72,0x61,0x6e,0x73,
-0x63,0x6f,0x64,0x65,0x2d,0x70,0x72,0x65,0x66,0x2d,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x72,0x6d,0x53,0x75,0x66,0x66,0x69,0x78,
-0x20,0x7d,0x7d,0x22,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,
-0x3d,0x22,0x64,0x65,0x6c,0x65,0x74,0x65,0x22,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,
-0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x69,0x64,0x3d,0x22,0x74,0x72,0x61,0x6e,0x73,0x63,0x6f,0x64,0x65,
-0x2d,0x70,0x72,0x65,0x66,0x2d,0x61,0x64,0x64,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,
-0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,0x72,0x65,0x61,0x74,0x65,0x5f,0x74,0x72,0x61,0x6e,0x73,0x63,
-0x6f,0x64,0x65,0x5f,0x70,0x72,0x65,0x66,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,
-0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x66,0x6f,0x72,0x6d,0x3d,0x22,0x74,0x72,0x61,0x6e,
-0x73,0x63,0x6f,0x64,0x65,0x2d,0x70,0x72,0x65,0x66,0x2d,0x61,0x64,0x64,0x22,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x74,0x65,
-0x78,0x74,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x63,0x6c,0x69,0x65,0x6e,0x74,0x22,0x20,0x70,0x6c,0x61,0x63,0x65,0x68,
-0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x63,0x6c,0x69,0x65,0x6e,0x74,0x20,0x6e,0x61,0x6d,0x65,0x22,0x3e,0x3c,0x2f,0x74,0x64,
-0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x73,0x65,0x6c,0x65,0x63,
-0x74,0x20,0x66,0x6f,0x72,0x6d,0x3d,0x22,0x74,0x72,0x61,0x6e,0x73,0x63,0x6f,0x64,0x65,0x2d,0x70,0x72,0x65,0x66,0x2d,0x61,
-0x64,0x64,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x72,0x6f,0x66,0x69,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x24,0x70,0x72,
-0x6f,0x66,0x69,0x6c,0x65,0x20,0x3a,0x3d,0x20,0x2e,0x54,0x72,0x61,0x6e,0x73,0x63,0x6f,0x64,0x65,0x50,0x72,0x6f,0x66,0x69,
-0x6c,0x65,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x3c,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,0x70,0x72,
-0x6f,0x66,0x69,0x6c,0x65,0x20,0x7d,0x7d,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x66,0x69,0x6c,0x65,0x20,0x7d,0x7d,
-0x3c,0x2f,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x3c,0x2f,0x73,0x65,0x6c,0x65,0x63,0x74,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x66,0x6f,0x72,0x6d,0x3d,0x22,0x74,0x72,0x61,0x6e,
-0x73,0x63,0x6f,0x64,0x65,0x2d,0x70,0x72,0x65,0x66,0x2d,0x61,0x64,0x64,0x22,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,
-0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x73,0x61,0x76,0x65,0x22,0x3e,0x3c,0x2f,0x74,0x64,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
-0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,
-0x3e,0x0a,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,
-0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,
-0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,
-0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2d,0x6d,0x75,0x73,0x69,0x63,0x22,
-0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x73,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,
-0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,
-0x2d,0x72,0x69,0x67,0x68,0x74,0x20,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x28,0x6c,0x65,0x6e,0x20,0x2e,0x50,0x6c,0x61,0x79,0x6c,
-0x69,0x73,0x74,0x73,0x29,0x20,0x30,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
-0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,
-0x6e,0x6f,0x20,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x73,0x20,0x79,0x65,0x74,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x69,0x64,0x3d,0x22,0x72,0x65,0x63,0x65,0x6e,0x74,0x2d,0x70,0x6c,0x61,0x79,
-0x6c,0x69,0x73,0x74,0x73,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,
-0x20,0x24,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x20,0x3a,0x3d,0x20,0x2e,0x50,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x73,
-0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,
-0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2e,0x4e,0x61,0x6d,
-0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,
-0x64,0x3e,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,
-0x74,0x22,0x3e,0x28,0x7b,0x7b,0x20,0x24,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2e,0x54,0x72,0x61,0x63,0x6b,0x43,0x6f,
-0x75,0x6e,0x74,0x20,0x7d,0x7d,0x20,0x74,0x72,0x61,0x63,0x6b,0x73,0x29,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x3c,0x2f,0x74,
-0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,
-0x3d,0x22,0x6e,0x6f,0x2d,0x73,0x6d,0x61,0x6c,0x6c,0x22,0x3e,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,
-0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x20,0x74,0x69,0x74,0x6c,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,
-0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2e,0x43,0x72,0x65,0x61,0x74,0x65,0x64,0x41,0x74,0x20,0x7d,0x7d,0x22,0x3e,0x7b,
-0x7b,0x20,0x24,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2e,0x43,0x72,0x65,0x61,0x74,0x65,0x64,0x41,0x74,0x20,0x7c,0x20,
-0x64,0x61,0x74,0x65,0x48,0x75,0x6d,0x61,0x6e,0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x3c,0x2f,0x74,0x64,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,
-0x61,0x62,0x6c,0x65,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x0a,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x69,0x64,0x3d,0x22,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2d,0x75,0x70,0x6c,
-0x6f,0x61,0x64,0x2d,0x66,0x6f,0x72,0x6d,0x22,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x65,0x6e,
-0x63,0x74,0x79,0x70,0x65,0x3d,0x22,0x6d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x2f,0x66,0x6f,0x72,0x6d,0x2d,0x64,0x61,
-0x74,0x61,0x22,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,
-0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x75,0x70,0x6c,0x6f,0x61,0x64,0x5f,0x70,
-0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x73,0x74,0x79,
-0x6c,0x65,0x3d,0x22,0x70,0x6f,0x73,0x69,0x74,0x69,0x6f,0x6e,0x3a,0x20,0x72,0x65,0x6c,0x61,0x74,0x69,0x76,0x65,0x3b,0x22,
-0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,
-0x20,0x69,0x64,0x3d,0x22,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2d,0x75,0x70,0x6c,0x6f,0x61,0x64,0x2d,0x69,0x6e,0x70,
-0x75,0x74,0x22,0x20,0x73,0x74,0x79,0x6c,0x65,0x3d,0x22,0x70,0x6f,0x73,0x69,0x74,0x69,0x6f,0x6e,0x3a,0x20,0x61,0x62,0x73,
-0x6f,0x6c,0x75,0x74,0x65,0x3b,0x20,0x6f,0x70,0x61,0x63,0x69,0x74,0x79,0x3a,0x20,0x30,0x3b,0x22,0x20,0x6e,0x61,0x6d,0x65,
-0x3d,0x22,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2d,0x66,0x69,0x6c,0x65,0x73,0x22,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,
-0x66,0x69,0x6c,0x65,0x22,0x20,0x6d,0x75,0x6c,0x74,0x69,0x70,0x6c,0x65,0x20,0x2f,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x62,
-0x75,0x74,0x74,0x6f,0x6e,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x75,0x70,0x6c,0x6f,0x61,0x64,0x20,0x6d,0x33,0x75,
-0x38,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
-0x73,0x63,0x72,0x69,0x70,0x74,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x64,0x6f,0x63,0x75,
-0x6d,0x65,0x6e,0x74,0x2e,0x67,0x65,0x74,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x42,0x79,0x49,0x64,0x28,0x22,0x70,0x6c,0x61,
-0x79,0x6c,0x69,0x73,0x74,0x2d,0x75,0x70,0x6c,0x6f,0x61,0x64,0x2d,0x69,0x6e,0x70,0x75,0x74,0x22,0x29,0x2e,0x6f,0x6e,0x63,
-0x68,0x61,0x6e,0x67,0x65,0x20,0x3d,0x20,0x28,0x65,0x29,0x20,0x3d,0x3e,0x20,0x7b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x64,0x6f,0x63,0x75,0x6d,0x65,0x6e,0x74,0x2e,0x67,0x65,0x74,0x45,0x6c,0x65,
-0x6d,0x65,0x6e,0x74,0x42,0x79,0x49,0x64,0x28,0x22,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2d,0x75,0x70,0x6c,0x6f,0x61,
-0x64,0x2d,0x66,0x6f,0x72,0x6d,0x22,0x29,0x2e,0x73,0x75,0x62,0x6d,0x69,0x74,0x28,0x29,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x73,0x63,0x72,0x69,0x70,
-0x74,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,
-0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
-}},
-"pages/delete_user.tmpl": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
-	Bytes: []byte{
-0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,
-0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,
-0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,
-0x64,0x69,0x2d,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,0x2d,0x72,0x65,0x6d,0x6f,0x76,0x65,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,
-0x64,0x65,0x6c,0x65,0x74,0x69,0x6e,0x67,0x20,0x75,0x73,0x65,0x72,0x20,0x7b,0x7b,0x20,0x2e,0x53,0x65,0x6c,0x65,0x63,0x74,
-0x65,0x64,0x55,0x73,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,
-0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x72,
-0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x61,0x72,0x65,0x20,0x79,0x6f,0x75,0x20,0x73,
-0x75,0x72,0x65,0x3f,0x3c,0x62,0x72,0x2f,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,
-0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x74,0x68,0x65,0x69,0x72,
-0x20,0x70,0x6c,0x61,0x79,0x73,0x2c,0x20,0x73,0x74,0x61,0x72,0x72,0x65,0x64,0x2c,0x20,0x65,0x74,0x63,0x2e,0x20,0x77,0x69,
-0x6c,0x6c,0x20,0x61,0x6c,0x73,0x6f,0x20,0x62,0x65,0x20,0x64,0x65,0x6c,0x65,0x74,0x65,0x64,0x3c,0x2f,0x73,0x70,0x61,0x6e,
-0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x63,
-0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,
-0x70,0x72,0x69,0x6e,0x74,0x66,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x64,0x65,0x6c,0x65,0x74,0x65,0x5f,0x75,0x73,
-0x65,0x72,0x5f,0x64,0x6f,0x3f,0x75,0x73,0x65,0x72,0x3d,0x25,0x73,0x22,0x20,0x2e,0x53,0x65,0x6c,0x65,0x63,0x74,0x65,0x64,
-0x55,0x73,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7c,0x20,0x70,0x61,0x74,0x68,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,
-0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,
-0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,
-0x79,0x65,0x73,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,
-0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
-}},
-"pages/update_lastfm_api_key.tmpl": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
-	Bytes: []byte{
-0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,
-0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,
-0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,
-0x64,0x69,0x2d,0x6b,0x65,0x79,0x2d,0x63,0x68,0x61,0x6e,0x67,0x65,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x75,0x70,0x64,0x61,
-0x74,0x69,0x6e,0x67,0x20,0x6c,0x61,0x73,0x74,0x2e,0x66,0x6d,0x20,0x6b,0x65,0x79,0x73,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,
-0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,
-0x2d,0x64,0x65,0x73,0x63,0x72,0x69,0x70,0x74,0x69,0x6f,0x6e,0x20,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,
-0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x79,0x6f,0x75,0x20,0x63,0x61,0x6e,0x20,0x67,0x65,0x74,
-0x20,0x61,0x6e,0x20,0x61,0x70,0x69,0x20,0x6b,0x65,0x79,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x68,0x74,0x74,
-0x70,0x73,0x3a,0x2f,0x2f,0x77,0x77,0x77,0x2e,0x6c,0x61,0x73,0x74,0x2e,0x66,0x6d,0x2f,0x61,0x70,0x69,0x2f,0x61,0x63,0x63,
-0x6f,0x75,0x6e,0x74,0x2f,0x63,0x72,0x65,0x61,0x74,0x65,0x22,0x20,0x74,0x61,0x72,0x67,0x65,0x74,0x3d,0x22,0x5f,0x62,0x6c,
-0x61,0x6e,0x6b,0x22,0x3e,0x68,0x65,0x72,0x65,0x3c,0x2f,0x61,0x3e,0x20,0x28,0x6e,0x6f,0x74,0x65,0x3a,0x20,0x6f,0x6e,0x6c,
-0x79,0x20,0x74,0x68,0x65,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x20,0x6e,0x61,0x6d,0x65,0x22,
-0x20,0x66,0x69,0x65,0x6c,0x64,0x20,0x69,0x73,0x20,0x72,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x29,0x3c,0x2f,0x70,0x3e,0x0a,
-0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,
-0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x3c,0x70,0x3e,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,
-0x68,0x74,0x22,0x3e,0x63,0x75,0x72,0x72,0x65,0x6e,0x74,0x20,0x6b,0x65,0x79,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x20,0x3c,
-0x69,0x3e,0x7b,0x7b,0x20,0x64,0x65,0x66,0x61,0x75,0x6c,0x74,0x20,0x22,0x6e,0x6f,0x74,0x20,0x73,0x65,0x74,0x22,0x20,0x2e,
-0x43,0x75,0x72,0x72,0x65,0x6e,0x74,0x4c,0x61,0x73,0x74,0x46,0x4d,0x41,0x50,0x49,0x4b,0x65,0x79,0x20,0x7d,0x7d,0x3c,0x2f,
-0x69,0x3e,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x3c,0x73,0x70,0x61,0x6e,0x20,
-0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x63,0x75,0x72,0x72,0x65,
-0x6e,0x74,0x20,0x73,0x65,0x63,0x72,0x65,0x74,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x20,0x3c,0x69,0x3e,0x7b,0x7b,0x20,0x64,
-0x65,0x66,0x61,0x75,0x6c,0x74,0x20,0x22,0x6e,0x6f,0x74,0x20,0x73,0x65,0x74,0x22,0x20,0x2e,0x43,0x75,0x72,0x72,0x65,0x6e,
-0x74,0x4c,0x61,0x73,0x74,0x46,0x4d,0x41,0x50,0x49,0x53,0x65,0x63,0x72,0x65,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x69,0x3e,0x3c,
-0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,
-0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,
-0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x75,0x70,0x64,0x61,0x74,0x65,0x5f,0x6c,0x61,
-0x73,0x74,0x66,0x6d,0x5f,0x61,0x70,0x69,0x5f,0x6b,0x65,0x79,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,
-0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,
-0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x74,0x65,0x78,0x74,0x22,0x20,0x69,0x64,0x3d,0x22,0x61,0x70,0x69,0x5f,0x6b,
-0x65,0x79,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x61,0x70,0x69,0x5f,0x6b,0x65,0x79,0x22,0x20,0x70,0x6c,0x61,0x63,0x65,
-0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x6e,0x65,0x77,0x20,0x6b,0x65,0x79,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x74,0x65,0x78,0x74,0x22,0x20,0x69,0x64,0x3d,
-0x22,0x73,0x65,0x63,0x72,0x65,0x74,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x73,0x65,0x63,0x72,0x65,0x74,0x22,0x20,0x70,
-0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x6e,0x65,0x77,0x20,0x73,0x65,0x63,0x72,0x65,0x74,0x22,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,
-0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x75,0x70,0x64,0x61,0x74,0x65,0x22,0x3e,0x0a,0x20,0x20,
-0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,
-0x7d,0x7d,0x0a,
-}},
-"pages/change_own_password.tmpl": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
-	Bytes: []byte{
-0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,
-0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,
-0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,
-0x64,0x69,0x2d,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,0x2d,0x6b,0x65,0x79,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x63,0x68,0x61,
-0x6e,0x67,0x69,0x6e,0x67,0x20,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x0a,0x20,
-0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x63,0x6c,0x61,0x73,
-0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,
-0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,0x68,0x61,0x6e,0x67,0x65,0x5f,0x6f,0x77,0x6e,0x5f,0x70,0x61,0x73,
-0x73,0x77,0x6f,0x72,0x64,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,
-0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,
-0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,
-0x5f,0x6f,0x6e,0x65,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x6f,0x6e,0x65,
-0x22,0x20,0x70,0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x6e,0x65,0x77,0x20,0x70,0x61,0x73,0x73,0x77,
-0x6f,0x72,0x64,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,
-0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,
-0x64,0x5f,0x74,0x77,0x6f,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x74,0x77,
-0x6f,0x22,0x20,0x70,0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x76,0x65,0x72,0x69,0x66,0x79,0x20,0x6e,
-0x65,0x77,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,
-0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,
-0x3d,0x22,0x63,0x68,0x61,0x6e,0x67,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x3c,
-0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
-}},
-"pages/create_user.tmpl": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
+"partials/head.tmpl": &EmbeddedAsset{
+	ModTime: time.Unix(1587481623, 0),
 	Bytes: []byte{
-0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,
-0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,
-0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,
-0x64,0x69,0x2d,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,0x2d,0x70,0x6c,0x75,0x73,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x63,0x72,
-0x65,0x61,0x74,0x69,0x6e,0x67,0x20,0x6e,0x65,0x77,0x20,0x75,0x73,0x65,0x72,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,
-0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,
-0x6b,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,
-0x69,0x6e,0x2f,0x63,0x72,0x65,0x61,0x74,0x65,0x5f,0x75,0x73,0x65,0x72,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,
-0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,
-0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x74,0x65,0x78,0x74,0x22,0x20,0x69,0x64,0x3d,0x22,0x75,0x73,0x65,
-0x72,0x6e,0x61,0x6d,0x65,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x75,0x73,0x65,0x72,0x6e,0x61,0x6d,0x65,0x22,0x20,0x70,
-0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x75,0x73,0x65,0x72,0x6e,0x61,0x6d,0x65,0x22,0x3e,0x0a,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,
-0x77,0x6f,0x72,0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x6f,0x6e,0x65,0x22,0x20,
-0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x6f,0x6e,0x65,0x22,0x20,0x70,0x6c,0x61,0x63,
-0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,
-0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x74,0x77,0x6f,0x22,0x20,0x6e,0x61,0x6d,
-0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x74,0x77,0x6f,0x22,0x20,0x70,0x6c,0x61,0x63,0x65,0x68,0x6f,
-0x6c,0x64,0x65,0x72,0x3d,0x22,0x76,0x65,0x72,0x69,0x66,0x79,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x3e,0x0a,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,
-0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x63,0x72,0x65,0x61,0x74,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,
-0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,
-0x7d,0x0a,
+0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x68,0x65,0x61,0x64,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x6c,0x69,0x6e,
+0x6b,0x20,0x72,0x65,0x6c,0x3d,0x22,0x73,0x74,0x79,0x6c,0x65,0x73,0x68,0x65,0x65,0x74,0x22,0x20,0x68,0x72,0x65,0x66,0x3d,
+0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x73,0x74,0x61,0x74,0x69,0x63,0x2f,
+0x72,0x65,0x73,0x65,0x74,0x2e,0x63,0x73,0x73,0x22,0x20,0x7d,0x7d,0x22,0x3e,0x0a,0x3c,0x6c,0x69,0x6e,0x6b,0x20,0x72,0x65,
+0x6c,0x3d,0x22,0x73,0x74,0x79,0x6c,0x65,0x73,0x68,0x65,0x65,0x74,0x22,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x68,0x74,0x74,
+0x70,0x73,0x3a,0x2f,0x2f,0x63,0x64,0x6e,0x2e,0x6d,0x61,0x74,0x65,0x72,0x69,0x61,0x6c,0x64,0x65,0x73,0x69,0x67,0x6e,0x69,
+0x63,0x6f,0x6e,0x73,0x2e,0x63,0x6f,0x6d,0x2f,0x33,0x2e,0x36,0x2e,0x39,0x35,0x2f,0x63,0x73,0x73,0x2f,0x6d,0x61,0x74,0x65,
+0x72,0x69,0x61,0x6c,0x64,0x65,0x73,0x69,0x67,0x6e,0x69,0x63,0x6f,0x6e,0x73,0x2e,0x6d,0x69,0x6e,0x2e,0x63,0x73,0x73,0x22,
+0x3e,0x0a,0x3c,0x6c,0x69,0x6e,0x6b,0x20,0x72,0x65,0x6c,0x3d,0x22,0x73,0x74,0x79,0x6c,0x65,0x73,0x68,0x65,0x65,0x74,0x22,
+0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x73,
+0x74,0x61,0x74,0x69,0x63,0x2f,0x6d,0x61,0x69,0x6e,0x2e,0x63,0x73,0x73,0x22,0x20,0x7c,0x20,0x6e,0x6f,0x43,0x61,0x63,0x68,
+0x65,0x20,0x7d,0x7d,0x22,0x3e,0x0a,0x3c,0x6c,0x69,0x6e,0x6b,0x20,0x72,0x65,0x6c,0x3d,0x22,0x73,0x68,0x6f,0x72,0x74,0x63,
+0x75,0x74,0x20,0x69,0x63,0x6f,0x6e,0x22,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,
+0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x73,0x74,0x61,0x74,0x69,0x63,0x2f,0x66,0x61,0x76,0x69,0x63,0x6f,0x6e,0x2e,0x69,0x63,
+0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x69,0x6d,0x61,0x67,0x65,0x2f,0x78,0x2d,0x69,0x63,0x6f,
+0x6e,0x22,0x3e,0x0a,0x3c,0x6c,0x69,0x6e,0x6b,0x20,0x72,0x65,0x6c,0x3d,0x22,0x69,0x63,0x6f,0x6e,0x22,0x20,0x68,0x72,0x65,
+0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x73,0x74,0x61,0x74,0x69,
+0x63,0x2f,0x66,0x61,0x76,0x69,0x63,0x6f,0x6e,0x2e,0x69,0x63,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x74,0x79,0x70,0x65,0x3d,
+0x22,0x69,0x6d,0x61,0x67,0x65,0x2f,0x78,0x2d,0x69,0x63,0x6f,0x6e,0x22,0x3e,0x0a,0x3c,0x6d,0x65,0x74,0x61,0x20,0x6e,0x61,
+0x6d,0x65,0x3d,0x22,0x76,0x69,0x65,0x77,0x70,0x6f,0x72,0x74,0x22,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x3d,0x22,0x77,
+0x69,0x64,0x74,0x68,0x3d,0x64,0x65,0x76,0x69,0x63,0x65,0x2d,0x77,0x69,0x64,0x74,0x68,0x2c,0x20,0x69,0x6e,0x69,0x74,0x69,
+0x61,0x6c,0x2d,0x73,0x63,0x61,0x6c,0x65,0x3d,0x31,0x2c,0x20,0x75,0x73,0x65,0x72,0x2d,0x73,0x63,0x61,0x6c,0x61,0x62,0x6c,
+0x65,0x3d,0x6e,0x6f,0x22,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
 }},
-"pages/not_found.tmpl": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
+"layouts/user.tmpl": &EmbeddedAsset{
+	ModTime: time.Unix(1587481623, 0),
 	Bytes: []byte{
 0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x22,0x20,0x7d,0x7d,0x0a,0x3c,
-0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,
-0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x70,0x61,0x67,0x65,0x20,0x6e,0x6f,0x74,0x20,0x66,0x6f,0x75,0x6e,0x64,0x0a,
-0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,
-0x20,0x7d,0x7d,0x0a,
+0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x2d,0x73,0x69,0x64,0x65,0x20,0x74,
+0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x20,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,
+0x20,0x20,0x20,0x77,0x65,0x6c,0x63,0x6f,0x6d,0x65,0x20,0x7b,0x7b,0x20,0x2e,0x55,0x73,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,
+0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x26,0x23,0x31,0x32,0x34,0x3b,0x0a,0x20,0x20,0x20,0x20,0x3c,0x61,0x20,0x68,0x72,
+0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x68,0x6f,0x6d,0x65,
+0x22,0x20,0x7d,0x7d,0x22,0x3e,0x68,0x6f,0x6d,0x65,0x3c,0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x26,0x23,0x31,0x32,0x34,
+0x3b,0x0a,0x20,0x20,0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,
+0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x6c,0x6f,0x67,0x6f,0x75,0x74,0x22,0x20,0x7d,0x7d,0x22,0x3e,0x6c,0x6f,0x67,0x6f,0x75,
+0x74,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x6c,0x6f,0x67,0x6f,
+0x75,0x74,0x2d,0x76,0x61,0x72,0x69,0x61,0x6e,0x74,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x3c,0x2f,0x61,0x3e,0x0a,0x3c,0x2f,0x64,
+0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x74,0x65,0x6d,0x70,0x6c,0x61,0x74,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x2e,
+0x20,0x7d,0x7d,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
 }},
-"static/reset.css": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
+"layouts/base.tmpl": &EmbeddedAsset{
+	ModTime: time.Unix(1587481623, 0),
 	Bytes: []byte{
-0x2f,0x2a,0x20,0x68,0x74,0x74,0x70,0x3a,0x2f,0x2f,0x6d,0x65,0x79,0x65,0x72,0x77,0x65,0x62,0x2e,0x63,0x6f,0x6d,0x2f,0x65,
-0x72,0x69,0x63,0x2f,0x74,0x6f,0x6f,0x6c,0x73,0x2f,0x63,0x73,0x73,0x2f,0x72,0x65,0x73,0x65,0x74,0x2f,0x0a,0x20,0x20,0x20,
-0x76,0x32,0x2e,0x30,0x20,0x7c,0x20,0x32,0x30,0x31,0x31,0x30,0x31,0x32,0x36,0x0a,0x20,0x20,0x20,0x4c,0x69,0x63,0x65,0x6e,
-0x73,0x65,0x3a,0x20,0x6e,0x6f,0x6e,0x65,0x20,0x28,0x70,0x75,0x62,0x6c,0x69,0x63,0x20,0x64,0x6f,0x6d,0x61,0x69,0x6e,0x29,
-0x0a,0x2a,0x2f,0x0a,0x0a,0x68,0x74,0x6d,0x6c,0x2c,0x0a,0x62,0x6f,0x64,0x79,0x2c,0x0a,0x64,0x69,0x76,0x2c,0x0a,0x73,0x70,
-0x61,0x6e,0x2c,0x0a,0x61,0x70,0x70,0x6c,0x65,0x74,0x2c,0x0a,0x6f,0x62,0x6a,0x65,0x63,0x74,0x2c,0x0a,0x69,0x66,0x72,0x61,
-0x6d,0x65,0x2c,0x0a,0x68,0x31,0x2c,0x0a,0x68,0x32,0x2c,0x0a,0x68,0x33,0x2c,0x0a,0x68,0x34,0x2c,0x0a,0x68,0x35,0x2c,0x0a,
-0x68,0x36,0x2c,0x0a,0x70,0x2c,0x0a,0x62,0x6c,0x6f,0x63,0x6b,0x71,0x75,0x6f,0x74,0x65,0x2c,0x0a,0x70,0x72,0x65,0x2c,0x0a,
-0x61,0x2c,0x0a,0x61,0x62,0x62,0x72,0x2c,0x0a,0x61,0x63,0x72,0x6f,0x6e,0x79,0x6d,0x2c,0x0a,0x61,0x64,0x64,0x72,0x65,0x73,
-0x73,0x2c,0x0a,0x62,0x69,0x67,0x2c,0x0a,0x63,0x69,0x74,0x65,0x2c,0x0a,0x63,0x6f,0x64,0x65,0x2c,0x0a,0x64,0x65,0x6c,0x2c,
-0x0a,0x64,0x66,0x6e,0x2c,0x0a,0x65,0x6d,0x2c,0x0a,0x69,0x6d,0x67,0x2c,0x0a,0x69,0x6e,0x73,0x2c,0x0a,0x6b,0x62,0x64,0x2c,
-0x0a,0x71,0x2c,0x0a,0x73,0x2c,0x0a,0x73,0x61,0x6d,0x70,0x2c,0x0a,0x73,0x6d,0x61,0x6c,0x6c,0x2c,0x0a,0x73,0x74,0x72,0x69,
-0x6b,0x65,0x2c,0x0a,0x73,0x74,0x72,0x6f,0x6e,0x67,0x2c,0x0a,0x73,0x75,0x62,0x2c,0x0a,0x73,0x75,0x70,0x2c,0x0a,0x74,0x74,
-0x2c,0x0a,0x76,0x61,0x72,0x2c,0x0a,0x62,0x2c,0x0a,0x75,0x2c,0x0a,0x69,0x2c,0x0a,0x63,0x65,0x6e,0x74,0x65,0x72,0x2c,0x0a,
-0x64,0x6c,0x2c,0x0a,0x64,0x74,0x2c,0x0a,0x64,0x64,0x2c,0x0a,0x6f,0x6c,0x2c,0x0a,0x75,0x6c,0x2c,0x0a,0x6c,0x69,0x2c,0x0a,
-0x66,0x69,0x65,0x6c,0x64,0x73,0x65,0x74,0x2c,0x0a,0x66,0x6f,0x72,0x6d,0x2c,0x0a,0x6c,0x61,0x62,0x65,0x6c,0x2c,0x0a,0x6c,
-0x65,0x67,0x65,0x6e,0x64,0x2c,0x0a,0x74,0x61,0x62,0x6c,0x65,0x2c,0x0a,0x63,0x61,0x70,0x74,0x69,0x6f,0x6e,0x2c,0x0a,0x74,
-0x62,0x6f,0x64,0x79,0x2c,0x0a,0x74,0x66,0x6f,0x6f,0x74,0x2c,0x0a,0x74,0x68,0x65,0x61,0x64,0x2c,0x0a,0x74,0x72,0x2c,0x0a,
-0x74,0x68,0x2c,0x0a,0x74,0x64,0x2c,0x0a,0x61,0x72,0x74,0x69,0x63,0x6c,0x65,0x2c,0x0a,0x61,0x73,0x69,0x64,0x65,0x2c,0x0a,
-0x63,0x61,0x6e,0x76,0x61,0x73,0x2c,0x0a,0x64,0x65,0x74,0x61,0x69,0x6c,0x73,0x2c,0x0a,0x65,0x6d,0x62,0x65,0x64,0x2c,0x0a,
-0x66,0x69,0x67,0x75,0x72,0x65,0x2c,0x0a,0x66,0x69,0x67,0x63,0x61,0x70,0x74,0x69,0x6f,0x6e,0x2c,0x0a,0x66,0x6f,0x6f,0x74,
-0x65,0x72,0x2c,0x0a,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x0a,0x68,0x67,0x72,0x6f,0x75,0x70,0x2c,0x0a,0x6d,0x65,0x6e,0x75,
-0x2c,0x0a,0x6e,0x61,0x76,0x2c,0x0a,0x6f,0x75,0x74,0x70,0x75,0x74,0x2c,0x0a,0x72,0x75,0x62,0x79,0x2c,0x0a,0x73,0x65,0x63,
-0x74,0x69,0x6f,0x6e,0x2c,0x0a,0x73,0x75,0x6d,0x6d,0x61,0x72,0x79,0x2c,0x0a,0x74,0x69,0x6d,0x65,0x2c,0x0a,0x6d,0x61,0x72,
-0x6b,0x2c,0x0a,0x61,0x75,0x64,0x69,0x6f,0x2c,0x0a,0x76,0x69,0x64,0x65,0x6f,0x20,0x7b,0x0a,0x20,0x20,0x6d,0x61,0x72,0x67,
-0x69,0x6e,0x3a,0x20,0x30,0x3b,0x0a,0x20,0x20,0x70,0x61,0x64,0x64,0x69,0x6e,0x67,0x3a,0x20,0x30,0x3b,0x0a,0x20,0x20,0x62,
-0x6f,0x72,0x64,0x65,0x72,0x3a,0x20,0x30,0x3b,0x0a,0x20,0x20,0x66,0x6f,0x6e,0x74,0x2d,0x73,0x69,0x7a,0x65,0x3a,0x20,0x31,
-0x30,0x30,0x25,0x3b,0x0a,0x20,0x20,0x66,0x6f,0x6e,0x74,0x3a,0x20,0x69,0x6e,0x68,0x65,0x72,0x69,0x74,0x3b,0x0a,0x20,0x20,
-0x76,0x65,0x72,0x74,0x69,0x63,0x61,0x6c,0x2d,0x61,0x6c,0x69,0x67,0x6e,0x3a,0x20,0x62,0x61,0x73,0x65,0x6c,0x69,0x6e,0x65,
-0x3b,0x0a,0x7d,0x0a,0x0a,0x2f,0x2a,0x20,0x68,0x74,0x6d,0x6c,0x35,0x20,0x64,0x69,0x73,0x70,0x6c,0x61,0x79,0x2d,0x72,0x6f,
-0x6c,0x65,0x20,0x72,0x65,0x73,0x65,0x74,0x20,0x66,0x6f,0x72,0x20,0x6f,0x6c,0x64,0x65,0x72,0x20,0x62,0x72,0x6f,0x77,0x73,
-0x65,0x72,0x73,0x20,0x2a,0x2f,0x0a,0x61,0x72,0x74,0x69,0x63,0x6c,0x65,0x2c,0x0a,0x61,0x73,0x69,0x64,0x65,0x2c,0x0a,0x64,
-0x65,0x74,0x61,0x69,0x6c,0x73,0x2c,0x0a,0x66,0x69,0x67,0x63,0x61,0x70,0x74,0x69,0x6f,0x6e,0x2c,0x0a,0x66,0x69,0x67,0x75,
-0x72,0x65,0x2c,0x0a,0x66,0x6f,0x6f,0x74,0x65,0x72,0x2c,0x0a,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x0a,0x68,0x67,0x72,0x6f,
-0x75,0x70,0x2c,0x0a,0x6d,0x65,0x6e,0x75,0x2c,0x0a,0x6e,0x61,0x76,0x2c,0x0a,0x73,0x65,0x63,0x74,0x69,0x6f,0x6e,0x20,0x7b,
-0x0a,0x20,0x20,0x64,0x69,0x73,0x70,0x6c,0x61,0x79,0x3a,0x20,0x62,0x6c,0x6f,0x63,0x6b,0x3b,0x0a,0x7d,0x0a,0x0a,0x62,0x6f,
-0x64,0x79,0x20,0x7b,0x0a,0x20,0x20,0x6c,0x69,0x6e,0x65,0x2d,0x68,0x65,0x69,0x67,0x68,0x74,0x3a,0x20,0x31,0x3b,0x0a,0x7d,
-0x0a,0x0a,0x6f,0x6c,0x2c,0x0a,0x75,0x6c,0x20,0x7b,0x0a,0x20,0x20,0x6c,0x69,0x73,0x74,0x2d,0x73,0x74,0x79,0x6c,0x65,0x3a,
-0x20,0x6e,0x6f,0x6e,0x65,0x3b,0x0a,0x7d,0x0a,0x0a,0x62,0x6c,0x6f,0x63,0x6b,0x71,0x75,0x6f,0x74,0x65,0x2c,0x0a,0x71,0x20,
-0x7b,0x0a,0x20,0x20,0x71,0x75,0x6f,0x74,0x65,0x73,0x3a,0x20,0x6e,0x6f,0x6e,0x65,0x3b,0x0a,0x7d,0x0a,0x0a,0x62,0x6c,0x6f,
-0x63,0x6b,0x71,0x75,0x6f,0x74,0x65,0x3a,0x62,0x65,0x66,0x6f,0x72,0x65,0x2c,0x0a,0x62,0x6c,0x6f,0x63,0x6b,0x71,0x75,0x6f,
-0x74,0x65,0x3a,0x61,0x66,0x74,0x65,0x72,0x2c,0x0a,0x71,0x3a,0x62,0x65,0x66,0x6f,0x72,0x65,0x2c,0x0a,0x71,0x3a,0x61,0x66,
-0x74,0x65,0x72,0x20,0x7b,0x0a,0x20,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x3a,0x20,0x22,0x22,0x3b,0x0a,0x20,0x20,0x63,
-0x6f,0x6e,0x74,0x65,0x6e,0x74,0x3a,0x20,0x6e,0x6f,0x6e,0x65,0x3b,0x0a,0x7d,0x0a,0x0a,0x74,0x61,0x62,0x6c,0x65,0x20,0x7b,
-0x0a,0x20,0x20,0x62,0x6f,0x72,0x64,0x65,0x72,0x2d,0x63,0x6f,0x6c,0x6c,0x61,0x70,0x73,0x65,0x3a,0x20,0x63,0x6f,0x6c,0x6c,
-0x61,0x70,0x73,0x65,0x3b,0x0a,0x20,0x20,0x62,0x6f,0x72,0x64,0x65,0x72,0x2d,0x73,0x70,0x61,0x63,0x69,0x6e,0x67,0x3a,0x20,
-0x30,0x3b,0x0a,0x7d,0x0a,
+0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x6c,0x61,0x79,0x6f,0x75,0x74,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x21,
+0x64,0x6f,0x63,0x74,0x79,0x70,0x65,0x20,0x68,0x74,0x6d,0x6c,0x3e,0x0a,0x3c,0x68,0x74,0x6d,0x6c,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x3c,0x68,0x65,0x61,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x6d,0x65,0x74,0x61,0x20,0x63,0x68,
+0x61,0x72,0x73,0x65,0x74,0x3d,0x22,0x75,0x74,0x66,0x2d,0x38,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
+0x74,0x69,0x74,0x6c,0x65,0x3e,0x67,0x6f,0x6e,0x69,0x63,0x3c,0x2f,0x74,0x69,0x74,0x6c,0x65,0x3e,0x0a,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x74,0x65,0x6d,0x70,0x6c,0x61,0x74,0x65,0x20,0x22,0x68,0x65,0x61,0x64,0x22,0x20,0x7d,
+0x7d,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x68,0x65,0x61,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x62,0x6f,0x64,0x79,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x69,0x64,0x3d,0x22,0x63,0x6f,0x6e,0x74,0x65,0x6e,
+0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x69,0x64,0x3d,
+0x22,0x68,0x65,0x61,0x64,0x65,0x72,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,
+0x69,0x6e,0x2f,0x68,0x6f,0x6d,0x65,0x22,0x20,0x7d,0x7d,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6d,0x67,0x20,0x73,0x72,0x63,0x3d,0x22,0x7b,0x7b,0x20,0x70,
+0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x73,0x74,0x61,0x74,0x69,0x63,0x2f,0x67,0x6f,0x6e,0x69,0x63,
+0x2e,0x70,0x6e,0x67,0x22,0x20,0x7d,0x7d,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x3c,0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,
+0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,
+0x24,0x66,0x6c,0x61,0x73,0x68,0x20,0x3a,0x3d,0x20,0x2e,0x46,0x6c,0x61,0x73,0x68,0x65,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,
+0x64,0x64,0x65,0x64,0x20,0x66,0x6c,0x61,0x73,0x68,0x2d,0x7b,0x7b,0x20,0x24,0x66,0x6c,0x61,0x73,0x68,0x2e,0x54,0x79,0x70,
+0x65,0x20,0x7d,0x7d,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
+0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x61,0x6c,0x65,0x72,0x74,0x2d,0x63,
+0x69,0x72,0x63,0x6c,0x65,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x7b,0x7b,0x20,0x24,0x66,0x6c,0x61,0x73,0x68,0x2e,0x4d,0x65,
+0x73,0x73,0x61,0x67,0x65,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,
+0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,
+0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x74,0x65,0x6d,0x70,0x6c,0x61,0x74,
+0x65,0x20,0x22,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x22,0x20,0x2e,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x2d,
+0x73,0x69,0x64,0x65,0x20,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,
+0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x7b,0x7b,0x20,0x2e,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x20,0x7d,
+0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x73,0x65,0x6e,0x61,0x6e,0x20,0x6b,0x65,0x6c,0x6c,0x79,0x2c,0x20,0x32,0x30,0x32,0x30,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,
+0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x26,0x23,0x31,0x32,0x34,0x3b,0x3c,0x2f,0x73,0x70,0x61,0x6e,
+0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,
+0x66,0x3d,0x22,0x68,0x74,0x74,0x70,0x73,0x3a,0x2f,0x2f,0x73,0x65,0x6e,0x61,0x6e,0x2e,0x78,0x79,0x7a,0x2f,0x67,0x2f,0x67,
+0x6f,0x6e,0x69,0x63,0x22,0x3e,0x67,0x69,0x74,0x68,0x75,0x62,0x3c,0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,
+0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x62,0x6f,0x64,0x79,0x3e,0x0a,0x3c,0x2f,0x68,0x74,0x6d,0x6c,0x3e,0x0a,0x7b,
+0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
 }},
 "static/favicon.ico": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
+	ModTime: time.Unix(1587481623, 0),
 	Bytes: []byte{
 0x00,0x00,0x01,0x00,0x01,0x00,0x20,0x20,0x00,0x00,0x01,0x00,0x20,0x00,0xa8,0x10,0x00,0x00,0x16,0x00,0x00,0x00,0x28,0x00,
 0x00,0x00,0x20,0x00,0x00,0x00,0x40,0x00,0x00,0x00,0x01,0x00,0x20,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,
@@ -753,7 +281,7 @@ var Bytes = map[string]*EmbeddedAsset{
 0x00,0x00,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,
 }},
 "static/gonic.png": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
+	ModTime: time.Unix(1587481623, 0),
 	Bytes: []byte{
 0x89,0x50,0x4e,0x47,0x0d,0x0a,0x1a,0x0a,0x00,0x00,0x00,0x0d,0x49,0x48,0x44,0x52,0x00,0x00,0x0e,0x8c,0x00,0x00,0x03,0xbd,
 0x08,0x06,0x00,0x00,0x00,0x02,0x78,0xa4,0xad,0x00,0x00,0x0c,0x4c,0x7a,0x54,0x58,0x74,0x52,0x61,0x77,0x20,0x70,0x72,0x6f,
@@ -10828,7 +10356,7 @@ var Bytes = map[string]*EmbeddedAsset{
 0x55,0x23,0xfe,0x00,0x00,0x00,0x00,0x49,0x45,0x4e,0x44,0xae,0x42,0x60,0x82,
 }},
 "static/main.css": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
+	ModTime: time.Unix(1587481623, 0),
 	Bytes: []byte{
 0x3a,0x72,0x6f,0x6f,0x74,0x20,0x7b,0x0a,0x20,0x20,0x2d,0x2d,0x73,0x69,0x7a,0x65,0x3a,0x20,0x31,0x33,0x70,0x78,0x3b,0x0a,
 0x20,0x20,0x2d,0x2d,0x77,0x69,0x64,0x74,0x68,0x2d,0x62,0x6f,0x64,0x79,0x3a,0x20,0x37,0x35,0x30,0x70,0x78,0x3b,0x0a,0x20,
@@ -10924,93 +10452,1023 @@ var Bytes = map[string]*EmbeddedAsset{
 0x0a,0x7d,0x0a,0x0a,0x2e,0x61,0x6e,0x67,0x72,0x79,0x20,0x7b,0x0a,0x20,0x20,0x62,0x61,0x63,0x6b,0x67,0x72,0x6f,0x75,0x6e,
 0x64,0x2d,0x63,0x6f,0x6c,0x6f,0x72,0x3a,0x20,0x23,0x66,0x34,0x34,0x33,0x33,0x36,0x36,0x39,0x3b,0x0a,0x7d,0x0a,
 }},
-"partials/head.tmpl": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
+"static/reset.css": &EmbeddedAsset{
+	ModTime: time.Unix(1587481623, 0),
+	Bytes: []byte{
+0x2f,0x2a,0x20,0x68,0x74,0x74,0x70,0x3a,0x2f,0x2f,0x6d,0x65,0x79,0x65,0x72,0x77,0x65,0x62,0x2e,0x63,0x6f,0x6d,0x2f,0x65,
+0x72,0x69,0x63,0x2f,0x74,0x6f,0x6f,0x6c,0x73,0x2f,0x63,0x73,0x73,0x2f,0x72,0x65,0x73,0x65,0x74,0x2f,0x0a,0x20,0x20,0x20,
+0x76,0x32,0x2e,0x30,0x20,0x7c,0x20,0x32,0x30,0x31,0x31,0x30,0x31,0x32,0x36,0x0a,0x20,0x20,0x20,0x4c,0x69,0x63,0x65,0x6e,
+0x73,0x65,0x3a,0x20,0x6e,0x6f,0x6e,0x65,0x20,0x28,0x70,0x75,0x62,0x6c,0x69,0x63,0x20,0x64,0x6f,0x6d,0x61,0x69,0x6e,0x29,
+0x0a,0x2a,0x2f,0x0a,0x0a,0x68,0x74,0x6d,0x6c,0x2c,0x0a,0x62,0x6f,0x64,0x79,0x2c,0x0a,0x64,0x69,0x76,0x2c,0x0a,0x73,0x70,
+0x61,0x6e,0x2c,0x0a,0x61,0x70,0x70,0x6c,0x65,0x74,0x2c,0x0a,0x6f,0x62,0x6a,0x65,0x63,0x74,0x2c,0x0a,0x69,0x66,0x72,0x61,
+0x6d,0x65,0x2c,0x0a,0x68,0x31,0x2c,0x0a,0x68,0x32,0x2c,0x0a,0x68,0x33,0x2c,0x0a,0x68,0x34,0x2c,0x0a,0x68,0x35,0x2c,0x0a,
+0x68,0x36,0x2c,0x0a,0x70,0x2c,0x0a,0x62,0x6c,0x6f,0x63,0x6b,0x71,0x75,0x6f,0x74,0x65,0x2c,0x0a,0x70,0x72,0x65,0x2c,0x0a,
+0x61,0x2c,0x0a,0x61,0x62,0x62,0x72,0x2c,0x0a,0x61,0x63,0x72,0x6f,0x6e,0x79,0x6d,0x2c,0x0a,0x61,0x64,0x64,0x72,0x65,0x73,
+0x73,0x2c,0x0a,0x62,0x69,0x67,0x2c,0x0a,0x63,0x69,0x74,0x65,0x2c,0x0a,0x63,0x6f,0x64,0x65,0x2c,0x0a,0x64,0x65,0x6c,0x2c,
+0x0a,0x64,0x66,0x6e,0x2c,0x0a,0x65,0x6d,0x2c,0x0a,0x69,0x6d,0x67,0x2c,0x0a,0x69,0x6e,0x73,0x2c,0x0a,0x6b,0x62,0x64,0x2c,
+0x0a,0x71,0x2c,0x0a,0x73,0x2c,0x0a,0x73,0x61,0x6d,0x70,0x2c,0x0a,0x73,0x6d,0x61,0x6c,0x6c,0x2c,0x0a,0x73,0x74,0x72,0x69,
+0x6b,0x65,0x2c,0x0a,0x73,0x74,0x72,0x6f,0x6e,0x67,0x2c,0x0a,0x73,0x75,0x62,0x2c,0x0a,0x73,0x75,0x70,0x2c,0x0a,0x74,0x74,
+0x2c,0x0a,0x76,0x61,0x72,0x2c,0x0a,0x62,0x2c,0x0a,0x75,0x2c,0x0a,0x69,0x2c,0x0a,0x63,0x65,0x6e,0x74,0x65,0x72,0x2c,0x0a,
+0x64,0x6c,0x2c,0x0a,0x64,0x74,0x2c,0x0a,0x64,0x64,0x2c,0x0a,0x6f,0x6c,0x2c,0x0a,0x75,0x6c,0x2c,0x0a,0x6c,0x69,0x2c,0x0a,
+0x66,0x69,0x65,0x6c,0x64,0x73,0x65,0x74,0x2c,0x0a,0x66,0x6f,0x72,0x6d,0x2c,0x0a,0x6c,0x61,0x62,0x65,0x6c,0x2c,0x0a,0x6c,
+0x65,0x67,0x65,0x6e,0x64,0x2c,0x0a,0x74,0x61,0x62,0x6c,0x65,0x2c,0x0a,0x63,0x61,0x70,0x74,0x69,0x6f,0x6e,0x2c,0x0a,0x74,
+0x62,0x6f,0x64,0x79,0x2c,0x0a,0x74,0x66,0x6f,0x6f,0x74,0x2c,0x0a,0x74,0x68,0x65,0x61,0x64,0x2c,0x0a,0x74,0x72,0x2c,0x0a,
+0x74,0x68,0x2c,0x0a,0x74,0x64,0x2c,0x0a,0x61,0x72,0x74,0x69,0x63,0x6c,0x65,0x2c,0x0a,0x61,0x73,0x69,0x64,0x65,0x2c,0x0a,
+0x63,0x61,0x6e,0x76,0x61,0x73,0x2c,0x0a,0x64,0x65,0x74,0x61,0x69,0x6c,0x73,0x2c,0x0a,0x65,0x6d,0x62,0x65,0x64,0x2c,0x0a,
+0x66,0x69,0x67,0x75,0x72,0x65,0x2c,0x0a,0x66,0x69,0x67,0x63,0x61,0x70,0x74,0x69,0x6f,0x6e,0x2c,0x0a,0x66,0x6f,0x6f,0x74,
+0x65,0x72,0x2c,0x0a,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x0a,0x68,0x67,0x72,0x6f,0x75,0x70,0x2c,0x0a,0x6d,0x65,0x6e,0x75,
+0x2c,0x0a,0x6e,0x61,0x76,0x2c,0x0a,0x6f,0x75,0x74,0x70,0x75,0x74,0x2c,0x0a,0x72,0x75,0x62,0x79,0x2c,0x0a,0x73,0x65,0x63,
+0x74,0x69,0x6f,0x6e,0x2c,0x0a,0x73,0x75,0x6d,0x6d,0x61,0x72,0x79,0x2c,0x0a,0x74,0x69,0x6d,0x65,0x2c,0x0a,0x6d,0x61,0x72,
+0x6b,0x2c,0x0a,0x61,0x75,0x64,0x69,0x6f,0x2c,0x0a,0x76,0x69,0x64,0x65,0x6f,0x20,0x7b,0x0a,0x20,0x20,0x6d,0x61,0x72,0x67,
+0x69,0x6e,0x3a,0x20,0x30,0x3b,0x0a,0x20,0x20,0x70,0x61,0x64,0x64,0x69,0x6e,0x67,0x3a,0x20,0x30,0x3b,0x0a,0x20,0x20,0x62,
+0x6f,0x72,0x64,0x65,0x72,0x3a,0x20,0x30,0x3b,0x0a,0x20,0x20,0x66,0x6f,0x6e,0x74,0x2d,0x73,0x69,0x7a,0x65,0x3a,0x20,0x31,
+0x30,0x30,0x25,0x3b,0x0a,0x20,0x20,0x66,0x6f,0x6e,0x74,0x3a,0x20,0x69,0x6e,0x68,0x65,0x72,0x69,0x74,0x3b,0x0a,0x20,0x20,
+0x76,0x65,0x72,0x74,0x69,0x63,0x61,0x6c,0x2d,0x61,0x6c,0x69,0x67,0x6e,0x3a,0x20,0x62,0x61,0x73,0x65,0x6c,0x69,0x6e,0x65,
+0x3b,0x0a,0x7d,0x0a,0x0a,0x2f,0x2a,0x20,0x68,0x74,0x6d,0x6c,0x35,0x20,0x64,0x69,0x73,0x70,0x6c,0x61,0x79,0x2d,0x72,0x6f,
+0x6c,0x65,0x20,0x72,0x65,0x73,0x65,0x74,0x20,0x66,0x6f,0x72,0x20,0x6f,0x6c,0x64,0x65,0x72,0x20,0x62,0x72,0x6f,0x77,0x73,
+0x65,0x72,0x73,0x20,0x2a,0x2f,0x0a,0x61,0x72,0x74,0x69,0x63,0x6c,0x65,0x2c,0x0a,0x61,0x73,0x69,0x64,0x65,0x2c,0x0a,0x64,
+0x65,0x74,0x61,0x69,0x6c,0x73,0x2c,0x0a,0x66,0x69,0x67,0x63,0x61,0x70,0x74,0x69,0x6f,0x6e,0x2c,0x0a,0x66,0x69,0x67,0x75,
+0x72,0x65,0x2c,0x0a,0x66,0x6f,0x6f,0x74,0x65,0x72,0x2c,0x0a,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x0a,0x68,0x67,0x72,0x6f,
+0x75,0x70,0x2c,0x0a,0x6d,0x65,0x6e,0x75,0x2c,0x0a,0x6e,0x61,0x76,0x2c,0x0a,0x73,0x65,0x63,0x74,0x69,0x6f,0x6e,0x20,0x7b,
+0x0a,0x20,0x20,0x64,0x69,0x73,0x70,0x6c,0x61,0x79,0x3a,0x20,0x62,0x6c,0x6f,0x63,0x6b,0x3b,0x0a,0x7d,0x0a,0x0a,0x62,0x6f,
+0x64,0x79,0x20,0x7b,0x0a,0x20,0x20,0x6c,0x69,0x6e,0x65,0x2d,0x68,0x65,0x69,0x67,0x68,0x74,0x3a,0x20,0x31,0x3b,0x0a,0x7d,
+0x0a,0x0a,0x6f,0x6c,0x2c,0x0a,0x75,0x6c,0x20,0x7b,0x0a,0x20,0x20,0x6c,0x69,0x73,0x74,0x2d,0x73,0x74,0x79,0x6c,0x65,0x3a,
+0x20,0x6e,0x6f,0x6e,0x65,0x3b,0x0a,0x7d,0x0a,0x0a,0x62,0x6c,0x6f,0x63,0x6b,0x71,0x75,0x6f,0x74,0x65,0x2c,0x0a,0x71,0x20,
+0x7b,0x0a,0x20,0x20,0x71,0x75,0x6f,0x74,0x65,0x73,0x3a,0x20,0x6e,0x6f,0x6e,0x65,0x3b,0x0a,0x7d,0x0a,0x0a,0x62,0x6c,0x6f,
+0x63,0x6b,0x71,0x75,0x6f,0x74,0x65,0x3a,0x62,0x65,0x66,0x6f,0x72,0x65,0x2c,0x0a,0x62,0x6c,0x6f,0x63,0x6b,0x71,0x75,0x6f,
+0x74,0x65,0x3a,0x61,0x66,0x74,0x65,0x72,0x2c,0x0a,0x71,0x3a,0x62,0x65,0x66,0x6f,0x72,0x65,0x2c,0x0a,0x71,0x3a,0x61,0x66,
+0x74,0x65,0x72,0x20,0x7b,0x0a,0x20,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x3a,0x20,0x22,0x22,0x3b,0x0a,0x20,0x20,0x63,
+0x6f,0x6e,0x74,0x65,0x6e,0x74,0x3a,0x20,0x6e,0x6f,0x6e,0x65,0x3b,0x0a,0x7d,0x0a,0x0a,0x74,0x61,0x62,0x6c,0x65,0x20,0x7b,
+0x0a,0x20,0x20,0x62,0x6f,0x72,0x64,0x65,0x72,0x2d,0x63,0x6f,0x6c,0x6c,0x61,0x70,0x73,0x65,0x3a,0x20,0x63,0x6f,0x6c,0x6c,
+0x61,0x70,0x73,0x65,0x3b,0x0a,0x20,0x20,0x62,0x6f,0x72,0x64,0x65,0x72,0x2d,0x73,0x70,0x61,0x63,0x69,0x6e,0x67,0x3a,0x20,
+0x30,0x3b,0x0a,0x7d,0x0a,
+}},
+"pages/not_found.tmpl": &EmbeddedAsset{
+	ModTime: time.Unix(1587481623, 0),
+	Bytes: []byte{
+0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x22,0x20,0x7d,0x7d,0x0a,0x3c,
+0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,
+0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x70,0x61,0x67,0x65,0x20,0x6e,0x6f,0x74,0x20,0x66,0x6f,0x75,0x6e,0x64,0x0a,
+0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,
+0x20,0x7d,0x7d,0x0a,
+}},
+"pages/login.tmpl": &EmbeddedAsset{
+	ModTime: time.Unix(1587481623, 0),
+	Bytes: []byte{
+0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x22,0x20,0x7d,0x7d,0x0a,0x3c,
+0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,
+0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,
+0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,
+0x69,0x20,0x6d,0x64,0x69,0x2d,0x6c,0x6f,0x67,0x69,0x6e,0x2d,0x76,0x61,0x72,0x69,0x61,0x6e,0x74,0x22,0x3e,0x3c,0x2f,0x69,
+0x3e,0x20,0x6c,0x6f,0x67,0x69,0x6e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,
+0x66,0x6f,0x72,0x6d,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,
+0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x6c,0x6f,0x67,0x69,0x6e,
+0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x74,0x65,0x78,
+0x74,0x22,0x20,0x69,0x64,0x3d,0x22,0x75,0x73,0x65,0x72,0x6e,0x61,0x6d,0x65,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x75,
+0x73,0x65,0x72,0x6e,0x61,0x6d,0x65,0x22,0x20,0x70,0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x75,0x73,
+0x65,0x72,0x6e,0x61,0x6d,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,
+0x74,0x79,0x70,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,
+0x77,0x6f,0x72,0x64,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x20,0x70,0x6c,
+0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x3e,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,
+0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x6c,0x6f,0x67,0x69,0x6e,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,
+0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
+}},
+"pages/delete_user.tmpl": &EmbeddedAsset{
+	ModTime: time.Unix(1587481623, 0),
+	Bytes: []byte{
+0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,
+0x64,0x69,0x2d,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,0x2d,0x72,0x65,0x6d,0x6f,0x76,0x65,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,
+0x64,0x65,0x6c,0x65,0x74,0x69,0x6e,0x67,0x20,0x75,0x73,0x65,0x72,0x20,0x7b,0x7b,0x20,0x2e,0x53,0x65,0x6c,0x65,0x63,0x74,
+0x65,0x64,0x55,0x73,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,
+0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x72,
+0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x61,0x72,0x65,0x20,0x79,0x6f,0x75,0x20,0x73,
+0x75,0x72,0x65,0x3f,0x3c,0x62,0x72,0x2f,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,
+0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x74,0x68,0x65,0x69,0x72,
+0x20,0x70,0x6c,0x61,0x79,0x73,0x2c,0x20,0x73,0x74,0x61,0x72,0x72,0x65,0x64,0x2c,0x20,0x65,0x74,0x63,0x2e,0x20,0x77,0x69,
+0x6c,0x6c,0x20,0x61,0x6c,0x73,0x6f,0x20,0x62,0x65,0x20,0x64,0x65,0x6c,0x65,0x74,0x65,0x64,0x3c,0x2f,0x73,0x70,0x61,0x6e,
+0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x63,
+0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,
+0x70,0x72,0x69,0x6e,0x74,0x66,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x64,0x65,0x6c,0x65,0x74,0x65,0x5f,0x75,0x73,
+0x65,0x72,0x5f,0x64,0x6f,0x3f,0x75,0x73,0x65,0x72,0x3d,0x25,0x73,0x22,0x20,0x2e,0x53,0x65,0x6c,0x65,0x63,0x74,0x65,0x64,
+0x55,0x73,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7c,0x20,0x70,0x61,0x74,0x68,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,
+0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,
+0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,
+0x79,0x65,0x73,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,
+0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
+}},
+"pages/home.tmpl": &EmbeddedAsset{
+	ModTime: time.Unix(1792325188, 0),
+	Bytes: []byte{
+0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,
+0x64,0x69,0x2d,0x63,0x68,0x61,0x72,0x74,0x2d,0x61,0x72,0x63,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x73,0x74,0x61,0x74,0x73,
+0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,
+0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x69,0x64,0x3d,0x22,0x73,0x74,0x61,0x74,0x73,0x22,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x3c,0x74,0x64,0x3e,0x61,0x72,0x74,0x69,0x73,0x74,0x73,0x3a,0x3c,0x2f,0x74,0x64,0x3e,0x20,0x3c,0x74,0x64,0x3e,0x7b,
+0x7b,0x20,0x2e,0x41,0x72,0x74,0x69,0x73,0x74,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x3c,0x74,0x64,0x3e,0x61,0x6c,0x62,0x75,0x6d,0x73,0x3a,0x3c,0x2f,0x74,0x64,0x3e,0x20,0x3c,0x74,0x64,0x3e,0x7b,
+0x7b,0x20,0x2e,0x41,0x6c,0x62,0x75,0x6d,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x3c,0x74,0x64,0x3e,0x74,0x72,0x61,0x63,0x6b,0x73,0x3a,0x3c,0x2f,0x74,0x64,0x3e,0x20,0x3c,0x74,0x64,0x3e,0x7b,0x7b,
+0x20,0x2e,0x54,0x72,0x61,0x63,0x6b,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
+0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,
+0x3e,0x0a,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,
+0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,
+0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,
+0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x6c,0x61,0x73,0x74,0x66,0x6d,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x6c,0x61,
+0x73,0x74,0x2e,0x66,0x6d,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,
+0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x64,0x65,0x73,0x63,0x72,0x69,0x70,0x74,0x69,0x6f,0x6e,
+0x20,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,
+0x3e,0x67,0x6f,0x6e,0x69,0x63,0x20,0x63,0x61,0x6e,0x20,0x73,0x63,0x72,0x6f,0x62,0x62,0x6c,0x65,0x20,0x74,0x6f,0x20,0x3c,
+0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x68,0x74,0x74,0x70,0x73,0x3a,0x2f,0x2f,0x77,0x77,0x77,0x2e,0x6c,0x61,0x73,0x74,
+0x2e,0x66,0x6d,0x2f,0x22,0x20,0x74,0x61,0x72,0x67,0x65,0x74,0x3d,0x22,0x5f,0x62,0x6c,0x61,0x6e,0x6b,0x22,0x3e,0x6c,0x61,
+0x73,0x74,0x2e,0x66,0x6d,0x3c,0x2f,0x61,0x3e,0x20,0x66,0x6f,0x72,0x20,0x61,0x6e,0x79,0x20,0x75,0x73,0x65,0x72,0x20,0x28,
+0x62,0x75,0x74,0x20,0x74,0x68,0x65,0x20,0x61,0x64,0x6d,0x69,0x6e,0x20,0x6d,0x75,0x73,0x74,0x20,0x73,0x65,0x74,0x20,0x61,
+0x20,0x67,0x6c,0x6f,0x62,0x61,0x6c,0x20,0x61,0x70,0x69,0x20,0x6b,0x65,0x79,0x29,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,
+0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,
+0x69,0x66,0x20,0x2e,0x43,0x75,0x72,0x72,0x65,0x6e,0x74,0x4c,0x61,0x73,0x74,0x46,0x4d,0x41,0x50,0x49,0x4b,0x65,0x79,0x20,
+0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,
+0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x63,0x75,0x72,0x72,0x65,0x6e,0x74,0x20,
+0x73,0x74,0x61,0x74,0x75,0x73,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,0x55,0x73,0x65,0x72,0x2e,0x4c,0x61,0x73,0x74,0x46,0x4d,0x53,0x65,0x73,0x73,
+0x69,0x6f,0x6e,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x6c,
+0x69,0x6e,0x6b,0x65,0x64,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,
+0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x26,
+0x23,0x31,0x32,0x34,0x3b,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,
+0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x75,0x6e,0x6c,0x69,0x6e,0x6b,0x5f,0x6c,0x61,0x73,0x74,0x66,0x6d,
+0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,
+0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,
+0x75,0x6e,0x6c,0x69,0x6e,0x6b,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,
+0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x61,0x6e,0x67,0x72,0x79,0x22,0x3e,0x75,0x6e,0x6c,0x69,
+0x6e,0x6b,0x65,0x64,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x24,0x63,0x62,0x50,0x61,0x74,0x68,0x20,0x3a,0x3d,0x20,0x70,0x61,0x74,0x68,0x20,0x22,
+0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x6c,0x69,0x6e,0x6b,0x5f,0x6c,0x61,0x73,0x74,0x66,0x6d,0x5f,0x64,0x6f,0x22,0x20,0x7d,
+0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x24,0x63,0x62,
+0x55,0x52,0x4c,0x20,0x3a,0x3d,0x20,0x70,0x72,0x69,0x6e,0x74,0x66,0x20,0x22,0x25,0x73,0x25,0x73,0x22,0x20,0x2e,0x52,0x65,
+0x71,0x75,0x65,0x73,0x74,0x52,0x6f,0x6f,0x74,0x20,0x24,0x63,0x62,0x50,0x61,0x74,0x68,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x68,0x74,
+0x74,0x70,0x73,0x3a,0x2f,0x2f,0x77,0x77,0x77,0x2e,0x6c,0x61,0x73,0x74,0x2e,0x66,0x6d,0x2f,0x61,0x70,0x69,0x2f,0x61,0x75,
+0x74,0x68,0x2f,0x3f,0x61,0x70,0x69,0x5f,0x6b,0x65,0x79,0x3d,0x7b,0x7b,0x20,0x2e,0x43,0x75,0x72,0x72,0x65,0x6e,0x74,0x4c,
+0x61,0x73,0x74,0x46,0x4d,0x41,0x50,0x49,0x4b,0x65,0x79,0x20,0x7d,0x7d,0x26,0x63,0x62,0x3d,0x7b,0x7b,0x20,0x24,0x63,0x62,
+0x55,0x52,0x4c,0x20,0x7d,0x7d,0x22,0x3e,0x6c,0x69,0x6e,0x6b,0x26,0x23,0x38,0x32,0x33,0x30,0x3b,0x3c,0x2f,0x61,0x3e,0x0a,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,
+0x74,0x22,0x3e,0x61,0x70,0x69,0x20,0x6b,0x65,0x79,0x20,0x6e,0x6f,0x74,0x20,0x73,0x65,0x74,0x3c,0x2f,0x70,0x3e,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x6e,0x6f,0x74,0x20,0x2e,0x55,0x73,
+0x65,0x72,0x2e,0x49,0x73,0x41,0x64,0x6d,0x69,0x6e,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,
+0x68,0x74,0x22,0x3e,0x70,0x6c,0x65,0x61,0x73,0x65,0x20,0x61,0x73,0x6b,0x20,0x79,0x6f,0x75,0x72,0x20,0x61,0x64,0x6d,0x69,
+0x6e,0x20,0x74,0x6f,0x20,0x73,0x65,0x74,0x20,0x69,0x74,0x3c,0x2f,0x70,0x3e,0x0a,0x09,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,
+0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,0x55,0x73,0x65,0x72,0x2e,0x49,0x73,0x41,
+0x64,0x6d,0x69,0x6e,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x3c,
+0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,
+0x75,0x70,0x64,0x61,0x74,0x65,0x5f,0x6c,0x61,0x73,0x74,0x66,0x6d,0x5f,0x61,0x70,0x69,0x5f,0x6b,0x65,0x79,0x22,0x20,0x7d,
+0x7d,0x22,0x3e,0x75,0x70,0x64,0x61,0x74,0x65,0x20,0x61,0x70,0x69,0x20,0x6b,0x65,0x79,0x26,0x23,0x38,0x32,0x33,0x30,0x3b,
+0x3c,0x2f,0x61,0x3e,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,
+0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x64,0x69,
+0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,
+0x20,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,0x55,0x73,0x65,0x72,0x2e,0x49,0x73,0x41,0x64,0x6d,0x69,0x6e,0x20,0x7d,0x7d,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2f,0x2a,0x20,0x61,0x64,0x6d,0x69,0x6e,0x20,0x70,0x61,0x6e,0x65,
+0x6c,0x20,0x74,0x6f,0x20,0x6d,0x61,0x6e,0x61,0x67,0x65,0x20,0x61,0x6c,0x6c,0x20,0x75,0x73,0x65,0x72,0x73,0x20,0x2a,0x2f,
+0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,
+0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
+0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,
+0x2d,0x6d,0x75,0x6c,0x74,0x69,0x70,0x6c,0x65,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x75,0x73,0x65,0x72,0x73,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,
+0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x24,0x75,0x73,0x65,0x72,0x20,0x3a,0x3d,0x20,
+0x2e,0x41,0x6c,0x6c,0x55,0x73,0x65,0x72,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x3c,0x69,0x3e,0x7b,0x7b,0x20,0x24,0x75,0x73,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x69,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x20,0x6e,0x6f,0x2d,0x73,0x6d,0x61,0x6c,0x6c,0x22,0x3e,0x7b,
+0x7b,0x20,0x24,0x75,0x73,0x65,0x72,0x2e,0x43,0x72,0x65,0x61,0x74,0x65,0x64,0x41,0x74,0x20,0x7c,0x20,0x64,0x61,0x74,0x65,
+0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
+0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,
+0x26,0x23,0x31,0x32,0x34,0x3b,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x72,0x69,0x6e,0x74,0x66,0x20,0x22,0x2f,0x61,
+0x64,0x6d,0x69,0x6e,0x2f,0x63,0x68,0x61,0x6e,0x67,0x65,0x5f,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x3f,0x75,0x73,0x65,
+0x72,0x3d,0x25,0x73,0x22,0x20,0x24,0x75,0x73,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7c,0x20,0x70,0x61,0x74,0x68,0x20,
+0x7d,0x7d,0x22,0x3e,0x63,0x68,0x61,0x6e,0x67,0x65,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x26,0x23,0x38,0x32,0x33,
+0x30,0x3b,0x3c,0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x26,0x23,0x31,0x32,
+0x34,0x3b,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,
+0x20,0x69,0x66,0x20,0x24,0x75,0x73,0x65,0x72,0x2e,0x49,0x73,0x41,0x64,0x6d,0x69,0x6e,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x64,0x65,0x6c,0x65,0x74,0x65,0x26,0x23,0x38,0x32,
+0x33,0x30,0x3b,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,
+0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x72,0x69,0x6e,0x74,0x66,0x20,0x22,0x2f,0x61,
+0x64,0x6d,0x69,0x6e,0x2f,0x64,0x65,0x6c,0x65,0x74,0x65,0x5f,0x75,0x73,0x65,0x72,0x3f,0x75,0x73,0x65,0x72,0x3d,0x25,0x73,
+0x22,0x20,0x24,0x75,0x73,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7c,0x20,0x70,0x61,0x74,0x68,0x20,0x7d,0x7d,0x22,0x3e,
+0x64,0x65,0x6c,0x65,0x74,0x65,0x26,0x23,0x38,0x32,0x33,0x30,0x3b,0x3c,0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x3c,0x62,0x72,0x2f,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,
+0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,
+0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,0x72,0x65,0x61,0x74,0x65,0x5f,0x75,0x73,0x65,0x72,
+0x22,0x20,0x7d,0x7d,0x22,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x75,0x74,0x74,0x6f,0x6e,0x22,0x3e,0x63,0x72,0x65,
+0x61,0x74,0x65,0x20,0x6e,0x65,0x77,0x26,0x23,0x38,0x32,0x33,0x30,0x3b,0x3c,0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2f,0x2a,0x20,0x75,0x73,0x65,0x72,0x20,0x70,0x61,0x6e,0x65,0x6c,
+0x20,0x74,0x6f,0x20,0x6d,0x61,0x6e,0x61,0x67,0x65,0x20,0x74,0x68,0x65,0x6d,0x73,0x65,0x6c,0x76,0x65,0x73,0x20,0x2a,0x2f,
+0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,
+0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
+0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,
+0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x79,0x6f,0x75,0x72,0x20,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,0x0a,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,
+0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,
+0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,0x68,0x61,0x6e,0x67,0x65,0x5f,0x6f,0x77,0x6e,0x5f,0x70,0x61,0x73,0x73,
+0x77,0x6f,0x72,0x64,0x22,0x20,0x7d,0x7d,0x22,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x75,0x74,0x74,0x6f,0x6e,0x22,
+0x3e,0x63,0x68,0x61,0x6e,0x67,0x65,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x26,0x23,0x38,0x32,0x33,0x30,0x3b,0x3c,
+0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x7b,
+0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,
+0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,
+0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x66,
+0x6f,0x6c,0x64,0x65,0x72,0x2d,0x6d,0x75,0x6c,0x74,0x69,0x70,0x6c,0x65,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x72,0x65,0x63,
+0x65,0x6e,0x74,0x20,0x66,0x6f,0x6c,0x64,0x65,0x72,0x73,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,
+0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x2d,0x72,0x69,0x67,
+0x68,0x74,0x20,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x28,0x6c,0x65,0x6e,0x20,0x2e,0x52,0x65,0x63,0x65,0x6e,0x74,0x46,0x6f,0x6c,
+0x64,0x65,0x72,0x73,0x29,0x20,0x30,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
+0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,
+0x6e,0x6f,0x20,0x66,0x6f,0x6c,0x64,0x65,0x72,0x73,0x20,0x79,0x65,0x74,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x69,0x64,0x3d,0x22,0x72,0x65,0x63,0x65,0x6e,0x74,0x2d,0x66,0x6f,0x6c,0x64,0x65,0x72,
+0x73,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x63,0x6f,0x6c,0x67,0x72,0x6f,0x75,0x70,0x3e,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x63,0x6f,0x6c,0x20,0x77,0x69,0x64,0x74,0x68,0x3d,0x22,0x38,
+0x30,0x25,0x22,0x20,0x2f,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x63,0x6f,0x6c,0x20,
+0x77,0x69,0x64,0x74,0x68,0x3d,0x22,0x30,0x25,0x22,0x20,0x2f,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,
+0x63,0x6f,0x6c,0x67,0x72,0x6f,0x75,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,
+0x67,0x65,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x20,0x3a,0x3d,0x20,0x2e,0x52,0x65,0x63,0x65,0x6e,0x74,0x46,0x6f,0x6c,
+0x64,0x65,0x72,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,
+0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x20,0x74,0x65,0x78,0x74,0x2d,0x74,0x72,0x75,0x6e,0x63,0x22,0x3e,0x7b,
+0x7b,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x2e,0x52,0x69,0x67,0x68,0x74,0x50,0x61,0x74,0x68,0x20,0x7d,0x7d,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x73,0x70,0x61,
+0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x20,0x74,0x69,0x74,
+0x6c,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x2e,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x41,
+0x74,0x20,0x7d,0x7d,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x2e,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,
+0x64,0x41,0x74,0x20,0x7c,0x20,0x64,0x61,0x74,0x65,0x48,0x75,0x6d,0x61,0x6e,0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,
+0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,
+0x69,0x66,0x20,0x2e,0x49,0x73,0x53,0x63,0x61,0x6e,0x6e,0x69,0x6e,0x67,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x20,0x3a,0x3d,0x20,0x2e,
+0x53,0x63,0x61,0x6e,0x50,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x69,0x64,0x3d,0x22,0x73,0x63,0x61,0x6e,0x2d,0x70,0x72,0x6f,0x67,
+0x72,0x65,0x73,0x73,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
+0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x73,
+0x63,0x61,0x6e,0x6e,0x69,0x6e,0x67,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x73,0x70,0x61,0x6e,0x20,0x69,0x64,0x3d,0x22,0x73,
+0x63,0x61,0x6e,0x2d,0x70,0x68,0x61,0x73,0x65,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,
+0x50,0x68,0x61,0x73,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,
+0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x73,0x65,0x65,0x6e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x73,0x70,
+0x61,0x6e,0x20,0x69,0x64,0x3d,0x22,0x73,0x63,0x61,0x6e,0x2d,0x66,0x6f,0x6c,0x64,0x65,0x72,0x73,0x22,0x3e,0x7b,0x7b,0x20,
+0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x46,0x6f,0x6c,0x64,0x65,0x72,0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,
+0x61,0x6e,0x3e,0x20,0x66,0x6f,0x6c,0x64,0x65,0x72,0x73,0x2c,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x69,0x64,0x3d,0x22,0x73,
+0x63,0x61,0x6e,0x2d,0x74,0x72,0x61,0x63,0x6b,0x73,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,
+0x2e,0x54,0x72,0x61,0x63,0x6b,0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x20,0x74,0x72,0x61,0x63,0x6b,0x73,
+0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,
+0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x74,0x72,0x61,0x63,
+0x6b,0x73,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x73,0x70,0x61,0x6e,0x20,0x69,0x64,0x3d,0x22,0x73,0x63,0x61,0x6e,0x2d,0x74,
+0x72,0x61,0x63,0x6b,0x73,0x2d,0x6e,0x65,0x77,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,
+0x54,0x72,0x61,0x63,0x6b,0x73,0x4e,0x65,0x77,0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x20,0x6e,0x65,0x77,0x2c,
+0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x69,0x64,0x3d,0x22,0x73,0x63,0x61,0x6e,0x2d,0x74,0x72,0x61,0x63,0x6b,0x73,0x2d,0x75,
+0x70,0x64,0x61,0x74,0x65,0x64,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x54,0x72,0x61,
+0x63,0x6b,0x73,0x55,0x70,0x64,0x61,0x74,0x65,0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x20,0x75,0x70,0x64,
+0x61,0x74,0x65,0x64,0x2c,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x69,0x64,0x3d,0x22,0x73,0x63,0x61,0x6e,0x2d,0x74,0x72,0x61,
+0x63,0x6b,0x73,0x2d,0x65,0x72,0x72,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x54,0x72,
+0x61,0x63,0x6b,0x73,0x45,0x72,0x72,0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,
+0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,
+0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x61,0x74,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x74,0x72,0x75,0x6e,0x63,0x22,0x20,0x69,
+0x64,0x3d,0x22,0x73,0x63,0x61,0x6e,0x2d,0x63,0x75,0x72,0x72,0x65,0x6e,0x74,0x2d,0x70,0x61,0x74,0x68,0x22,0x3e,0x7b,0x7b,
+0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x43,0x75,0x72,0x72,0x65,0x6e,0x74,0x50,0x61,0x74,0x68,0x20,0x7d,
+0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
+0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,
+0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,
+0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x74,0x69,0x6d,
+0x65,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x73,0x70,0x61,0x6e,0x20,0x69,0x64,0x3d,0x22,0x73,0x63,0x61,0x6e,0x2d,0x65,0x6c,
+0x61,0x70,0x73,0x65,0x64,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x45,0x6c,0x61,0x70,
+0x73,0x65,0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x65,0x74,0x61,0x3c,0x2f,0x73,0x70,0x61,0x6e,
+0x3e,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x69,0x64,0x3d,0x22,0x73,0x63,0x61,0x6e,0x2d,0x65,0x74,0x61,0x22,0x3e,0x7b,0x7b,
+0x20,0x69,0x66,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x45,0x54,0x41,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x24,
+0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x45,0x54,0x41,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,
+0x7d,0x75,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,
+0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,
+0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x69,0x66,0x20,0x2e,0x55,0x73,0x65,
+0x72,0x2e,0x49,0x73,0x41,0x64,0x6d,0x69,0x6e,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,
+0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,0x61,0x6e,0x63,0x65,0x6c,0x5f,0x73,0x63,0x61,0x6e,0x5f,
+0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,
+0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x63,
+0x61,0x6e,0x63,0x65,0x6c,0x20,0x73,0x63,0x61,0x6e,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x7b,0x7b,0x2d,0x20,0x65,0x6e,0x64,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x3c,0x73,0x63,0x72,0x69,0x70,0x74,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x28,0x28,0x29,0x20,0x3d,0x3e,0x20,0x7b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x63,0x6f,0x6e,0x73,0x74,0x20,0x73,0x65,0x63,0x73,0x20,0x3d,0x20,0x28,0x6e,0x29,0x20,
+0x3d,0x3e,0x20,0x60,0x24,0x7b,0x4d,0x61,0x74,0x68,0x2e,0x66,0x6c,0x6f,0x6f,0x72,0x28,0x6e,0x20,0x2f,0x20,0x36,0x30,0x29,
+0x7d,0x6d,0x24,0x7b,0x6e,0x20,0x25,0x20,0x36,0x30,0x7d,0x73,0x60,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x63,0x6f,0x6e,0x73,0x74,0x20,0x73,0x65,0x74,0x20,0x3d,0x20,0x28,
+0x69,0x64,0x2c,0x20,0x76,0x61,0x6c,0x75,0x65,0x29,0x20,0x3d,0x3e,0x20,0x64,0x6f,0x63,0x75,0x6d,0x65,0x6e,0x74,0x2e,0x67,
+0x65,0x74,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x42,0x79,0x49,0x64,0x28,0x69,0x64,0x29,0x2e,0x74,0x65,0x78,0x74,0x43,0x6f,
+0x6e,0x74,0x65,0x6e,0x74,0x20,0x3d,0x20,0x76,0x61,0x6c,0x75,0x65,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x63,0x6f,0x6e,0x73,0x74,0x20,0x65,0x76,0x65,0x6e,0x74,0x73,0x20,
+0x3d,0x20,0x6e,0x65,0x77,0x20,0x45,0x76,0x65,0x6e,0x74,0x53,0x6f,0x75,0x72,0x63,0x65,0x28,0x22,0x7b,0x7b,0x20,0x70,0x61,
+0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x73,0x63,0x61,0x6e,0x5f,0x65,0x76,0x65,0x6e,0x74,0x73,0x22,0x20,
+0x7d,0x7d,0x22,0x29,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x65,0x76,0x65,0x6e,0x74,0x73,0x2e,0x61,0x64,0x64,0x45,0x76,0x65,0x6e,0x74,0x4c,0x69,0x73,0x74,0x65,0x6e,0x65,
+0x72,0x28,0x22,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x22,0x2c,0x20,0x28,0x65,0x29,0x20,0x3d,0x3e,0x20,0x7b,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x63,
+0x6f,0x6e,0x73,0x74,0x20,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x20,0x3d,0x20,0x4a,0x53,0x4f,0x4e,0x2e,0x70,0x61,0x72,
+0x73,0x65,0x28,0x65,0x2e,0x64,0x61,0x74,0x61,0x29,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x69,0x66,0x20,0x28,0x21,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,
+0x73,0x2e,0x73,0x63,0x61,0x6e,0x6e,0x69,0x6e,0x67,0x29,0x20,0x7b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x2f,0x2f,0x20,0x64,0x6f,0x6e,
+0x65,0x2c,0x20,0x73,0x6f,0x20,0x73,0x68,0x6f,0x77,0x20,0x74,0x68,0x65,0x20,0x6e,0x65,0x77,0x20,0x66,0x6f,0x6c,0x64,0x65,
+0x72,0x73,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x65,0x76,0x65,0x6e,0x74,0x73,0x2e,0x63,0x6c,0x6f,0x73,0x65,0x28,0x29,0x3b,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x77,0x69,0x6e,0x64,0x6f,0x77,0x2e,0x6c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2e,0x72,0x65,0x6c,0x6f,0x61,
+0x64,0x28,0x29,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x72,0x65,0x74,0x75,0x72,0x6e,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x65,0x74,0x28,0x22,
+0x73,0x63,0x61,0x6e,0x2d,0x70,0x68,0x61,0x73,0x65,0x22,0x2c,0x20,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x70,0x68,
+0x61,0x73,0x65,0x29,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x65,0x74,0x28,0x22,0x73,0x63,0x61,0x6e,0x2d,0x66,0x6f,0x6c,0x64,0x65,0x72,0x73,0x22,
+0x2c,0x20,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x66,0x6f,0x6c,0x64,0x65,0x72,0x73,0x29,0x3b,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x65,0x74,
+0x28,0x22,0x73,0x63,0x61,0x6e,0x2d,0x74,0x72,0x61,0x63,0x6b,0x73,0x22,0x2c,0x20,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,
+0x2e,0x74,0x72,0x61,0x63,0x6b,0x73,0x29,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x65,0x74,0x28,0x22,0x73,0x63,0x61,0x6e,0x2d,0x74,0x72,0x61,0x63,
+0x6b,0x73,0x2d,0x6e,0x65,0x77,0x22,0x2c,0x20,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x74,0x72,0x61,0x63,0x6b,0x73,
+0x4e,0x65,0x77,0x29,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x65,0x74,0x28,0x22,0x73,0x63,0x61,0x6e,0x2d,0x74,0x72,0x61,0x63,0x6b,0x73,0x2d,0x75,
+0x70,0x64,0x61,0x74,0x65,0x64,0x22,0x2c,0x20,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x74,0x72,0x61,0x63,0x6b,0x73,
+0x55,0x70,0x64,0x61,0x74,0x65,0x64,0x29,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x65,0x74,0x28,0x22,0x73,0x63,0x61,0x6e,0x2d,0x74,0x72,0x61,0x63,
+0x6b,0x73,0x2d,0x65,0x72,0x72,0x22,0x2c,0x20,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x74,0x72,0x61,0x63,0x6b,0x73,
+0x45,0x72,0x72,0x29,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x65,0x74,0x28,0x22,0x73,0x63,0x61,0x6e,0x2d,0x63,0x75,0x72,0x72,0x65,0x6e,0x74,0x2d,
+0x70,0x61,0x74,0x68,0x22,0x2c,0x20,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x63,0x75,0x72,0x72,0x65,0x6e,0x74,0x50,
+0x61,0x74,0x68,0x20,0x7c,0x7c,0x20,0x22,0x22,0x29,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x65,0x74,0x28,0x22,0x73,0x63,0x61,0x6e,0x2d,0x65,0x6c,
+0x61,0x70,0x73,0x65,0x64,0x22,0x2c,0x20,0x73,0x65,0x63,0x73,0x28,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x65,0x6c,
+0x61,0x70,0x73,0x65,0x64,0x29,0x29,0x3b,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x65,0x74,0x28,0x22,0x73,0x63,0x61,0x6e,0x2d,0x65,0x74,0x61,0x22,0x2c,
+0x20,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x65,0x74,0x61,0x20,0x3f,0x20,0x73,0x65,0x63,0x73,0x28,0x70,0x72,0x6f,
+0x67,0x72,0x65,0x73,0x73,0x2e,0x65,0x74,0x61,0x29,0x20,0x3a,0x20,0x22,0x75,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x22,0x29,0x3b,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7d,0x29,0x3b,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7d,0x29,0x28,0x29,0x3b,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x73,0x63,0x72,0x69,0x70,0x74,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x65,0x6e,0x64,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x7b,0x7b,0x2d,0x20,0x69,0x66,0x20,0x61,0x6e,0x64,0x20,0x28,0x6e,0x6f,0x74,0x20,0x2e,0x49,0x73,0x53,0x63,0x61,0x6e,
+0x6e,0x69,0x6e,0x67,0x29,0x20,0x28,0x2e,0x55,0x73,0x65,0x72,0x2e,0x49,0x73,0x41,0x64,0x6d,0x69,0x6e,0x29,0x20,0x2d,0x7d,
+0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x69,0x66,0x20,0x2e,0x53,0x63,
+0x61,0x6e,0x50,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x43,0x61,0x6e,0x63,0x65,0x6c,0x6c,0x65,0x64,0x20,0x2d,0x7d,0x7d,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x6c,0x61,0x73,0x74,0x20,0x73,0x63,0x61,0x6e,
+0x20,0x77,0x61,0x73,0x20,0x63,0x61,0x6e,0x63,0x65,0x6c,0x6c,0x65,0x64,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x65,0x6c,0x73,0x65,0x20,0x69,0x66,0x20,0x6e,0x6f,0x74,0x20,0x2e,
+0x4c,0x61,0x73,0x74,0x53,0x63,0x61,0x6e,0x54,0x69,0x6d,0x65,0x2e,0x49,0x73,0x5a,0x65,0x72,0x6f,0x20,0x2d,0x7d,0x7d,0x0a,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x20,0x74,0x69,0x74,0x6c,0x65,0x3d,0x22,0x7b,0x7b,0x20,
+0x2e,0x4c,0x61,0x73,0x74,0x53,0x63,0x61,0x6e,0x54,0x69,0x6d,0x65,0x20,0x7d,0x7d,0x22,0x3e,0x73,0x63,0x61,0x6e,0x6e,0x65,
+0x64,0x20,0x7b,0x7b,0x20,0x2e,0x4c,0x61,0x73,0x74,0x53,0x63,0x61,0x6e,0x54,0x69,0x6d,0x65,0x20,0x7c,0x20,0x64,0x61,0x74,
+0x65,0x48,0x75,0x6d,0x61,0x6e,0x20,0x7d,0x7d,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x7b,0x7b,0x2d,0x20,0x69,0x66,0x20,0x2e,0x48,0x61,0x73,0x53,0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x2d,0x7d,
+0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x3c,0x61,0x20,
+0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x73,0x63,
+0x61,0x6e,0x5f,0x65,0x72,0x72,0x6f,0x72,0x73,0x22,0x20,0x7d,0x7d,0x22,0x3e,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,0x4c,0x61,
+0x73,0x74,0x53,0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x2e,0x4c,
+0x61,0x73,0x74,0x53,0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,0x20,0x65,0x72,0x72,
+0x6f,0x72,0x73,0x20,0x69,0x6e,0x20,0x74,0x68,0x65,0x20,0x6c,0x61,0x73,0x74,0x20,0x73,0x63,0x61,0x6e,0x7b,0x7b,0x20,0x65,
+0x6c,0x73,0x65,0x20,0x7d,0x7d,0x65,0x72,0x72,0x6f,0x72,0x73,0x20,0x6f,0x66,0x20,0x74,0x68,0x65,0x20,0x6c,0x61,0x73,0x74,
+0x20,0x73,0x63,0x61,0x6e,0x73,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x26,0x23,0x38,0x32,0x33,0x30,0x3b,0x3c,0x2f,
+0x61,0x3e,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x65,
+0x6e,0x64,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,
+0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,
+0x2f,0x73,0x74,0x61,0x72,0x74,0x5f,0x73,0x63,0x61,0x6e,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,
+0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x69,0x66,0x20,0x67,0x74,0x20,0x28,0x6c,0x65,0x6e,0x20,0x2e,0x4d,0x75,0x73,0x69,0x63,
+0x46,0x6f,0x6c,0x64,0x65,0x72,0x73,0x29,0x20,0x31,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x65,0x6c,0x65,0x63,0x74,0x20,0x6e,0x61,0x6d,0x65,0x3d,
+0x22,0x6d,0x75,0x73,0x69,0x63,0x5f,0x66,0x6f,0x6c,0x64,0x65,0x72,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x20,
+0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x30,0x22,0x3e,0x61,0x6c,0x6c,0x20,0x66,0x6f,0x6c,0x64,0x65,0x72,0x73,0x3c,0x2f,0x6f,
+0x70,0x74,0x69,0x6f,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x20,0x3a,0x3d,0x20,0x2e,
+0x4d,0x75,0x73,0x69,0x63,0x46,0x6f,0x6c,0x64,0x65,0x72,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x20,
+0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x2e,0x49,0x44,0x20,0x7d,0x7d,0x22,
+0x3e,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x6f,0x70,0x74,
+0x69,0x6f,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x73,0x65,0x6c,0x65,0x63,0x74,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x65,0x6e,0x64,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,
+0x3d,0x22,0x74,0x65,0x78,0x74,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x74,0x68,0x22,0x20,0x70,0x6c,0x61,0x63,
+0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x6f,0x6e,0x6c,0x79,0x20,0x74,0x68,0x69,0x73,0x20,0x70,0x61,0x74,0x68,0x22,
+0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x6c,0x61,0x62,0x65,0x6c,
+0x20,0x74,0x69,0x74,0x6c,0x65,0x3d,0x22,0x72,0x65,0x61,0x64,0x20,0x65,0x76,0x65,0x72,0x79,0x20,0x74,0x72,0x61,0x63,0x6b,
+0x20,0x61,0x67,0x61,0x69,0x6e,0x2c,0x20,0x65,0x76,0x65,0x6e,0x20,0x6f,0x6e,0x65,0x73,0x20,0x77,0x68,0x69,0x63,0x68,0x20,
+0x68,0x61,0x76,0x65,0x6e,0x27,0x74,0x20,0x62,0x65,0x65,0x6e,0x20,0x6d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x22,0x3e,0x3c,
+0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x63,0x68,0x65,0x63,0x6b,0x62,0x6f,0x78,0x22,0x20,0x6e,0x61,
+0x6d,0x65,0x3d,0x22,0x66,0x75,0x6c,0x6c,0x22,0x3e,0x20,0x66,0x75,0x6c,0x6c,0x3c,0x2f,0x6c,0x61,0x62,0x65,0x6c,0x3e,0x0a,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,
+0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x73,0x74,0x61,0x72,
+0x74,0x20,0x73,0x63,0x61,0x6e,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,
+0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x72,0x61,0x6e,
+0x67,0x65,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x20,0x3a,0x3d,0x20,0x2e,0x4f,0x6c,0x64,0x4d,0x75,0x73,0x69,0x63,0x46,
+0x6f,0x6c,0x64,0x65,0x72,0x73,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,
+0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x64,0x65,0x6c,0x65,0x74,0x65,0x5f,0x6d,0x75,0x73,0x69,0x63,0x5f,0x66,0x6f,
+0x6c,0x64,0x65,0x72,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,
+0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,
+0x20,0x74,0x69,0x74,0x6c,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x2e,0x4c,0x6f,0x63,0x61,0x74,
+0x69,0x6f,0x6e,0x20,0x7d,0x7d,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,
+0x7d,0x7d,0x20,0x69,0x73,0x6e,0x27,0x74,0x20,0x63,0x6f,0x6e,0x66,0x69,0x67,0x75,0x72,0x65,0x64,0x20,0x61,0x6e,0x79,0x20,
+0x6d,0x6f,0x72,0x65,0x2c,0x20,0x62,0x75,0x74,0x20,0x69,0x74,0x73,0x20,0x61,0x6c,0x62,0x75,0x6d,0x73,0x20,0x61,0x72,0x65,
+0x20,0x6b,0x65,0x70,0x74,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x68,0x69,
+0x64,0x64,0x65,0x6e,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x6d,0x75,0x73,0x69,0x63,0x5f,0x66,0x6f,0x6c,0x64,0x65,0x72,
+0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x2e,0x49,0x44,0x20,0x7d,
+0x7d,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,
+0x75,0x65,0x3d,0x22,0x64,0x65,0x6c,0x65,0x74,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x7b,0x7b,0x2d,0x20,0x65,0x6e,0x64,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,
+0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,
+0x0a,0x7b,0x7b,0x20,0x69,0x66,0x20,0x61,0x6e,0x64,0x20,0x2e,0x55,0x73,0x65,0x72,0x2e,0x49,0x73,0x41,0x64,0x6d,0x69,0x6e,
+0x20,0x2e,0x4d,0x75,0x73,0x69,0x63,0x43,0x61,0x63,0x68,0x65,0x73,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,
+0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,
+0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,
+0x68,0x61,0x72,0x64,0x64,0x69,0x73,0x6b,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x6d,0x75,0x73,0x69,0x63,0x20,0x63,0x61,0x63,
+0x68,0x65,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,
+0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x64,0x65,0x73,0x63,0x72,0x69,0x70,0x74,0x69,0x6f,0x6e,0x20,0x74,0x65,
+0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x74,0x72,
+0x61,0x63,0x6b,0x73,0x20,0x73,0x74,0x72,0x65,0x61,0x6d,0x65,0x64,0x20,0x66,0x72,0x6f,0x6d,0x20,0x72,0x65,0x6d,0x6f,0x74,
+0x65,0x20,0x6d,0x75,0x73,0x69,0x63,0x20,0x66,0x6f,0x6c,0x64,0x65,0x72,0x73,0x20,0x61,0x72,0x65,0x20,0x6b,0x65,0x70,0x74,
+0x20,0x6f,0x6e,0x20,0x64,0x69,0x73,0x6b,0x2c,0x20,0x73,0x6f,0x20,0x70,0x6c,0x61,0x79,0x69,0x6e,0x67,0x20,0x74,0x68,0x65,
+0x6d,0x20,0x61,0x67,0x61,0x69,0x6e,0x20,0x64,0x6f,0x65,0x73,0x6e,0x27,0x74,0x20,0x66,0x65,0x74,0x63,0x68,0x20,0x74,0x68,
+0x65,0x6d,0x20,0x61,0x67,0x61,0x69,0x6e,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,
+0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x2d,0x72,0x69,
+0x67,0x68,0x74,0x20,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x69,0x64,0x3d,0x22,0x6d,0x75,0x73,0x69,0x63,0x2d,0x63,0x61,0x63,0x68,0x65,0x73,
+0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x24,0x63,0x61,0x63,
+0x68,0x65,0x20,0x3a,0x3d,0x20,0x2e,0x4d,0x75,0x73,0x69,0x63,0x43,0x61,0x63,0x68,0x65,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,
+0x20,0x74,0x65,0x78,0x74,0x2d,0x74,0x72,0x75,0x6e,0x63,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x61,0x63,0x68,0x65,0x2e,0x4e,
+0x61,0x6d,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x61,0x63,0x68,0x65,0x2e,0x42,0x79,0x74,0x65,0x73,0x20,0x7c,0x20,0x62,0x79,
+0x74,0x65,0x73,0x20,0x7d,0x7d,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,
+0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x6f,0x66,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x20,0x7b,0x7b,0x20,0x24,0x63,0x61,
+0x63,0x68,0x65,0x2e,0x4d,0x61,0x78,0x42,0x79,0x74,0x65,0x73,0x20,0x7c,0x20,0x62,0x79,0x74,0x65,0x73,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,
+0x73,0x73,0x3d,0x22,0x6e,0x6f,0x2d,0x73,0x6d,0x61,0x6c,0x6c,0x22,0x3e,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x61,0x63,0x68,0x65,
+0x2e,0x45,0x6e,0x74,0x72,0x69,0x65,0x73,0x20,0x7d,0x7d,0x20,0x74,0x72,0x61,0x63,0x6b,0x73,0x2c,0x20,0x7b,0x7b,0x20,0x24,
+0x63,0x61,0x63,0x68,0x65,0x2e,0x48,0x69,0x74,0x73,0x20,0x7d,0x7d,0x20,0x68,0x69,0x74,0x73,0x2c,0x20,0x7b,0x7b,0x20,0x24,
+0x63,0x61,0x63,0x68,0x65,0x2e,0x4d,0x69,0x73,0x73,0x65,0x73,0x20,0x7d,0x7d,0x20,0x6d,0x69,0x73,0x73,0x65,0x73,0x3c,0x2f,
+0x73,0x70,0x61,0x6e,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
+0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x3c,0x66,0x6f,0x72,0x6d,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,
+0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,0x6c,0x65,0x61,0x72,0x5f,0x6d,0x75,0x73,0x69,0x63,0x5f,0x63,0x61,0x63,0x68,0x65,0x5f,
+0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,
+0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x63,0x6c,0x65,0x61,0x72,0x22,0x3e,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,
+0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x7b,0x7b,0x20,0x69,0x66,
+0x20,0x61,0x6e,0x64,0x20,0x2e,0x55,0x73,0x65,0x72,0x2e,0x49,0x73,0x41,0x64,0x6d,0x69,0x6e,0x20,0x2e,0x43,0x6f,0x76,0x65,
+0x72,0x53,0x69,0x7a,0x65,0x73,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,
+0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
+0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x69,0x6d,0x61,0x67,0x65,0x2d,0x6d,
+0x75,0x6c,0x74,0x69,0x70,0x6c,0x65,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x63,0x6f,0x76,0x65,0x72,0x73,0x0a,0x20,0x20,0x20,
+0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,
+0x62,0x6f,0x78,0x2d,0x64,0x65,0x73,0x63,0x72,0x69,0x70,0x74,0x69,0x6f,0x6e,0x20,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,
+0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x63,0x6f,0x76,0x65,0x72,0x73,0x20,0x61,
+0x72,0x65,0x20,0x73,0x63,0x61,0x6c,0x65,0x64,0x20,0x74,0x6f,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x24,0x69,
+0x2c,0x20,0x24,0x73,0x69,0x7a,0x65,0x20,0x3a,0x3d,0x20,0x2e,0x43,0x6f,0x76,0x65,0x72,0x53,0x69,0x7a,0x65,0x73,0x20,0x7d,
+0x7d,0x7b,0x7b,0x20,0x69,0x66,0x20,0x24,0x69,0x20,0x7d,0x7d,0x2c,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x7b,
+0x7b,0x20,0x24,0x73,0x69,0x7a,0x65,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x70,0x78,0x20,0x77,0x68,
+0x65,0x6e,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x73,0x20,0x61,0x73,0x6b,0x20,0x66,0x6f,0x72,0x20,0x74,0x68,0x65,0x6d,0x2c,
+0x20,0x61,0x6e,0x64,0x20,0x6b,0x65,0x70,0x74,0x20,0x69,0x6e,0x20,0x74,0x68,0x65,0x20,0x63,0x61,0x63,0x68,0x65,0x2e,0x20,
+0x79,0x6f,0x75,0x20,0x63,0x61,0x6e,0x20,0x73,0x63,0x61,0x6c,0x65,0x20,0x74,0x68,0x65,0x6d,0x20,0x61,0x6c,0x6c,0x20,0x6e,
+0x6f,0x77,0x2c,0x20,0x73,0x6f,0x20,0x74,0x68,0x65,0x79,0x27,0x72,0x65,0x20,0x72,0x65,0x61,0x64,0x79,0x3c,0x2f,0x70,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,
+0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x2d,0x72,0x69,0x67,0x68,0x74,0x20,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,
+0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,
+0x73,0x20,0x3a,0x3d,0x20,0x2e,0x43,0x6f,0x76,0x65,0x72,0x50,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x20,0x7d,0x7d,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x69,0x66,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,
+0x52,0x75,0x6e,0x6e,0x69,0x6e,0x67,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x3c,0x70,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x67,0x65,
+0x6e,0x65,0x72,0x61,0x74,0x65,0x64,0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x44,0x6f,0x6e,
+0x65,0x20,0x7d,0x7d,0x20,0x6f,0x66,0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x43,0x6f,0x76,
+0x65,0x72,0x73,0x20,0x7d,0x7d,0x20,0x63,0x6f,0x76,0x65,0x72,0x73,0x2c,0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,
+0x65,0x73,0x73,0x2e,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x7d,0x7d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2c,0x20,0x69,0x6e,
+0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x45,0x6c,0x61,0x70,0x73,0x65,0x64,0x20,0x7d,0x7d,
+0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x61,
+0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,
+0x61,0x6e,0x63,0x65,0x6c,0x5f,0x67,0x65,0x6e,0x65,0x72,0x61,0x74,0x65,0x5f,0x63,0x6f,0x76,0x65,0x72,0x73,0x5f,0x64,0x6f,
+0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,
+0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x63,0x61,0x6e,0x63,0x65,0x6c,0x22,
+0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x65,0x6c,0x73,0x65,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x69,0x66,0x20,0x6e,0x6f,0x74,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,
+0x65,0x73,0x73,0x2e,0x53,0x74,0x61,0x72,0x74,0x65,0x64,0x2e,0x49,0x73,0x5a,0x65,0x72,0x6f,0x20,0x2d,0x7d,0x7d,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,
+0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x20,0x74,0x69,0x74,0x6c,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,
+0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x53,0x74,0x61,0x72,0x74,0x65,0x64,0x20,0x7d,0x7d,0x22,0x3e,0x7b,0x7b,0x20,
+0x69,0x66,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x43,0x61,0x6e,0x63,0x65,0x6c,0x6c,0x65,0x64,0x20,0x7d,
+0x7d,0x63,0x61,0x6e,0x63,0x65,0x6c,0x6c,0x65,0x64,0x20,0x61,0x66,0x74,0x65,0x72,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,
+0x7d,0x7d,0x67,0x65,0x6e,0x65,0x72,0x61,0x74,0x65,0x64,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x20,0x7b,0x7b,0x20,
+0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x44,0x6f,0x6e,0x65,0x20,0x7d,0x7d,0x20,0x6f,0x66,0x20,0x7b,0x7b,0x20,
+0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x43,0x6f,0x76,0x65,0x72,0x73,0x20,0x7d,0x7d,0x20,0x63,0x6f,0x76,0x65,
+0x72,0x73,0x2c,0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,
+0x7d,0x7d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2c,0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,
+0x53,0x74,0x61,0x72,0x74,0x65,0x64,0x20,0x7c,0x20,0x64,0x61,0x74,0x65,0x48,0x75,0x6d,0x61,0x6e,0x20,0x7d,0x7d,0x3c,0x2f,
+0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x65,0x6e,0x64,0x20,0x2d,
+0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x61,0x63,0x74,
+0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x67,0x65,0x6e,
+0x65,0x72,0x61,0x74,0x65,0x5f,0x63,0x6f,0x76,0x65,0x72,0x73,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,
+0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,
+0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x67,0x65,0x6e,0x65,0x72,0x61,0x74,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,
+0x7b,0x2d,0x20,0x65,0x6e,0x64,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x2f,
+0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,0x55,0x73,
+0x65,0x72,0x2e,0x49,0x73,0x41,0x64,0x6d,0x69,0x6e,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,
+0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x76,0x6f,0x6c,
+0x75,0x6d,0x65,0x2d,0x68,0x69,0x67,0x68,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x6c,0x6f,0x75,0x64,0x6e,0x65,0x73,0x73,0x0a,
+0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x64,0x65,0x73,0x63,0x72,0x69,0x70,0x74,0x69,0x6f,0x6e,0x20,0x74,0x65,0x78,0x74,0x2d,
+0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x74,0x72,0x61,0x63,0x6b,
+0x73,0x20,0x77,0x69,0x74,0x68,0x6f,0x75,0x74,0x20,0x72,0x65,0x70,0x6c,0x61,0x79,0x67,0x61,0x69,0x6e,0x20,0x74,0x61,0x67,
+0x73,0x20,0x63,0x61,0x6e,0x20,0x68,0x61,0x76,0x65,0x20,0x74,0x68,0x65,0x69,0x72,0x20,0x6c,0x6f,0x75,0x64,0x6e,0x65,0x73,
+0x73,0x20,0x6d,0x65,0x61,0x73,0x75,0x72,0x65,0x64,0x20,0x77,0x69,0x74,0x68,0x20,0x66,0x66,0x6d,0x70,0x65,0x67,0x2c,0x20,
+0x73,0x6f,0x20,0x74,0x68,0x61,0x74,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x73,0x20,0x63,0x61,0x6e,0x20,0x70,0x6c,0x61,0x79,
+0x20,0x74,0x68,0x65,0x6d,0x20,0x61,0x74,0x20,0x74,0x68,0x65,0x20,0x73,0x61,0x6d,0x65,0x20,0x76,0x6f,0x6c,0x75,0x6d,0x65,
+0x20,0x61,0x73,0x20,0x74,0x68,0x65,0x20,0x72,0x65,0x73,0x74,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,
+0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,
+0x6b,0x2d,0x72,0x69,0x67,0x68,0x74,0x20,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x20,0x3a,0x3d,0x20,0x2e,0x4c,0x6f,
+0x75,0x64,0x6e,0x65,0x73,0x73,0x50,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x7b,0x7b,0x2d,0x20,0x69,0x66,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x52,0x75,0x6e,0x6e,0x69,
+0x6e,0x67,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x20,0x63,0x6c,
+0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x61,0x6e,0x61,0x6c,0x79,0x73,0x65,
+0x64,0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x44,0x6f,0x6e,0x65,0x20,0x7d,0x7d,0x20,0x6f,
+0x66,0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x54,0x72,0x61,0x63,0x6b,0x73,0x20,0x7d,0x7d,
+0x20,0x74,0x72,0x61,0x63,0x6b,0x73,0x2c,0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x45,0x72,
+0x72,0x6f,0x72,0x73,0x20,0x7d,0x7d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2c,0x20,0x69,0x6e,0x20,0x7b,0x7b,0x20,0x24,0x70,
+0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x45,0x6c,0x61,0x70,0x73,0x65,0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x70,0x3e,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,
+0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,0x61,0x6e,0x63,0x65,0x6c,0x5f,
+0x61,0x6e,0x61,0x6c,0x79,0x73,0x65,0x5f,0x6c,0x6f,0x75,0x64,0x6e,0x65,0x73,0x73,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,
+0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,
+0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x63,0x61,0x6e,0x63,0x65,0x6c,0x22,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x7b,0x7b,0x2d,0x20,0x65,0x6c,0x73,0x65,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x7b,0x7b,0x2d,0x20,0x69,0x66,0x20,0x6e,0x6f,0x74,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x53,
+0x74,0x61,0x72,0x74,0x65,0x64,0x2e,0x49,0x73,0x5a,0x65,0x72,0x6f,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,
+0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x20,0x74,0x69,0x74,0x6c,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,
+0x65,0x73,0x73,0x2e,0x53,0x74,0x61,0x72,0x74,0x65,0x64,0x20,0x7d,0x7d,0x22,0x3e,0x7b,0x7b,0x20,0x69,0x66,0x20,0x24,0x70,
+0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x43,0x61,0x6e,0x63,0x65,0x6c,0x6c,0x65,0x64,0x20,0x7d,0x7d,0x63,0x61,0x6e,0x63,
+0x65,0x6c,0x6c,0x65,0x64,0x20,0x61,0x66,0x74,0x65,0x72,0x20,0x61,0x6e,0x61,0x6c,0x79,0x73,0x69,0x6e,0x67,0x7b,0x7b,0x20,
+0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x61,0x6e,0x61,0x6c,0x79,0x73,0x65,0x64,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,
+0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x44,0x6f,0x6e,0x65,0x20,0x7d,0x7d,0x20,0x6f,0x66,
+0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x54,0x72,0x61,0x63,0x6b,0x73,0x20,0x7d,0x7d,0x20,
+0x74,0x72,0x61,0x63,0x6b,0x73,0x2c,0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x45,0x72,0x72,
+0x6f,0x72,0x73,0x20,0x7d,0x7d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2c,0x20,0x61,0x6e,0x64,0x20,0x7b,0x7b,0x20,0x24,0x70,
+0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x41,0x6c,0x62,0x75,0x6d,0x73,0x20,0x7d,0x7d,0x20,0x61,0x6c,0x62,0x75,0x6d,0x73,
+0x2c,0x20,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x67,0x72,0x65,0x73,0x73,0x2e,0x53,0x74,0x61,0x72,0x74,0x65,0x64,0x20,0x7c,
+0x20,0x64,0x61,0x74,0x65,0x48,0x75,0x6d,0x61,0x6e,0x20,0x7d,0x7d,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x2d,0x20,0x65,0x6e,0x64,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,
+0x68,0x74,0x22,0x3e,0x7b,0x7b,0x20,0x2e,0x55,0x6e,0x61,0x6e,0x61,0x6c,0x79,0x73,0x65,0x64,0x54,0x72,0x61,0x63,0x6b,0x73,
+0x20,0x7d,0x7d,0x20,0x74,0x72,0x61,0x63,0x6b,0x73,0x20,0x77,0x69,0x74,0x68,0x6f,0x75,0x74,0x20,0x67,0x61,0x69,0x6e,0x73,
+0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x61,
+0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x61,
+0x6e,0x61,0x6c,0x79,0x73,0x65,0x5f,0x6c,0x6f,0x75,0x64,0x6e,0x65,0x73,0x73,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,
+0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,
+0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x61,0x6e,0x61,0x6c,0x79,0x73,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x7b,0x7b,0x2d,0x20,0x65,0x6e,0x64,0x20,0x2d,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,
+0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,
+0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,
+0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,
+0x66,0x69,0x6c,0x65,0x2d,0x6d,0x75,0x73,0x69,0x63,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x74,0x72,0x61,0x6e,0x73,0x63,0x6f,
+0x64,0x69,0x6e,0x67,0x20,0x64,0x65,0x76,0x69,0x63,0x65,0x20,0x70,0x72,0x6f,0x66,0x69,0x6c,0x65,0x73,0x0a,0x20,0x20,0x20,
+0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,
+0x62,0x6f,0x78,0x2d,0x64,0x65,0x73,0x63,0x72,0x69,0x70,0x74,0x69,0x6f,0x6e,0x20,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,
+0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x79,0x6f,0x75,0x20,0x63,0x61,0x6e,0x20,
+0x66,0x69,0x6e,0x64,0x20,0x79,0x6f,0x75,0x72,0x20,0x64,0x65,0x76,0x69,0x63,0x65,0x27,0x73,0x20,0x63,0x6c,0x69,0x65,0x6e,
+0x74,0x20,0x6e,0x61,0x6d,0x65,0x20,0x69,0x6e,0x20,0x74,0x68,0x65,0x20,0x67,0x6f,0x6e,0x69,0x63,0x20,0x6c,0x6f,0x67,0x73,
+0x2e,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x73,0x6f,0x6d,0x65,0x20,0x63,0x6f,
+0x6d,0x6d,0x6f,0x6e,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x20,0x6e,0x61,0x6d,0x65,0x73,0x20,0x61,0x72,0x65,0x20,0x3c,0x73,
+0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x65,0x6d,0x70,0x22,0x3e,0x44,0x53,0x75,
+0x62,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x2c,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,
+0x65,0x78,0x74,0x2d,0x65,0x6d,0x70,0x22,0x3e,0x4a,0x61,0x6d,0x73,0x74,0x61,0x73,0x68,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,
+0x2c,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x65,0x6d,0x70,0x22,
+0x3e,0x53,0x6f,0x75,0x6e,0x64,0x77,0x61,0x76,0x65,0x73,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x2c,0x20,0x6f,0x72,0x20,0x75,
+0x73,0x65,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x65,0x6d,0x70,
+0x22,0x3e,0x2a,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x20,0x61,0x73,0x20,0x66,0x61,0x6c,0x6c,0x62,0x61,0x63,0x6b,0x20,0x72,
+0x75,0x6c,0x65,0x20,0x66,0x6f,0x72,0x20,0x61,0x6e,0x79,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x3c,0x2f,0x70,0x3e,0x0a,0x20,
+0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x69,0x64,0x3d,0x22,0x74,0x72,0x61,0x6e,0x73,0x63,0x6f,0x64,0x65,0x2d,0x70,0x72,0x65,
+0x66,0x65,0x72,0x65,0x6e,0x63,0x65,0x73,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,
+0x6e,0x67,0x65,0x20,0x24,0x70,0x72,0x65,0x66,0x20,0x3a,0x3d,0x20,0x2e,0x54,0x72,0x61,0x6e,0x73,0x63,0x6f,0x64,0x65,0x50,
+0x72,0x65,0x66,0x65,0x72,0x65,0x6e,0x63,0x65,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,
+0x7b,0x20,0x24,0x66,0x6f,0x72,0x6d,0x53,0x75,0x66,0x66,0x69,0x78,0x20,0x3a,0x3d,0x20,0x6b,0x65,0x62,0x61,0x62,0x63,0x61,
+0x73,0x65,0x20,0x24,0x70,0x72,0x65,0x66,0x2e,0x43,0x6c,0x69,0x65,0x6e,0x74,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x69,0x64,0x3d,0x22,0x74,0x72,0x61,
+0x6e,0x73,0x63,0x6f,0x64,0x65,0x2d,0x70,0x72,0x65,0x66,0x2d,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x72,0x6d,0x53,0x75,0x66,0x66,
+0x69,0x78,0x20,0x7d,0x7d,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x72,0x69,0x6e,0x74,0x66,
+0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x64,0x65,0x6c,0x65,0x74,0x65,0x5f,0x74,0x72,0x61,0x6e,0x73,0x63,0x6f,0x64,
+0x65,0x5f,0x70,0x72,0x65,0x66,0x5f,0x64,0x6f,0x3f,0x63,0x6c,0x69,0x65,0x6e,0x74,0x3d,0x25,0x73,0x22,0x20,0x24,0x70,0x72,
+0x65,0x66,0x2e,0x43,0x6c,0x69,0x65,0x6e,0x74,0x20,0x7c,0x20,0x70,0x61,0x74,0x68,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,
+0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x72,0x65,0x66,0x2e,
+0x43,0x6c,0x69,0x65,0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x72,0x65,0x66,0x2e,0x50,0x72,0x6f,0x66,
+0x69,0x6c,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x66,0x6f,0x72,0x6d,0x3d,0x22,0x74,0x72,0x61,
+0x6e,0x73,0x63,0x6f,0x64,0x65,0x2d,0x70,0x72,0x65,0x66,0x2d,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x72,0x6d,0x53,0x75,0x66,0x66,
+0x69,0x78,0x20,0x7d,0x7d,0x22,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,
+0x75,0x65,0x3d,0x22,0x64,0x65,0x6c,0x65,0x74,0x65,0x22,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,
+0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x69,0x64,0x3d,0x22,0x74,0x72,0x61,0x6e,0x73,0x63,0x6f,
+0x64,0x65,0x2d,0x70,0x72,0x65,0x66,0x2d,0x61,0x64,0x64,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,
+0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,0x72,0x65,0x61,0x74,0x65,0x5f,0x74,0x72,0x61,0x6e,
+0x73,0x63,0x6f,0x64,0x65,0x5f,0x70,0x72,0x65,0x66,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,
+0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x66,0x6f,0x72,0x6d,0x3d,0x22,0x74,0x72,
+0x61,0x6e,0x73,0x63,0x6f,0x64,0x65,0x2d,0x70,0x72,0x65,0x66,0x2d,0x61,0x64,0x64,0x22,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,
+0x74,0x65,0x78,0x74,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x63,0x6c,0x69,0x65,0x6e,0x74,0x22,0x20,0x70,0x6c,0x61,0x63,
+0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x63,0x6c,0x69,0x65,0x6e,0x74,0x20,0x6e,0x61,0x6d,0x65,0x22,0x3e,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x73,0x65,0x6c,
+0x65,0x63,0x74,0x20,0x66,0x6f,0x72,0x6d,0x3d,0x22,0x74,0x72,0x61,0x6e,0x73,0x63,0x6f,0x64,0x65,0x2d,0x70,0x72,0x65,0x66,
+0x2d,0x61,0x64,0x64,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x72,0x6f,0x66,0x69,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x24,
+0x70,0x72,0x6f,0x66,0x69,0x6c,0x65,0x20,0x3a,0x3d,0x20,0x2e,0x54,0x72,0x61,0x6e,0x73,0x63,0x6f,0x64,0x65,0x50,0x72,0x6f,
+0x66,0x69,0x6c,0x65,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,
+0x70,0x72,0x6f,0x66,0x69,0x6c,0x65,0x20,0x7d,0x7d,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x72,0x6f,0x66,0x69,0x6c,0x65,0x20,
+0x7d,0x7d,0x3c,0x2f,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x3c,0x2f,0x73,0x65,0x6c,0x65,0x63,0x74,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x66,0x6f,0x72,0x6d,0x3d,0x22,0x74,0x72,
+0x61,0x6e,0x73,0x63,0x6f,0x64,0x65,0x2d,0x70,0x72,0x65,0x66,0x2d,0x61,0x64,0x64,0x22,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,
+0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x73,0x61,0x76,0x65,0x22,0x3e,0x3c,0x2f,0x74,
+0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x2f,0x64,
+0x69,0x76,0x3e,0x0a,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,
+0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,
+0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2d,0x6d,0x75,0x73,0x69,
+0x63,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x73,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,
+0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,
+0x63,0x6b,0x2d,0x72,0x69,0x67,0x68,0x74,0x20,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x28,0x6c,0x65,0x6e,0x20,0x2e,0x50,0x6c,0x61,
+0x79,0x6c,0x69,0x73,0x74,0x73,0x29,0x20,0x30,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,
+0x22,0x3e,0x6e,0x6f,0x20,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x73,0x20,0x79,0x65,0x74,0x3c,0x2f,0x73,0x70,0x61,0x6e,
+0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x69,0x64,0x3d,0x22,0x72,0x65,0x63,0x65,0x6e,0x74,0x2d,0x70,0x6c,
+0x61,0x79,0x6c,0x69,0x73,0x74,0x73,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,
+0x67,0x65,0x20,0x24,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x20,0x3a,0x3d,0x20,0x2e,0x50,0x6c,0x61,0x79,0x6c,0x69,0x73,
+0x74,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,
+0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2e,0x4e,
+0x61,0x6d,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x3c,0x74,0x64,0x3e,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,
+0x67,0x68,0x74,0x22,0x3e,0x28,0x7b,0x7b,0x20,0x24,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2e,0x54,0x72,0x61,0x63,0x6b,
+0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,0x20,0x74,0x72,0x61,0x63,0x6b,0x73,0x29,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,
+0x73,0x73,0x3d,0x22,0x6e,0x6f,0x2d,0x73,0x6d,0x61,0x6c,0x6c,0x22,0x3e,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x20,0x74,0x69,0x74,0x6c,0x65,0x3d,0x22,0x7b,0x7b,
+0x20,0x24,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2e,0x43,0x72,0x65,0x61,0x74,0x65,0x64,0x41,0x74,0x20,0x7d,0x7d,0x22,
+0x3e,0x7b,0x7b,0x20,0x24,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2e,0x43,0x72,0x65,0x61,0x74,0x65,0x64,0x41,0x74,0x20,
+0x7c,0x20,0x64,0x61,0x74,0x65,0x48,0x75,0x6d,0x61,0x6e,0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x3c,0x2f,0x74,
+0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
+0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x69,0x64,0x3d,0x22,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2d,0x75,
+0x70,0x6c,0x6f,0x61,0x64,0x2d,0x66,0x6f,0x72,0x6d,0x22,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x65,0x6e,0x63,0x74,0x79,0x70,0x65,0x3d,0x22,0x6d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x2f,0x66,0x6f,0x72,0x6d,0x2d,
+0x64,0x61,0x74,0x61,0x22,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,
+0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x75,0x70,0x6c,0x6f,0x61,0x64,
+0x5f,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x0a,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x73,
+0x74,0x79,0x6c,0x65,0x3d,0x22,0x70,0x6f,0x73,0x69,0x74,0x69,0x6f,0x6e,0x3a,0x20,0x72,0x65,0x6c,0x61,0x74,0x69,0x76,0x65,
+0x3b,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,
+0x75,0x74,0x20,0x69,0x64,0x3d,0x22,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2d,0x75,0x70,0x6c,0x6f,0x61,0x64,0x2d,0x69,
+0x6e,0x70,0x75,0x74,0x22,0x20,0x73,0x74,0x79,0x6c,0x65,0x3d,0x22,0x70,0x6f,0x73,0x69,0x74,0x69,0x6f,0x6e,0x3a,0x20,0x61,
+0x62,0x73,0x6f,0x6c,0x75,0x74,0x65,0x3b,0x20,0x6f,0x70,0x61,0x63,0x69,0x74,0x79,0x3a,0x20,0x30,0x3b,0x22,0x20,0x6e,0x61,
+0x6d,0x65,0x3d,0x22,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2d,0x66,0x69,0x6c,0x65,0x73,0x22,0x20,0x74,0x79,0x70,0x65,
+0x3d,0x22,0x66,0x69,0x6c,0x65,0x22,0x20,0x6d,0x75,0x6c,0x74,0x69,0x70,0x6c,0x65,0x20,0x2f,0x3e,0x0a,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,
+0x22,0x62,0x75,0x74,0x74,0x6f,0x6e,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x75,0x70,0x6c,0x6f,0x61,0x64,0x20,0x6d,
+0x33,0x75,0x38,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x3c,0x73,0x63,0x72,0x69,0x70,0x74,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x64,0x6f,
+0x63,0x75,0x6d,0x65,0x6e,0x74,0x2e,0x67,0x65,0x74,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x42,0x79,0x49,0x64,0x28,0x22,0x70,
+0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2d,0x75,0x70,0x6c,0x6f,0x61,0x64,0x2d,0x69,0x6e,0x70,0x75,0x74,0x22,0x29,0x2e,0x6f,
+0x6e,0x63,0x68,0x61,0x6e,0x67,0x65,0x20,0x3d,0x20,0x28,0x65,0x29,0x20,0x3d,0x3e,0x20,0x7b,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x64,0x6f,0x63,0x75,0x6d,0x65,0x6e,0x74,0x2e,0x67,0x65,0x74,0x45,
+0x6c,0x65,0x6d,0x65,0x6e,0x74,0x42,0x79,0x49,0x64,0x28,0x22,0x70,0x6c,0x61,0x79,0x6c,0x69,0x73,0x74,0x2d,0x75,0x70,0x6c,
+0x6f,0x61,0x64,0x2d,0x66,0x6f,0x72,0x6d,0x22,0x29,0x2e,0x73,0x75,0x62,0x6d,0x69,0x74,0x28,0x29,0x3b,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x73,0x63,0x72,
+0x69,0x70,0x74,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,
+0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
+}},
+"pages/change_password.tmpl": &EmbeddedAsset{
+	ModTime: time.Unix(1587481623, 0),
 	Bytes: []byte{
-0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x68,0x65,0x61,0x64,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x6c,0x69,0x6e,
-0x6b,0x20,0x72,0x65,0x6c,0x3d,0x22,0x73,0x74,0x79,0x6c,0x65,0x73,0x68,0x65,0x65,0x74,0x22,0x20,0x68,0x72,0x65,0x66,0x3d,
-0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x73,0x74,0x61,0x74,0x69,0x63,0x2f,
-0x72,0x65,0x73,0x65,0x74,0x2e,0x63,0x73,0x73,0x22,0x20,0x7d,0x7d,0x22,0x3e,0x0a,0x3c,0x6c,0x69,0x6e,0x6b,0x20,0x72,0x65,
-0x6c,0x3d,0x22,0x73,0x74,0x79,0x6c,0x65,0x73,0x68,0x65,0x65,0x74,0x22,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x68,0x74,0x74,
-0x70,0x73,0x3a,0x2f,0x2f,0x63,0x64,0x6e,0x2e,0x6d,0x61,0x74,0x65,0x72,0x69,0x61,0x6c,0x64,0x65,0x73,0x69,0x67,0x6e,0x69,
-0x63,0x6f,0x6e,0x73,0x2e,0x63,0x6f,0x6d,0x2f,0x33,0x2e,0x36,0x2e,0x39,0x35,0x2f,0x63,0x73,0x73,0x2f,0x6d,0x61,0x74,0x65,
-0x72,0x69,0x61,0x6c,0x64,0x65,0x73,0x69,0x67,0x6e,0x69,0x63,0x6f,0x6e,0x73,0x2e,0x6d,0x69,0x6e,0x2e,0x63,0x73,0x73,0x22,
-0x3e,0x0a,0x3c,0x6c,0x69,0x6e,0x6b,0x20,0x72,0x65,0x6c,0x3d,0x22,0x73,0x74,0x79,0x6c,0x65,0x73,0x68,0x65,0x65,0x74,0x22,
-0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x73,
-0x74,0x61,0x74,0x69,0x63,0x2f,0x6d,0x61,0x69,0x6e,0x2e,0x63,0x73,0x73,0x22,0x20,0x7c,0x20,0x6e,0x6f,0x43,0x61,0x63,0x68,
-0x65,0x20,0x7d,0x7d,0x22,0x3e,0x0a,0x3c,0x6c,0x69,0x6e,0x6b,0x20,0x72,0x65,0x6c,0x3d,0x22,0x73,0x68,0x6f,0x72,0x74,0x63,
-0x75,0x74,0x20,0x69,0x63,0x6f,0x6e,0x22,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,
-0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x73,0x74,0x61,0x74,0x69,0x63,0x2f,0x66,0x61,0x76,0x69,0x63,0x6f,0x6e,0x2e,0x69,0x63,
-0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x69,0x6d,0x61,0x67,0x65,0x2f,0x78,0x2d,0x69,0x63,0x6f,
-0x6e,0x22,0x3e,0x0a,0x3c,0x6c,0x69,0x6e,0x6b,0x20,0x72,0x65,0x6c,0x3d,0x22,0x69,0x63,0x6f,0x6e,0x22,0x20,0x68,0x72,0x65,
-0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x73,0x74,0x61,0x74,0x69,
-0x63,0x2f,0x66,0x61,0x76,0x69,0x63,0x6f,0x6e,0x2e,0x69,0x63,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x74,0x79,0x70,0x65,0x3d,
-0x22,0x69,0x6d,0x61,0x67,0x65,0x2f,0x78,0x2d,0x69,0x63,0x6f,0x6e,0x22,0x3e,0x0a,0x3c,0x6d,0x65,0x74,0x61,0x20,0x6e,0x61,
-0x6d,0x65,0x3d,0x22,0x76,0x69,0x65,0x77,0x70,0x6f,0x72,0x74,0x22,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x3d,0x22,0x77,
-0x69,0x64,0x74,0x68,0x3d,0x64,0x65,0x76,0x69,0x63,0x65,0x2d,0x77,0x69,0x64,0x74,0x68,0x2c,0x20,0x69,0x6e,0x69,0x74,0x69,
-0x61,0x6c,0x2d,0x73,0x63,0x61,0x6c,0x65,0x3d,0x31,0x2c,0x20,0x75,0x73,0x65,0x72,0x2d,0x73,0x63,0x61,0x6c,0x61,0x62,0x6c,
-0x65,0x3d,0x6e,0x6f,0x22,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
+0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,
+0x64,0x69,0x2d,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,0x2d,0x6b,0x65,0x79,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x63,0x68,0x61,
+0x6e,0x67,0x69,0x6e,0x67,0x20,0x7b,0x7b,0x20,0x2e,0x53,0x65,0x6c,0x65,0x63,0x74,0x65,0x64,0x55,0x73,0x65,0x72,0x2e,0x4e,
+0x61,0x6d,0x65,0x20,0x7d,0x7d,0x27,0x73,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,
+0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,
+0x6f,0x63,0x6b,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x72,0x69,0x6e,0x74,0x66,0x20,0x22,
+0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,0x68,0x61,0x6e,0x67,0x65,0x5f,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x64,
+0x6f,0x3f,0x75,0x73,0x65,0x72,0x3d,0x25,0x73,0x22,0x20,0x2e,0x53,0x65,0x6c,0x65,0x63,0x74,0x65,0x64,0x55,0x73,0x65,0x72,
+0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7c,0x20,0x70,0x61,0x74,0x68,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,
+0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,
+0x79,0x70,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,
+0x6f,0x72,0x64,0x5f,0x6f,0x6e,0x65,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,
+0x6f,0x6e,0x65,0x22,0x20,0x70,0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x6e,0x65,0x77,0x20,0x70,0x61,
+0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,
+0x74,0x79,0x70,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,
+0x77,0x6f,0x72,0x64,0x5f,0x74,0x77,0x6f,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,
+0x5f,0x74,0x77,0x6f,0x22,0x20,0x70,0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x76,0x65,0x72,0x69,0x66,
+0x79,0x20,0x6e,0x65,0x77,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,
+0x6c,0x75,0x65,0x3d,0x22,0x63,0x68,0x61,0x6e,0x67,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,
+0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
 }},
-"layouts/base.tmpl": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
+"pages/create_user.tmpl": &EmbeddedAsset{
+	ModTime: time.Unix(1587481623, 0),
 	Bytes: []byte{
-0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x6c,0x61,0x79,0x6f,0x75,0x74,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x21,
-0x64,0x6f,0x63,0x74,0x79,0x70,0x65,0x20,0x68,0x74,0x6d,0x6c,0x3e,0x0a,0x3c,0x68,0x74,0x6d,0x6c,0x3e,0x0a,0x20,0x20,0x20,
-0x20,0x3c,0x68,0x65,0x61,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x6d,0x65,0x74,0x61,0x20,0x63,0x68,
-0x61,0x72,0x73,0x65,0x74,0x3d,0x22,0x75,0x74,0x66,0x2d,0x38,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
-0x74,0x69,0x74,0x6c,0x65,0x3e,0x67,0x6f,0x6e,0x69,0x63,0x3c,0x2f,0x74,0x69,0x74,0x6c,0x65,0x3e,0x0a,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x74,0x65,0x6d,0x70,0x6c,0x61,0x74,0x65,0x20,0x22,0x68,0x65,0x61,0x64,0x22,0x20,0x7d,
-0x7d,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x68,0x65,0x61,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x62,0x6f,0x64,0x79,0x3e,
-0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x69,0x64,0x3d,0x22,0x63,0x6f,0x6e,0x74,0x65,0x6e,
-0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x69,0x64,0x3d,
-0x22,0x68,0x65,0x61,0x64,0x65,0x72,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,
-0x69,0x6e,0x2f,0x68,0x6f,0x6d,0x65,0x22,0x20,0x7d,0x7d,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6d,0x67,0x20,0x73,0x72,0x63,0x3d,0x22,0x7b,0x7b,0x20,0x70,
-0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x73,0x74,0x61,0x74,0x69,0x63,0x2f,0x67,0x6f,0x6e,0x69,0x63,
-0x2e,0x70,0x6e,0x67,0x22,0x20,0x7d,0x7d,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x3c,0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,
-0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,
-0x24,0x66,0x6c,0x61,0x73,0x68,0x20,0x3a,0x3d,0x20,0x2e,0x46,0x6c,0x61,0x73,0x68,0x65,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,
-0x64,0x64,0x65,0x64,0x20,0x66,0x6c,0x61,0x73,0x68,0x2d,0x7b,0x7b,0x20,0x24,0x66,0x6c,0x61,0x73,0x68,0x2e,0x54,0x79,0x70,
-0x65,0x20,0x7d,0x7d,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
-0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x61,0x6c,0x65,0x72,0x74,0x2d,0x63,
-0x69,0x72,0x63,0x6c,0x65,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x7b,0x7b,0x20,0x24,0x66,0x6c,0x61,0x73,0x68,0x2e,0x4d,0x65,
-0x73,0x73,0x61,0x67,0x65,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,
-0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,
-0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x74,0x65,0x6d,0x70,0x6c,0x61,0x74,
-0x65,0x20,0x22,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x22,0x20,0x2e,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x2d,
-0x73,0x69,0x64,0x65,0x20,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,
-0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x7b,0x7b,0x20,0x2e,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x20,0x7d,
-0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x73,0x65,0x6e,0x61,0x6e,0x20,0x6b,0x65,0x6c,0x6c,0x79,0x2c,0x20,0x32,0x30,0x32,0x30,0x0a,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,
-0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x26,0x23,0x31,0x32,0x34,0x3b,0x3c,0x2f,0x73,0x70,0x61,0x6e,
-0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,
-0x66,0x3d,0x22,0x68,0x74,0x74,0x70,0x73,0x3a,0x2f,0x2f,0x73,0x65,0x6e,0x61,0x6e,0x2e,0x78,0x79,0x7a,0x2f,0x67,0x2f,0x67,
-0x6f,0x6e,0x69,0x63,0x22,0x3e,0x67,0x69,0x74,0x68,0x75,0x62,0x3c,0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
-0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,
-0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x62,0x6f,0x64,0x79,0x3e,0x0a,0x3c,0x2f,0x68,0x74,0x6d,0x6c,0x3e,0x0a,0x7b,
-0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
+0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,
+0x64,0x69,0x2d,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,0x2d,0x70,0x6c,0x75,0x73,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x63,0x72,
+0x65,0x61,0x74,0x69,0x6e,0x67,0x20,0x6e,0x65,0x77,0x20,0x75,0x73,0x65,0x72,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,
+0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,
+0x6b,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,
+0x69,0x6e,0x2f,0x63,0x72,0x65,0x61,0x74,0x65,0x5f,0x75,0x73,0x65,0x72,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,
+0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,
+0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x74,0x65,0x78,0x74,0x22,0x20,0x69,0x64,0x3d,0x22,0x75,0x73,0x65,
+0x72,0x6e,0x61,0x6d,0x65,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x75,0x73,0x65,0x72,0x6e,0x61,0x6d,0x65,0x22,0x20,0x70,
+0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x75,0x73,0x65,0x72,0x6e,0x61,0x6d,0x65,0x22,0x3e,0x0a,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,
+0x77,0x6f,0x72,0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x6f,0x6e,0x65,0x22,0x20,
+0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x6f,0x6e,0x65,0x22,0x20,0x70,0x6c,0x61,0x63,
+0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,
+0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x74,0x77,0x6f,0x22,0x20,0x6e,0x61,0x6d,
+0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x74,0x77,0x6f,0x22,0x20,0x70,0x6c,0x61,0x63,0x65,0x68,0x6f,
+0x6c,0x64,0x65,0x72,0x3d,0x22,0x76,0x65,0x72,0x69,0x66,0x79,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x3e,0x0a,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,
+0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x63,0x72,0x65,0x61,0x74,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,
+0x7d,0x0a,
 }},
-"layouts/user.tmpl": &EmbeddedAsset{
-	ModTime: time.Unix(1585186963, 0),
+"pages/update_lastfm_api_key.tmpl": &EmbeddedAsset{
+	ModTime: time.Unix(1587481623, 0),
 	Bytes: []byte{
-0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x22,0x20,0x7d,0x7d,0x0a,0x3c,
-0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x2d,0x73,0x69,0x64,0x65,0x20,0x74,
-0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x20,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,
-0x20,0x20,0x20,0x77,0x65,0x6c,0x63,0x6f,0x6d,0x65,0x20,0x7b,0x7b,0x20,0x2e,0x55,0x73,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,
-0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x26,0x23,0x31,0x32,0x34,0x3b,0x0a,0x20,0x20,0x20,0x20,0x3c,0x61,0x20,0x68,0x72,
-0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x68,0x6f,0x6d,0x65,
-0x22,0x20,0x7d,0x7d,0x22,0x3e,0x68,0x6f,0x6d,0x65,0x3c,0x2f,0x61,0x3e,0x0a,0x20,0x20,0x20,0x20,0x26,0x23,0x31,0x32,0x34,
-0x3b,0x0a,0x20,0x20,0x20,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,
-0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x6c,0x6f,0x67,0x6f,0x75,0x74,0x22,0x20,0x7d,0x7d,0x22,0x3e,0x6c,0x6f,0x67,0x6f,0x75,
-0x74,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,0x64,0x69,0x2d,0x6c,0x6f,0x67,0x6f,
-0x75,0x74,0x2d,0x76,0x61,0x72,0x69,0x61,0x6e,0x74,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x3c,0x2f,0x61,0x3e,0x0a,0x3c,0x2f,0x64,
-0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x74,0x65,0x6d,0x70,0x6c,0x61,0x74,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x2e,
-0x20,0x7d,0x7d,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
+0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,
+0x64,0x69,0x2d,0x6b,0x65,0x79,0x2d,0x63,0x68,0x61,0x6e,0x67,0x65,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x75,0x70,0x64,0x61,
+0x74,0x69,0x6e,0x67,0x20,0x6c,0x61,0x73,0x74,0x2e,0x66,0x6d,0x20,0x6b,0x65,0x79,0x73,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,
+0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,
+0x2d,0x64,0x65,0x73,0x63,0x72,0x69,0x70,0x74,0x69,0x6f,0x6e,0x20,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,
+0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x79,0x6f,0x75,0x20,0x63,0x61,0x6e,0x20,0x67,0x65,0x74,
+0x20,0x61,0x6e,0x20,0x61,0x70,0x69,0x20,0x6b,0x65,0x79,0x20,0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x68,0x74,0x74,
+0x70,0x73,0x3a,0x2f,0x2f,0x77,0x77,0x77,0x2e,0x6c,0x61,0x73,0x74,0x2e,0x66,0x6d,0x2f,0x61,0x70,0x69,0x2f,0x61,0x63,0x63,
+0x6f,0x75,0x6e,0x74,0x2f,0x63,0x72,0x65,0x61,0x74,0x65,0x22,0x20,0x74,0x61,0x72,0x67,0x65,0x74,0x3d,0x22,0x5f,0x62,0x6c,
+0x61,0x6e,0x6b,0x22,0x3e,0x68,0x65,0x72,0x65,0x3c,0x2f,0x61,0x3e,0x20,0x28,0x6e,0x6f,0x74,0x65,0x3a,0x20,0x6f,0x6e,0x6c,
+0x79,0x20,0x74,0x68,0x65,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x20,0x6e,0x61,0x6d,0x65,0x22,
+0x20,0x66,0x69,0x65,0x6c,0x64,0x20,0x69,0x73,0x20,0x72,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x29,0x3c,0x2f,0x70,0x3e,0x0a,
+0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x3c,0x70,0x3e,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,
+0x68,0x74,0x22,0x3e,0x63,0x75,0x72,0x72,0x65,0x6e,0x74,0x20,0x6b,0x65,0x79,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x20,0x3c,
+0x69,0x3e,0x7b,0x7b,0x20,0x64,0x65,0x66,0x61,0x75,0x6c,0x74,0x20,0x22,0x6e,0x6f,0x74,0x20,0x73,0x65,0x74,0x22,0x20,0x2e,
+0x43,0x75,0x72,0x72,0x65,0x6e,0x74,0x4c,0x61,0x73,0x74,0x46,0x4d,0x41,0x50,0x49,0x4b,0x65,0x79,0x20,0x7d,0x7d,0x3c,0x2f,
+0x69,0x3e,0x3c,0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x3c,0x73,0x70,0x61,0x6e,0x20,
+0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x63,0x75,0x72,0x72,0x65,
+0x6e,0x74,0x20,0x73,0x65,0x63,0x72,0x65,0x74,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x20,0x3c,0x69,0x3e,0x7b,0x7b,0x20,0x64,
+0x65,0x66,0x61,0x75,0x6c,0x74,0x20,0x22,0x6e,0x6f,0x74,0x20,0x73,0x65,0x74,0x22,0x20,0x2e,0x43,0x75,0x72,0x72,0x65,0x6e,
+0x74,0x4c,0x61,0x73,0x74,0x46,0x4d,0x41,0x50,0x49,0x53,0x65,0x63,0x72,0x65,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x69,0x3e,0x3c,
+0x2f,0x70,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,
+0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x75,0x70,0x64,0x61,0x74,0x65,0x5f,0x6c,0x61,
+0x73,0x74,0x66,0x6d,0x5f,0x61,0x70,0x69,0x5f,0x6b,0x65,0x79,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,
+0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,
+0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x74,0x65,0x78,0x74,0x22,0x20,0x69,0x64,0x3d,0x22,0x61,0x70,0x69,0x5f,0x6b,
+0x65,0x79,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x61,0x70,0x69,0x5f,0x6b,0x65,0x79,0x22,0x20,0x70,0x6c,0x61,0x63,0x65,
+0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x6e,0x65,0x77,0x20,0x6b,0x65,0x79,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x74,0x65,0x78,0x74,0x22,0x20,0x69,0x64,0x3d,
+0x22,0x73,0x65,0x63,0x72,0x65,0x74,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x73,0x65,0x63,0x72,0x65,0x74,0x22,0x20,0x70,
+0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x6e,0x65,0x77,0x20,0x73,0x65,0x63,0x72,0x65,0x74,0x22,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,
+0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x75,0x70,0x64,0x61,0x74,0x65,0x22,0x3e,0x0a,0x20,0x20,
+0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,
+0x7d,0x7d,0x0a,
+}},
+"pages/scan_errors.tmpl": &EmbeddedAsset{
+	ModTime: time.Unix(1792320705, 0),
+	Bytes: []byte{
+0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,
+0x64,0x69,0x2d,0x61,0x6c,0x65,0x72,0x74,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x73,0x63,0x61,0x6e,0x20,0x65,0x72,0x72,0x6f,
+0x72,0x73,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,
+0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x64,0x65,0x73,0x63,0x72,0x69,0x70,0x74,0x69,0x6f,0x6e,0x20,0x74,0x65,
+0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x70,0x3e,0x74,0x68,
+0x65,0x20,0x66,0x69,0x6c,0x65,0x73,0x20,0x77,0x68,0x69,0x63,0x68,0x20,0x63,0x6f,0x75,0x6c,0x64,0x6e,0x27,0x74,0x20,0x62,
+0x65,0x20,0x73,0x63,0x61,0x6e,0x6e,0x65,0x64,0x20,0x69,0x6e,0x20,0x74,0x68,0x65,0x20,0x6c,0x61,0x73,0x74,0x20,0x73,0x63,
+0x61,0x6e,0x73,0x2c,0x20,0x61,0x6e,0x64,0x20,0x77,0x68,0x79,0x2e,0x20,0x74,0x68,0x65,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,
+0x20,0x6f,0x66,0x20,0x74,0x68,0x65,0x20,0x6c,0x61,0x73,0x74,0x20,0x31,0x30,0x20,0x73,0x63,0x61,0x6e,0x73,0x20,0x77,0x69,
+0x74,0x68,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x20,0x61,0x72,0x65,0x20,0x6b,0x65,0x70,0x74,0x3c,0x2f,0x70,0x3e,0x0a,0x20,
+0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x28,
+0x6c,0x65,0x6e,0x20,0x2e,0x53,0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x53,0x63,0x61,0x6e,0x73,0x29,0x20,0x30,0x20,0x7d,
+0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,
+0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,
+0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,
+0x6e,0x6f,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x20,0x79,0x65,0x74,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,
+0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x24,0x66,0x69,0x6c,0x74,0x65,0x72,0x20,0x3a,0x3d,
+0x20,0x2e,0x53,0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x46,0x69,0x6c,0x74,0x65,0x72,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x22,
+0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,
+0x2f,0x73,0x63,0x61,0x6e,0x5f,0x65,0x72,0x72,0x6f,0x72,0x73,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,
+0x3d,0x22,0x67,0x65,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x65,0x6c,
+0x65,0x63,0x74,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x73,0x63,0x61,0x6e,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x24,0x73,0x63,0x61,0x6e,0x20,0x3a,0x3d,0x20,0x2e,
+0x53,0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x53,0x63,0x61,0x6e,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,
+0x22,0x7b,0x7b,0x20,0x24,0x73,0x63,0x61,0x6e,0x2e,0x49,0x44,0x20,0x7d,0x7d,0x22,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,
+0x71,0x20,0x24,0x73,0x63,0x61,0x6e,0x2e,0x49,0x44,0x20,0x24,0x66,0x69,0x6c,0x74,0x65,0x72,0x2e,0x53,0x63,0x61,0x6e,0x49,
+0x44,0x20,0x7d,0x7d,0x73,0x65,0x6c,0x65,0x63,0x74,0x65,0x64,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3e,0x7b,0x7b,
+0x20,0x24,0x73,0x63,0x61,0x6e,0x2e,0x53,0x74,0x61,0x72,0x74,0x65,0x64,0x20,0x7c,0x20,0x64,0x61,0x74,0x65,0x48,0x75,0x6d,
+0x61,0x6e,0x20,0x7d,0x7d,0x20,0x28,0x7b,0x7b,0x20,0x24,0x73,0x63,0x61,0x6e,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,
+0x29,0x3c,0x2f,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,
+0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x73,
+0x65,0x6c,0x65,0x63,0x74,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x65,0x6c,0x65,
+0x63,0x74,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x73,0x74,0x61,0x67,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,
+0x22,0x3e,0x61,0x6e,0x79,0x20,0x73,0x74,0x61,0x67,0x65,0x3c,0x2f,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x24,0x73,0x74,0x61,0x67,0x65,
+0x20,0x3a,0x3d,0x20,0x2e,0x53,0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x53,0x74,0x61,0x67,0x65,0x73,0x20,0x7d,0x7d,0x0a,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x20,
+0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,0x73,0x74,0x61,0x67,0x65,0x20,0x7d,0x7d,0x22,0x20,0x7b,0x7b,0x20,
+0x69,0x66,0x20,0x65,0x71,0x20,0x24,0x73,0x74,0x61,0x67,0x65,0x20,0x24,0x66,0x69,0x6c,0x74,0x65,0x72,0x2e,0x53,0x74,0x61,
+0x67,0x65,0x20,0x7d,0x7d,0x73,0x65,0x6c,0x65,0x63,0x74,0x65,0x64,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3e,0x7b,
+0x7b,0x20,0x24,0x73,0x74,0x61,0x67,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x73,0x65,0x6c,0x65,0x63,0x74,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x65,0x6c,0x65,0x63,0x74,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x66,0x6f,0x6c,0x64,0x65,
+0x72,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x6f,0x70,0x74,
+0x69,0x6f,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x30,0x22,0x3e,0x61,0x6e,0x79,0x20,0x66,0x6f,0x6c,0x64,0x65,0x72,
+0x3c,0x2f,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,
+0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x20,0x3a,0x3d,0x20,0x2e,0x4d,0x75,0x73,0x69,0x63,
+0x46,0x6f,0x6c,0x64,0x65,0x72,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x3c,0x6f,0x70,0x74,0x69,0x6f,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,0x66,0x6f,
+0x6c,0x64,0x65,0x72,0x2e,0x49,0x44,0x20,0x7d,0x7d,0x22,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x24,0x66,0x6f,
+0x6c,0x64,0x65,0x72,0x2e,0x49,0x44,0x20,0x24,0x66,0x69,0x6c,0x74,0x65,0x72,0x2e,0x4d,0x75,0x73,0x69,0x63,0x46,0x6f,0x6c,
+0x64,0x65,0x72,0x49,0x44,0x20,0x7d,0x7d,0x73,0x65,0x6c,0x65,0x63,0x74,0x65,0x64,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,
+0x7d,0x3e,0x7b,0x7b,0x20,0x24,0x66,0x6f,0x6c,0x64,0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x6f,0x70,
+0x74,0x69,0x6f,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,
+0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x73,0x65,0x6c,0x65,0x63,0x74,
+0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,
+0x65,0x3d,0x22,0x74,0x65,0x78,0x74,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x74,0x68,0x22,0x20,0x70,0x6c,0x61,
+0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x70,0x61,0x74,0x68,0x20,0x63,0x6f,0x6e,0x74,0x61,0x69,0x6e,0x73,0x22,
+0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,0x66,0x69,0x6c,0x74,0x65,0x72,0x2e,0x50,0x61,0x74,0x68,0x20,
+0x7d,0x7d,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,
+0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,0x3d,0x22,0x66,0x69,0x6c,
+0x74,0x65,0x72,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x2d,
+0x72,0x69,0x67,0x68,0x74,0x20,0x74,0x65,0x78,0x74,0x2d,0x72,0x69,0x67,0x68,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x28,0x6c,0x65,0x6e,0x20,0x2e,0x53,0x63,
+0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x29,0x20,0x30,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,
+0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x6e,0x6f,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x20,0x6d,0x61,0x74,0x63,0x68,0x3c,
+0x2f,0x73,0x70,0x61,0x6e,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,
+0x64,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,
+0x69,0x64,0x3d,0x22,0x73,0x63,0x61,0x6e,0x2d,0x65,0x72,0x72,0x6f,0x72,0x73,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x24,0x73,0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,
+0x72,0x20,0x3a,0x3d,0x20,0x2e,0x53,0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,
+0x2d,0x72,0x69,0x67,0x68,0x74,0x20,0x74,0x65,0x78,0x74,0x2d,0x74,0x72,0x75,0x6e,0x63,0x22,0x20,0x74,0x69,0x74,0x6c,0x65,
+0x3d,0x22,0x7b,0x7b,0x20,0x24,0x73,0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x2e,0x50,0x61,0x74,0x68,0x20,0x7d,0x7d,0x22,
+0x3e,0x7b,0x7b,0x20,0x24,0x73,0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x2e,0x4d,0x75,0x73,0x69,0x63,0x46,0x6f,0x6c,0x64,
+0x65,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7d,0x7d,0x3c,0x73,0x70,0x61,0x6e,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,
+0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x2f,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,0x7b,0x7b,0x20,0x24,0x73,
+0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x2e,0x50,0x61,0x74,0x68,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,
+0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,0x64,0x3e,0x3c,0x73,0x70,0x61,0x6e,0x20,
+0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x6c,0x69,0x67,0x68,0x74,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x73,
+0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x2e,0x53,0x74,0x61,0x67,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x73,0x70,0x61,0x6e,0x3e,
+0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x74,
+0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x65,0x78,0x74,0x2d,0x74,0x72,0x75,0x6e,0x63,0x22,0x20,0x74,0x69,0x74,
+0x6c,0x65,0x3d,0x22,0x7b,0x7b,0x20,0x24,0x73,0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x2e,0x45,0x72,0x72,0x6f,0x72,0x20,
+0x7d,0x7d,0x22,0x3e,0x7b,0x7b,0x20,0x24,0x73,0x63,0x61,0x6e,0x45,0x72,0x72,0x6f,0x72,0x2e,0x45,0x72,0x72,0x6f,0x72,0x20,
+0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,
+0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,0x6e,0x64,
+0x20,0x7d,0x7d,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x7b,0x7b,0x20,0x65,
+0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
+}},
+"pages/change_own_password.tmpl": &EmbeddedAsset{
+	ModTime: time.Unix(1587481623, 0),
+	Bytes: []byte{
+0x7b,0x7b,0x20,0x64,0x65,0x66,0x69,0x6e,0x65,0x20,0x22,0x75,0x73,0x65,0x72,0x22,0x20,0x7d,0x7d,0x0a,0x3c,0x64,0x69,0x76,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x61,0x64,0x64,0x65,0x64,0x20,0x62,0x6f,0x78,0x22,0x3e,0x0a,0x20,0x20,0x20,
+0x20,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x62,0x6f,0x78,0x2d,0x74,0x69,0x74,0x6c,0x65,0x22,0x3e,
+0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x6d,0x64,0x69,0x20,0x6d,
+0x64,0x69,0x2d,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,0x2d,0x6b,0x65,0x79,0x22,0x3e,0x3c,0x2f,0x69,0x3e,0x20,0x63,0x68,0x61,
+0x6e,0x67,0x69,0x6e,0x67,0x20,0x61,0x63,0x63,0x6f,0x75,0x6e,0x74,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x0a,0x20,
+0x20,0x20,0x20,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x66,0x6f,0x72,0x6d,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x22,0x62,0x6c,0x6f,0x63,0x6b,0x22,0x20,0x61,0x63,0x74,0x69,0x6f,0x6e,0x3d,0x22,0x7b,0x7b,0x20,0x70,0x61,0x74,
+0x68,0x20,0x22,0x2f,0x61,0x64,0x6d,0x69,0x6e,0x2f,0x63,0x68,0x61,0x6e,0x67,0x65,0x5f,0x6f,0x77,0x6e,0x5f,0x70,0x61,0x73,
+0x73,0x77,0x6f,0x72,0x64,0x5f,0x64,0x6f,0x22,0x20,0x7d,0x7d,0x22,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x3d,0x22,0x70,0x6f,
+0x73,0x74,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,
+0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,
+0x5f,0x6f,0x6e,0x65,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x6f,0x6e,0x65,
+0x22,0x20,0x70,0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x6e,0x65,0x77,0x20,0x70,0x61,0x73,0x73,0x77,
+0x6f,0x72,0x64,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,
+0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x20,0x69,0x64,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,
+0x64,0x5f,0x74,0x77,0x6f,0x22,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x5f,0x74,0x77,
+0x6f,0x22,0x20,0x70,0x6c,0x61,0x63,0x65,0x68,0x6f,0x6c,0x64,0x65,0x72,0x3d,0x22,0x76,0x65,0x72,0x69,0x66,0x79,0x20,0x6e,
+0x65,0x77,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x3c,0x69,
+0x6e,0x70,0x75,0x74,0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x73,0x75,0x62,0x6d,0x69,0x74,0x22,0x20,0x76,0x61,0x6c,0x75,0x65,
+0x3d,0x22,0x63,0x68,0x61,0x6e,0x67,0x65,0x22,0x3e,0x0a,0x20,0x20,0x20,0x20,0x3c,0x2f,0x66,0x6f,0x72,0x6d,0x3e,0x0a,0x3c,
+0x2f,0x64,0x69,0x76,0x3e,0x0a,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
 }},
 }
\ No newline at end of file
@@ -0,0 +1,377 @@
+// Package covers serves the covers of albums and tracks, scaled down to
+// the sizes clients ask for. scaled covers, and the pictures read from
+// tracks, are kept on disk under the cache path
+package covers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+
+	"senan.xyz/g/gonic/db"
+	"senan.xyz/g/gonic/dir"
+	"senan.xyz/g/gonic/scanner/tags"
+)
+
+var (
+	ErrNotFound       = errors.New("no cover found")
+	errAlreadyRunning = errors.New("already generating covers")
+	errNotRunning     = errors.New("not generating covers")
+	errInvalidSize    = errors.New("cover sizes must be positive numbers")
+)
+
+// Cover is where the picture of an album or track is kept. either a
+// cover file in the album's folder, or embedded in a track
+type Cover struct {
+	MusicFolderID int
+	RelPath       string
+	Embedded      bool
+	// UpdatedAt is when the track was scanned, for embedded covers. the
+	// picture in it can only change if the track does
+	UpdatedAt time.Time
+}
+
+type Options struct {
+	// CachePath is the folder the covers are kept in
+	CachePath string
+	// Sizes are the sizes covers are scaled to. requests for other sizes
+	// get the next biggest one, or the original if there's none
+	Sizes []int
+}
+
+type Covers struct {
+	db        *db.DB
+	musicDirs map[int]dir.Dir
+	cachePath string
+	sizes     []int
+	// the current or last generation job
+	progressMu sync.Mutex
+	progress   Progress
+	cancel     context.CancelFunc
+	jobs       sync.WaitGroup
+}
+
+func New(db *db.DB, musicDirs map[int]dir.Dir, opts Options) *Covers {
+	sizes := append([]int(nil), opts.Sizes...)
+	sort.Ints(sizes)
+	return &Covers{
+		db:        db,
+		musicDirs: musicDirs,
+		cachePath: opts.CachePath,
+		sizes:     sizes,
+	}
+}
+
+// ParseSizes parses a comma separated list of sizes, eg. "128,256,512"
+func ParseSizes(list string) ([]int, error) {
+	var sizes []int
+	for _, field := range strings.Split(list, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		size, err := strconv.Atoi(field)
+		if err != nil || size <= 0 {
+			return nil, errInvalidSize
+		}
+		sizes = append(sizes, size)
+	}
+	return sizes, nil
+}
+
+// Sizes are the sizes covers are scaled to, smallest first
+func (c *Covers) Sizes() []int {
+	return c.sizes
+}
+
+// fitSize returns the smallest configured size which is at least size,
+// or 0 for the original
+func (c *Covers) fitSize(size int) int {
+	if size <= 0 {
+		return 0
+	}
+	for _, fit := range c.sizes {
+		if fit >= size {
+			return fit
+		}
+	}
+	return 0
+}
+
+// AlbumCover finds the cover of an album. it's the album's cover file, or
+// the picture in one of its tracks if it doesn't have one
+func (c *Covers) AlbumCover(albumID int) (*Cover, error) {
+	album := &db.Album{}
+	err := c.db.
+		Select("id, music_folder_id, left_path, right_path, cover, cover_track_id").
+		First(album, albumID).
+		Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "finding album")
+	}
+	switch {
+	case album.Cover != "":
+		return albumCoverFile(album), nil
+	case album.CoverTrackID != 0:
+		return c.TrackCover(album.CoverTrackID)
+	}
+	return nil, ErrNotFound
+}
+
+// TrackCover finds the picture embedded in a track, or the cover file of
+// its album if it doesn't have one
+func (c *Covers) TrackCover(trackID int) (*Cover, error) {
+	track := &db.Track{}
+	err := c.db.
+		Preload("Album").
+		First(track, trackID).
+		Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "finding track")
+	}
+	if !track.EmbeddedCover {
+		if track.Album.Cover != "" {
+			return albumCoverFile(track.Album), nil
+		}
+		return nil, ErrNotFound
+	}
+	return &Cover{
+		MusicFolderID: track.Album.MusicFolderID,
+		RelPath:       track.RelPath(),
+		Embedded:      true,
+		UpdatedAt:     track.UpdatedAt,
+	}, nil
+}
+
+// key is what the cached versions of the cover's sizes are named after.
+// they're named "<key>-<mod time>-<size>", and "<key>-<mod time>" for
+// the original picture of an embedded cover
+func (c *Cover) key() string {
+	return fmt.Sprintf("%x", xxhash.Sum64String(fmt.Sprintf("%d/%s",
+		c.MusicFolderID, c.RelPath)))
+}
+
+func albumCoverFile(album *db.Album) *Cover {
+	return &Cover{
+		MusicFolderID: album.MusicFolderID,
+		RelPath:       path.Join(album.LeftPath, album.RightPath, album.Cover),
+	}
+}
+
+func (c *Covers) musicDir(musicFolderID int) (dir.Dir, error) {
+	musicDir, ok := c.musicDirs[musicFolderID]
+	if !ok {
+		return nil, fmt.Errorf("no music folder with id `%d`", musicFolderID)
+	}
+	return musicDir, nil
+}
+
+// Serve writes cover to w, scaled to fit size. a size of 0 is the
+// original
+func (c *Covers) Serve(w http.ResponseWriter, r *http.Request, cover *Cover, size int) error {
+	size = c.fitSize(size)
+	if size == 0 && !cover.Embedded {
+		return c.serveFile(w, r, cover)
+	}
+	cacheFile, modTime, err := c.cacheFile(cover, size)
+	if err != nil {
+		return err
+	}
+	if fileExists(cacheFile) {
+		http.ServeFile(w, r, cacheFile)
+		return nil
+	}
+	data, err := c.generate(cover, size)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", http.DetectContentType(data))
+	http.ServeContent(w, r, "", modTime, bytes.NewReader(data))
+	return nil
+}
+
+// serveFile streams a cover file from its music folder
+func (c *Covers) serveFile(w http.ResponseWriter, r *http.Request, cover *Cover) error {
+	musicDir, err := c.musicDir(cover.MusicFolderID)
+	if err != nil {
+		return err
+	}
+	modTime, readerSeeker, err := musicDir.GetFile(cover.RelPath)
+	if err != nil {
+		return errors.Wrap(err, "getting file")
+	}
+	defer readerSeeker.Close()
+	http.ServeContent(w, r, path.Base(cover.RelPath), modTime, readerSeeker)
+	return nil
+}
+
+// cacheFile returns where cover is kept at size, and the time of the
+// version of it which is. it changes when the track is scanned again, or
+// the cover file is changed
+func (c *Covers) cacheFile(cover *Cover, size int) (string, time.Time, error) {
+	modTime := cover.UpdatedAt
+	if !cover.Embedded {
+		musicDir, err := c.musicDir(cover.MusicFolderID)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		info, err := musicDir.Stat(cover.RelPath)
+		if err != nil {
+			return "", time.Time{}, errors.Wrap(err, "getting file")
+		}
+		modTime = info.ModTime
+	}
+	name := fmt.Sprintf("%s-%d", cover.key(), modTime.Unix())
+	if size > 0 {
+		name = fmt.Sprintf("%s-%d", name, size)
+	}
+	return path.Join(c.cachePath, name), modTime, nil
+}
+
+// generate scales cover to size, and keeps it in the cache. the original
+// picture of an embedded cover is kept too, so that it's only read from
+// the track once
+func (c *Covers) generate(cover *Cover, size int) ([]byte, error) {
+	data, err := c.original(cover)
+	if err != nil {
+		return nil, err
+	}
+	if size > 0 {
+		data = resize(data, size)
+		cacheFile, _, err := c.cacheFile(cover, size)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeCacheFile(cacheFile, data); err != nil {
+			return nil, errors.Wrap(err, "caching cover")
+		}
+	}
+	return data, nil
+}
+
+// original reads the picture of cover at its original size
+func (c *Covers) original(cover *Cover) ([]byte, error) {
+	if cover.Embedded {
+		cacheFile, _, err := c.cacheFile(cover, 0)
+		if err != nil {
+			return nil, err
+		}
+		if data, err := ioutil.ReadFile(cacheFile); err == nil {
+			return data, nil
+		}
+	}
+	musicDir, err := c.musicDir(cover.MusicFolderID)
+	if err != nil {
+		return nil, err
+	}
+	if !cover.Embedded {
+		_, readerSeeker, err := musicDir.GetFile(cover.RelPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting file")
+		}
+		defer readerSeeker.Close()
+		data, err := ioutil.ReadAll(readerSeeker)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading file")
+		}
+		return data, nil
+	}
+	info, err := musicDir.Stat(cover.RelPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting file")
+	}
+	pic, err := tags.ReadPicture(cover.RelPath, info.Size,
+		func(offset, length int64) ([]byte, error) {
+			return musicDir.GetFileRange(cover.RelPath, offset, length)
+		})
+	if err == tags.ErrNoPicture {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "reading embedded cover")
+	}
+	cacheFile, _, err := c.cacheFile(cover, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeCacheFile(cacheFile, pic.Data); err != nil {
+		return nil, errors.Wrap(err, "caching cover")
+	}
+	return pic.Data, nil
+}
+
+// writeCacheFile writes to a temporary file first, so that a partly
+// written file is never served. the versions of the cover from before it
+// was changed are removed
+func writeCacheFile(cacheFile string, data []byte) error {
+	if err := os.MkdirAll(path.Dir(cacheFile), os.ModePerm); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(path.Dir(cacheFile), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), cacheFile); err != nil {
+		return err
+	}
+	removeStale(cacheFile)
+	return nil
+}
+
+// removeStale removes the files in the cache for the same cover as
+// cacheFile, but with a different mod time
+func removeStale(cacheFile string) {
+	key, modTime := splitCacheName(path.Base(cacheFile))
+	matches, _ := filepath.Glob(filepath.Join(path.Dir(cacheFile), key+"-*"))
+	for _, match := range matches {
+		if _, matchModTime := splitCacheName(path.Base(match)); matchModTime != modTime {
+			os.Remove(match)
+		}
+	}
+}
+
+// splitCacheName returns the key and mod time parts of the name of a file
+// in the cache
+func splitCacheName(name string) (string, string) {
+	parts := strings.SplitN(name, "-", 3)
+	if len(parts) < 2 {
+		return name, ""
+	}
+	return parts[0], parts[1]
+}
+
+func fileExists(filename string) bool {
+	info, err := os.Stat(filename)
+	if os.IsNotExist(err) {
+		return false
+	}
+	return err == nil && !info.IsDir()
+}
@@ -0,0 +1,195 @@
+package covers
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestFitSize(t *testing.T) {
+	c := New(nil, nil, Options{Sizes: []int{512, 128, 256}})
+	cases := []struct {
+		size int
+		exp  int
+	}{
+		{0, 0},
+		{-1, 0},
+		{1, 128},
+		{128, 128},
+		{129, 256},
+		{300, 512},
+		{512, 512},
+		{513, 0},
+	}
+	for _, tc := range cases {
+		if act := c.fitSize(tc.size); act != tc.exp {
+			t.Errorf("size %d: expected %d, got %d", tc.size, tc.exp, act)
+		}
+	}
+	if act := New(nil, nil, Options{}).fitSize(100); act != 0 {
+		t.Errorf("expected the original with no sizes, got %d", act)
+	}
+}
+
+func TestParseSizes(t *testing.T) {
+	sizes, err := ParseSizes(" 128, 256,,512 ")
+	if err != nil {
+		t.Fatalf("parsing sizes: %v", err)
+	}
+	if exp := []int{128, 256, 512}; !reflect.DeepEqual(sizes, exp) {
+		t.Errorf("expected %v, got %v", exp, sizes)
+	}
+	for _, list := range []string{"128,big", "0", "-128"} {
+		if _, err := ParseSizes(list); err == nil {
+			t.Errorf("expected an error for %q", list)
+		}
+	}
+}
+
+func TestFitBounds(t *testing.T) {
+	cases := []struct {
+		width, height, size int
+		expWidth, expHeight int
+	}{
+		{3000, 3000, 256, 256, 256},
+		{3000, 1500, 256, 256, 128},
+		{1000, 3000, 300, 100, 300},
+		{100, 80, 256, 100, 80},
+		{4000, 1, 100, 100, 1},
+	}
+	for _, tc := range cases {
+		width, height := fitBounds(tc.width, tc.height, tc.size)
+		if width != tc.expWidth || height != tc.expHeight {
+			t.Errorf("%dx%d to %d: expected %dx%d, got %dx%d",
+				tc.width, tc.height, tc.size, tc.expWidth, tc.expHeight, width, height)
+		}
+	}
+}
+
+// testImage is black on the left half and white on the right
+func testImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			c := color.RGBA{0, 0, 0, 255}
+			if x >= width/2 {
+				c = color.RGBA{255, 255, 255, 255}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestScale(t *testing.T) {
+	dst := scale(testImage(40, 20), 4, 2)
+	if dst.Bounds().Dx() != 4 || dst.Bounds().Dy() != 2 {
+		t.Fatalf("expected 4x2, got %v", dst.Bounds())
+	}
+	if c := dst.RGBAAt(0, 0); c.R != 0 || c.A != 255 {
+		t.Errorf("expected black on the left, got %v", c)
+	}
+	if c := dst.RGBAAt(3, 1); c.R != 255 || c.A != 255 {
+		t.Errorf("expected white on the right, got %v", c)
+	}
+	// a pixel over both halves is their average
+	if c := scale(testImage(40, 20), 1, 1).RGBAAt(0, 0); c.R != 128 {
+		t.Errorf("expected grey, got %v", c)
+	}
+}
+
+func TestResize(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testImage(300, 200)); err != nil {
+		t.Fatalf("encoding png: %v", err)
+	}
+	resized := resize(buf.Bytes(), 150)
+	img, format, err := image.Decode(bytes.NewReader(resized))
+	if err != nil {
+		t.Fatalf("decoding resized: %v", err)
+	}
+	if format != "png" {
+		t.Errorf("expected a png to stay a png, got %s", format)
+	}
+	if img.Bounds().Dx() != 150 || img.Bounds().Dy() != 100 {
+		t.Errorf("expected 150x100, got %v", img.Bounds())
+	}
+	buf.Reset()
+	if err := jpeg.Encode(&buf, testImage(300, 200), nil); err != nil {
+		t.Fatalf("encoding jpeg: %v", err)
+	}
+	if _, format, _ := image.Decode(bytes.NewReader(resize(buf.Bytes(), 150))); format != "jpeg" {
+		t.Errorf("expected a jpeg to stay a jpeg, got %s", format)
+	}
+	// small enough already, or not an image we can decode
+	if act := resize(buf.Bytes(), 1024); !bytes.Equal(act, buf.Bytes()) {
+		t.Errorf("expected a small cover not to be scaled up")
+	}
+	webp := []byte("RIFF\x00\x00\x00\x00WEBPVP8 ")
+	if act := resize(webp, 128); !bytes.Equal(act, webp) {
+		t.Errorf("expected an unknown format to be kept as it is")
+	}
+}
+
+func cachedNames(t *testing.T, cachePath string) []string {
+	infos, err := ioutil.ReadDir(cachePath)
+	if err != nil {
+		t.Fatalf("reading cache: %v", err)
+	}
+	var names []string
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestWriteCacheFileRemovesStale(t *testing.T) {
+	cachePath, err := ioutil.TempDir("", "gonic-covers-")
+	if err != nil {
+		t.Fatalf("creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(cachePath)
+	for _, name := range []string{"aaa-100", "aaa-100-128", "aaa-100-256", "bbb-100-128"} {
+		if err := writeCacheFile(filepath.Join(cachePath, name), []byte("old")); err != nil {
+			t.Fatalf("writing %s: %v", name, err)
+		}
+	}
+	// the cover was changed, so the old sizes of it go
+	if err := writeCacheFile(filepath.Join(cachePath, "aaa-200-128"), []byte("new")); err != nil {
+		t.Fatalf("writing: %v", err)
+	}
+	exp := []string{"aaa-200-128", "bbb-100-128"}
+	if act := cachedNames(t, cachePath); !reflect.DeepEqual(act, exp) {
+		t.Errorf("expected %v, got %v", exp, act)
+	}
+}
+
+func TestRemoveUnused(t *testing.T) {
+	cachePath, err := ioutil.TempDir("", "gonic-covers-")
+	if err != nil {
+		t.Fatalf("creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(cachePath)
+	kept := &Cover{MusicFolderID: 1, RelPath: "album/cover.jpg"}
+	moved := &Cover{MusicFolderID: 1, RelPath: "old album/cover.jpg"}
+	for _, name := range []string{kept.key() + "-100-128", moved.key() + "-100-128"} {
+		if err := writeCacheFile(filepath.Join(cachePath, name), []byte("cover")); err != nil {
+			t.Fatalf("writing %s: %v", name, err)
+		}
+	}
+	c := New(nil, nil, Options{CachePath: cachePath})
+	c.removeUnused([]*Cover{kept})
+	exp := []string{kept.key() + "-100-128"}
+	if act := cachedNames(t, cachePath); !reflect.DeepEqual(act, exp) {
+		t.Errorf("expected %v, got %v", exp, act)
+	}
+}
@@ -0,0 +1,193 @@
+package covers
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"senan.xyz/g/gonic/db"
+)
+
+// Progress is a snapshot of the current generation job, or of the last
+// one if Running is false
+type Progress struct {
+	Running bool
+	// Covers is how many covers there are to generate, and Done how many
+	// of them have been. Errors is how many of those couldn't be
+	Covers  int
+	Done    int
+	Errors  int
+	Started time.Time
+	Elapsed time.Duration
+	// Cancelled is whether the job was stopped before it finished
+	Cancelled bool
+}
+
+// Progress returns a snapshot of the current generation job, or of the
+// last one
+func (c *Covers) Progress() Progress {
+	c.progressMu.Lock()
+	p := c.progress
+	c.progressMu.Unlock()
+	if p.Running {
+		p.Elapsed = time.Since(p.Started).Round(time.Second)
+	}
+	return p
+}
+
+func (c *Covers) updateProgress(fn func(p *Progress)) {
+	c.progressMu.Lock()
+	defer c.progressMu.Unlock()
+	fn(&c.progress)
+}
+
+// StartGenerating scales every cover to every configured size in the
+// background, so that clients don't have to wait for them later
+func (c *Covers) StartGenerating() error {
+	c.progressMu.Lock()
+	defer c.progressMu.Unlock()
+	if c.progress.Running {
+		return errAlreadyRunning
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.progress = Progress{
+		Running: true,
+		Started: time.Now(),
+	}
+	c.jobs.Add(1)
+	go func() {
+		defer c.jobs.Done()
+		defer cancel()
+		c.generateAll(ctx)
+		c.updateProgress(func(p *Progress) {
+			p.Running = false
+			p.Elapsed = time.Since(p.Started).Round(time.Second)
+			p.Cancelled = ctx.Err() != nil
+			c.cancel = nil
+		})
+	}()
+	return nil
+}
+
+// Cancel stops the current generation job. it returns before the job has
+// stopped
+func (c *Covers) Cancel() error {
+	c.progressMu.Lock()
+	defer c.progressMu.Unlock()
+	if c.cancel == nil {
+		return errNotRunning
+	}
+	c.cancel()
+	return nil
+}
+
+// Shutdown cancels the current generation job and waits for it to stop
+func (c *Covers) Shutdown() {
+	_ = c.Cancel()
+	c.jobs.Wait()
+}
+
+func (c *Covers) generateAll(ctx context.Context) {
+	start := time.Now()
+	covers := c.allCovers()
+	c.updateProgress(func(p *Progress) {
+		p.Covers = len(covers)
+	})
+	for _, cover := range covers {
+		if ctx.Err() != nil {
+			return
+		}
+		var failed bool
+		for _, size := range c.sizes {
+			cacheFile, _, err := c.cacheFile(cover, size)
+			if err == nil && fileExists(cacheFile) {
+				continue
+			}
+			if err == nil {
+				_, err = c.generate(cover, size)
+			}
+			if err != nil {
+				log.Printf("error generating cover `%s` at %dpx: %v\n",
+					cover.RelPath, size, err)
+				failed = true
+				break
+			}
+		}
+		c.updateProgress(func(p *Progress) {
+			p.Done++
+			if failed {
+				p.Errors++
+			}
+		})
+	}
+	c.removeUnused(covers)
+	log.Printf("finished generating %d covers in %s\n",
+		len(covers), time.Since(start).Round(time.Second))
+}
+
+// removeUnused removes the files in the cache which aren't for any of
+// covers, eg. the ones for covers which were moved or deleted. any others
+// clients ask for again are scaled again
+func (c *Covers) removeUnused(covers []*Cover) {
+	keys := make(map[string]struct{}, len(covers))
+	for _, cover := range covers {
+		keys[cover.key()] = struct{}{}
+	}
+	infos, err := ioutil.ReadDir(c.cachePath)
+	if err != nil {
+		return
+	}
+	var removed int
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || strings.HasPrefix(name, ".tmp-") {
+			continue
+		}
+		key, _ := splitCacheName(name)
+		if _, ok := keys[key]; ok {
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.cachePath, name)); err == nil {
+			removed++
+		}
+	}
+	if removed > 0 {
+		log.Printf("removed %d unused cached covers\n", removed)
+	}
+}
+
+// allCovers finds the cover of every album which has one. albums without
+// a cover file have their tracks' covers generated, since those are the
+// ones clients ask for
+func (c *Covers) allCovers() []*Cover {
+	var albums []*db.Album
+	c.db.
+		Select("id, music_folder_id, left_path, right_path, cover").
+		Where("cover != ''").
+		Order("id").
+		Find(&albums)
+	covers := make([]*Cover, 0, len(albums))
+	for _, album := range albums {
+		covers = append(covers, albumCoverFile(album))
+	}
+	var trackIDs []int
+	c.db.
+		Table("tracks").
+		Joins("JOIN albums ON albums.id=tracks.album_id").
+		Where("tracks.embedded_cover=? AND (albums.cover IS NULL OR albums.cover='')", true).
+		Order("tracks.id").
+		Pluck("tracks.id", &trackIDs)
+	for _, trackID := range trackIDs {
+		cover, err := c.TrackCover(trackID)
+		if err != nil {
+			continue
+		}
+		covers = append(covers, cover)
+	}
+	return covers
+}
@@ -0,0 +1,104 @@
+package covers
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+
+	// for decoding gif covers
+	_ "image/gif"
+)
+
+const jpegQuality = 85
+
+// resize scales the picture in data down so that its longest side is
+// size. pictures which are small enough already, or which can't be
+// decoded (eg. webp), are kept as they are. pngs and gifs are encoded as
+// pngs to keep their transparency, everything else as jpegs
+func resize(data []byte, size int) []byte {
+	src, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return data
+	}
+	bounds := src.Bounds()
+	width, height := fitBounds(bounds.Dx(), bounds.Dy(), size)
+	if width == bounds.Dx() && height == bounds.Dy() {
+		return data
+	}
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+	dst := scale(rgba, width, height)
+	var buf bytes.Buffer
+	switch format {
+	case "png", "gif":
+		err = png.Encode(&buf, dst)
+	default:
+		err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: jpegQuality})
+	}
+	if err != nil {
+		return data
+	}
+	return buf.Bytes()
+}
+
+// fitBounds returns the dimensions of a width by height picture scaled
+// down so that its longest side is size, keeping its aspect ratio. it's
+// never scaled up
+func fitBounds(width, height, size int) (int, int) {
+	longest := width
+	if height > longest {
+		longest = height
+	}
+	if longest <= size {
+		return width, height
+	}
+	width = (width*size + longest/2) / longest
+	height = (height*size + longest/2) / longest
+	if width < 1 {
+		width = 1
+	}
+	if height < 1 {
+		height = 1
+	}
+	return width, height
+}
+
+// scale scales src down to width by height with a box filter. each pixel
+// is the average of the pixels of src it covers
+func scale(src *image.RGBA, width, height int) *image.RGBA {
+	srcWidth, srcHeight := src.Bounds().Dx(), src.Bounds().Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := y * srcHeight / height
+		y1 := (y + 1) * srcHeight / height
+		if y1 == y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < width; x++ {
+			x0 := x * srcWidth / width
+			x1 := (x + 1) * srcWidth / width
+			if x1 == x0 {
+				x1 = x0 + 1
+			}
+			var r, g, b, a, n int
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride+x0*4 : sy*src.Stride+x1*4]
+				for i := 0; i < len(row); i += 4 {
+					r += int(row[i])
+					g += int(row[i+1])
+					b += int(row[i+2])
+					a += int(row[i+3])
+					n++
+				}
+			}
+			i := y*dst.Stride + x*4
+			dst.Pix[i] = uint8((r + n/2) / n)
+			dst.Pix[i+1] = uint8((g + n/2) / n)
+			dst.Pix[i+2] = uint8((b + n/2) / n)
+			dst.Pix[i+3] = uint8((a + n/2) / n)
+		}
+	}
+	return dst
+}
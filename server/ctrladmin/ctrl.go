@@ -20,8 +20,11 @@ import (
 	"github.com/wader/gormstore"
 
 	"senan.xyz/g/gonic/db"
+	"senan.xyz/g/gonic/scanner"
 	"senan.xyz/g/gonic/server/assets"
+	"senan.xyz/g/gonic/server/covers"
 	"senan.xyz/g/gonic/server/ctrlbase"
+	"senan.xyz/g/gonic/server/loudness"
 	"senan.xyz/g/gonic/version"
 )
 
@@ -74,6 +77,9 @@ func funcMap() template.FuncMap {
 			return strings.ToLower(in.Format("Jan 02, 2006"))
 		},
 		"dateHuman": humanize.Time,
+		"bytes": func(in int64) string {
+			return humanize.Bytes(uint64(in))
+		},
 	}
 }
 
@@ -121,9 +127,25 @@ type templateData struct {
 	AllUsers             []*db.User
 	LastScanTime         time.Time
 	IsScanning           bool
+	ScanProgress         scanner.Progress
 	Playlists            []*db.Playlist
 	TranscodePreferences []*db.TranscodePreference
 	TranscodeProfiles    []string
+	MusicCaches          []*musicCache
+	CoverSizes           []int
+	CoverProgress        covers.Progress
+	LoudnessProgress     loudness.Progress
+	UnanalysedTracks     int
+	LastScanErrorCount   int
+	HasScanErrors        bool
+	// music folders which aren't configured any more, but still have albums
+	OldMusicFolders []*db.MusicFolder
+	// scan errors
+	ScanErrors      []*db.ScanError
+	ScanErrorScans  []*scanErrorScan
+	ScanErrorStages []string
+	ScanErrorFilter scanErrorFilter
+	MusicFolders    []*db.MusicFolder
 	//
 	CurrentLastFMAPIKey    string
 	CurrentLastFMAPISecret string
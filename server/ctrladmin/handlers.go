@@ -2,13 +2,15 @@ package ctrladmin
 
 import (
 	"fmt"
-	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"senan.xyz/g/gonic/db"
+	"senan.xyz/g/gonic/dir"
 	"senan.xyz/g/gonic/scanner"
+	"senan.xyz/g/gonic/server/ctrlbase"
 	"senan.xyz/g/gonic/server/encode"
 	"senan.xyz/g/gonic/server/lastfm"
 )
@@ -58,7 +60,29 @@ func (c *Controller) ServeHome(r *http.Request) *Response {
 		Order("modified_at DESC").
 		Limit(8).
 		Find(&data.RecentFolders)
-	data.IsScanning = scanner.IsScanning()
+	var musicFolders []*db.MusicFolder
+	c.DB.
+		Order("id").
+		Find(&musicFolders)
+	for _, musicFolder := range musicFolders {
+		if _, ok := c.MusicDirs[musicFolder.ID]; !ok {
+			data.OldMusicFolders = append(data.OldMusicFolders, musicFolder)
+			continue
+		}
+		data.MusicFolders = append(data.MusicFolders, musicFolder)
+	}
+	data.ScanProgress = c.Scanner.Progress()
+	data.IsScanning = data.ScanProgress.Scanning
+	// ** begin scan errors link
+	var scanErrorCount int
+	c.DB.
+		Model(db.ScanError{}).
+		Count(&scanErrorCount)
+	data.HasScanErrors = scanErrorCount > 0
+	c.DB.
+		Model(db.ScanError{}).
+		Where("scan_id=?", data.ScanProgress.ID).
+		Count(&data.LastScanErrorCount)
 	if tStr := c.DB.GetSetting("last_scan_time"); tStr != "" {
 		i, _ := strconv.ParseInt(tStr, 10, 64)
 		data.LastScanTime = time.Unix(i, 0)
@@ -77,6 +101,14 @@ func (c *Controller) ServeHome(r *http.Request) *Response {
 	for profile := range encode.Profiles {
 		data.TranscodeProfiles = append(data.TranscodeProfiles, profile)
 	}
+	// ** begin music cache box
+	data.MusicCaches = c.musicCaches()
+	// ** begin covers box
+	data.CoverSizes = c.Covers.Sizes()
+	data.CoverProgress = c.Covers.Progress()
+	// ** begin loudness box
+	data.LoudnessProgress = c.Loudness.Progress()
+	data.UnanalysedTracks = c.Loudness.Unanalysed()
 	//
 	return &Response{
 		template: "home.tmpl",
@@ -270,16 +302,152 @@ func (c *Controller) ServeUpdateLastFMAPIKeyDo(r *http.Request) *Response {
 }
 
 func (c *Controller) ServeStartScanDo(r *http.Request) *Response {
-	defer func() {
-		go func() {
-			if err := c.Scanner.Start(); err != nil {
-				log.Printf("error while scanning: %v\n", err)
-			}
-		}()
-	}()
+	musicFolderID, _ := strconv.Atoi(r.FormValue("music_folder"))
+	target := ctrlbase.ScanTarget{
+		MusicFolderID: musicFolderID,
+		RelPath:       strings.TrimSpace(r.FormValue("path")),
+		Opts: scanner.ScanOptions{
+			Force: r.FormValue("full") == "on",
+		},
+	}
+	if err := c.StartScan(target); err != nil {
+		return &Response{
+			redirect: "/admin/home",
+			flashW:   []string{err.Error()},
+		}
+	}
+	return &Response{
+		redirect: "/admin/home",
+		flashN:   []string{"scan started"},
+	}
+}
+
+func (c *Controller) ServeCancelScanDo(r *http.Request) *Response {
+	if err := c.Scanner.Cancel(); err != nil {
+		return &Response{
+			redirect: "/admin/home",
+			flashW:   []string{err.Error()},
+		}
+	}
+	return &Response{
+		redirect: "/admin/home",
+		flashN:   []string{"scan cancelled"},
+	}
+}
+
+func (c *Controller) ServeDeleteMusicFolderDo(r *http.Request) *Response {
+	musicFolderID, err := strconv.Atoi(r.FormValue("music_folder"))
+	if err != nil {
+		return &Response{
+			redirect: "/admin/home",
+			flashW:   []string{"please provide a valid music folder id"},
+		}
+	}
+	if _, ok := c.MusicDirs[musicFolderID]; ok {
+		return &Response{
+			redirect: "/admin/home",
+			flashW:   []string{"can't delete a music folder which is configured"},
+		}
+	}
+	if err := c.DB.DeleteMusicFolder(musicFolderID); err != nil {
+		return &Response{
+			redirect: "/admin/home",
+			flashW:   []string{err.Error()},
+		}
+	}
+	return &Response{
+		redirect: "/admin/home",
+		flashN:   []string{"music folder deleted"},
+	}
+}
+
+// musicCache is a music folder which is being cached on disk
+type musicCache struct {
+	Name string
+	dir.CacheStats
+	cacheDir *dir.CacheDir
+}
+
+func (c *Controller) musicCaches() []*musicCache {
+	var musicFolders []*db.MusicFolder
+	c.DB.
+		Order("id").
+		Find(&musicFolders)
+	var caches []*musicCache
+	for _, musicFolder := range musicFolders {
+		cacheDir, ok := c.MusicDirs[musicFolder.ID].(*dir.CacheDir)
+		if !ok {
+			continue
+		}
+		caches = append(caches, &musicCache{
+			Name:       musicFolder.Name,
+			CacheStats: cacheDir.Stats(),
+			cacheDir:   cacheDir,
+		})
+	}
+	return caches
+}
+
+func (c *Controller) ServeClearMusicCacheDo(r *http.Request) *Response {
+	caches := c.musicCaches()
+	for _, cache := range caches {
+		cache.cacheDir.Clear()
+	}
+	return &Response{
+		redirect: "/admin/home",
+		flashN:   []string{fmt.Sprintf("cleared %d music caches", len(caches))},
+	}
+}
+
+func (c *Controller) ServeGenerateCoversDo(r *http.Request) *Response {
+	if err := c.Covers.StartGenerating(); err != nil {
+		return &Response{
+			redirect: "/admin/home",
+			flashW:   []string{err.Error()},
+		}
+	}
+	return &Response{
+		redirect: "/admin/home",
+		flashN:   []string{"generating covers"},
+	}
+}
+
+func (c *Controller) ServeCancelGenerateCoversDo(r *http.Request) *Response {
+	if err := c.Covers.Cancel(); err != nil {
+		return &Response{
+			redirect: "/admin/home",
+			flashW:   []string{err.Error()},
+		}
+	}
+	return &Response{
+		redirect: "/admin/home",
+		flashN:   []string{"cancelled generating covers"},
+	}
+}
+
+func (c *Controller) ServeAnalyseLoudnessDo(r *http.Request) *Response {
+	if err := c.Loudness.StartAnalysing(); err != nil {
+		return &Response{
+			redirect: "/admin/home",
+			flashW:   []string{err.Error()},
+		}
+	}
+	return &Response{
+		redirect: "/admin/home",
+		flashN:   []string{"analysing loudness"},
+	}
+}
+
+func (c *Controller) ServeCancelAnalyseLoudnessDo(r *http.Request) *Response {
+	if err := c.Loudness.Cancel(); err != nil {
+		return &Response{
+			redirect: "/admin/home",
+			flashW:   []string{err.Error()},
+		}
+	}
 	return &Response{
 		redirect: "/admin/home",
-		flashN:   []string{"scan started. refresh for results"},
+		flashN:   []string{"cancelled analysing loudness"},
 	}
 }
 
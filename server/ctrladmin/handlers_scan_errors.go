@@ -0,0 +1,88 @@
+package ctrladmin
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"senan.xyz/g/gonic/db"
+	"senan.xyz/g/gonic/scanner"
+)
+
+// scanErrorsLimit is the most errors shown on the page at once
+const scanErrorsLimit = 500
+
+// scanErrorScan is a scan which had errors
+type scanErrorScan struct {
+	ID      int64
+	Started time.Time
+	Count   int
+}
+
+// scanErrorFilter is what the errors on the scan errors page are
+// filtered by. zero values don't filter
+type scanErrorFilter struct {
+	ScanID        int64
+	Stage         string
+	MusicFolderID int
+	Path          string
+}
+
+func (c *Controller) ServeScanErrors(r *http.Request) *Response {
+	data := &templateData{}
+	rows, err := c.DB.
+		Model(db.ScanError{}).
+		Select("scan_id, count(*)").
+		Group("scan_id").
+		Order("scan_id DESC").
+		Rows()
+	if err != nil {
+		return &Response{code: 500, err: err.Error()}
+	}
+	defer rows.Close()
+	for rows.Next() {
+		scan := &scanErrorScan{}
+		if err := rows.Scan(&scan.ID, &scan.Count); err != nil {
+			return &Response{code: 500, err: err.Error()}
+		}
+		scan.Started = time.Unix(0, scan.ID)
+		data.ScanErrorScans = append(data.ScanErrorScans, scan)
+	}
+	c.DB.
+		Order("id").
+		Find(&data.MusicFolders)
+	// ** begin filters
+	query := r.URL.Query()
+	filter := scanErrorFilter{
+		Stage: query.Get("stage"),
+		Path:  query.Get("path"),
+	}
+	filter.ScanID, _ = strconv.ParseInt(query.Get("scan"), 10, 64)
+	if filter.ScanID == 0 && len(data.ScanErrorScans) > 0 {
+		// the last scan by default
+		filter.ScanID = data.ScanErrorScans[0].ID
+	}
+	filter.MusicFolderID, _ = strconv.Atoi(query.Get("folder"))
+	q := c.DB.
+		Preload("MusicFolder").
+		Where("scan_id=?", filter.ScanID)
+	if filter.Stage != "" {
+		q = q.Where("stage=?", filter.Stage)
+	}
+	if filter.MusicFolderID != 0 {
+		q = q.Where("music_folder_id=?", filter.MusicFolderID)
+	}
+	if filter.Path != "" {
+		q = q.Where("path LIKE ?", "%"+filter.Path+"%")
+	}
+	q.
+		Order("music_folder_id, path").
+		Limit(scanErrorsLimit).
+		Find(&data.ScanErrors)
+	data.ScanErrorFilter = filter
+	data.ScanErrorStages = []string{scanner.StageWalk, scanner.StageTags, scanner.StageCue, scanner.StageLyrics}
+	return &Response{
+		template: "scan_errors.tmpl",
+		data:     data,
+	}
+}
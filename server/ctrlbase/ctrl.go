@@ -9,6 +9,8 @@ import (
 	"senan.xyz/g/gonic/db"
 	"senan.xyz/g/gonic/dir"
 	"senan.xyz/g/gonic/scanner"
+	"senan.xyz/g/gonic/server/covers"
+	"senan.xyz/g/gonic/server/loudness"
 )
 
 type statusWriter struct {
@@ -28,6 +30,14 @@ func (w *statusWriter) Write(b []byte) (int, error) {
 	return w.ResponseWriter.Write(b)
 }
 
+// Flush lets handlers which stream, like ServeScanEvents, flush through
+// the logging middleware
+func (w *statusWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
 func statusToBlock(code int) string {
 	var bg int
 	switch {
@@ -47,9 +57,22 @@ func statusToBlock(code int) string {
 
 type Controller struct {
 	DB          *db.DB
-	MusicDir    dir.Dir
+	MusicDirs   map[int]dir.Dir // music folder id -> dir
 	Scanner     *scanner.Scanner
+	Covers      *covers.Covers
+	Loudness    *loudness.Analyser
 	ProxyPrefix string
+	// Done is closed when the server is shutting down, to end streams
+	Done <-chan struct{}
+}
+
+// MusicDir returns the dir of the music folder with the given id
+func (c *Controller) MusicDir(musicFolderID int) (dir.Dir, error) {
+	musicDir, ok := c.MusicDirs[musicFolderID]
+	if !ok {
+		return nil, fmt.Errorf("no music folder with id `%d`", musicFolderID)
+	}
+	return musicDir, nil
 }
 
 // Path returns a URL path with the proxy prefix included
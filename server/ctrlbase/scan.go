@@ -0,0 +1,55 @@
+package ctrlbase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+
+	"senan.xyz/g/gonic/scanner"
+)
+
+// ScanTarget is what a scan started by a request covers. it's every music
+// folder if MusicFolderID is zero, otherwise RelPath in that one. if
+// there's only one music folder, RelPath can be given without it
+type ScanTarget struct {
+	MusicFolderID int
+	RelPath       string
+	Opts          scanner.ScanOptions
+}
+
+// StartScan checks target, then scans it in the background
+func (c *Controller) StartScan(target ScanTarget) error {
+	if target.MusicFolderID == 0 && target.RelPath != "" {
+		if len(c.MusicDirs) != 1 {
+			return fmt.Errorf("a path needs a music folder")
+		}
+		for id := range c.MusicDirs {
+			target.MusicFolderID = id
+		}
+	}
+	if target.MusicFolderID == 0 {
+		go func() {
+			if err := c.Scanner.Start(context.Background(), target.Opts); err != nil {
+				log.Printf("error while scanning: %v\n", err)
+			}
+		}()
+		return nil
+	}
+	if _, err := c.MusicDir(target.MusicFolderID); err != nil {
+		return err
+	}
+	// keep the path inside the music folder
+	relPath := path.Clean("/" + target.RelPath)[1:]
+	if relPath == "" {
+		relPath = "."
+	}
+	go func() {
+		err := c.Scanner.StartPaths(context.Background(),
+			target.MusicFolderID, []string{relPath}, target.Opts)
+		if err != nil {
+			log.Printf("error while scanning: %v\n", err)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,92 @@
+package ctrlbase
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"senan.xyz/g/gonic/scanner"
+)
+
+// scanEventsInterval is how often the scan progress is checked for
+// changes to send
+const scanEventsInterval = 500 * time.Millisecond
+
+// scanEvent is the data of a "progress" event. durations are in seconds
+type scanEvent struct {
+	Scanning      bool          `json:"scanning"`
+	Phase         scanner.Phase `json:"phase,omitempty"`
+	Folders       int           `json:"folders"`
+	Tracks        int           `json:"tracks"`
+	TracksNew     int           `json:"tracksNew"`
+	TracksUpdated int           `json:"tracksUpdated"`
+	TracksErr     int           `json:"tracksErr"`
+	Expected      int           `json:"expected,omitempty"`
+	CurrentPath   string        `json:"currentPath,omitempty"`
+	Started       *time.Time    `json:"started,omitempty"`
+	Elapsed       int64         `json:"elapsed"`
+	ETA           int64         `json:"eta,omitempty"`
+}
+
+func newScanEvent(p scanner.Progress) scanEvent {
+	event := scanEvent{
+		Scanning:      p.Scanning,
+		Phase:         p.Phase,
+		Folders:       p.Folders,
+		Tracks:        p.Tracks,
+		TracksNew:     p.TracksNew,
+		TracksUpdated: p.TracksUpdated,
+		TracksErr:     p.TracksErr,
+		Expected:      p.Expected,
+		CurrentPath:   p.CurrentPath,
+		Elapsed:       int64(p.Elapsed.Seconds()),
+		ETA:           int64(p.ETA.Seconds()),
+	}
+	// there hasn't been a scan yet if it's zero
+	if !p.Started.IsZero() {
+		event.Started = &p.Started
+	}
+	return event
+}
+
+// ServeScanEvents streams the progress of scans as server-sent events.
+// a "progress" event is sent straight away, then whenever the progress
+// changes, for as long as the client is connected and the server is up. the
+// server's write timeout still applies, but EventSource clients reconnect by
+// themselves
+func (c *Controller) ServeScanEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming isn't supported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // for nginx
+	ticker := time.NewTicker(scanEventsInterval)
+	defer ticker.Stop()
+	var last []byte
+	for {
+		data, err := json.Marshal(newScanEvent(c.Scanner.Progress()))
+		if err != nil {
+			log.Printf("error encoding scan progress: %v\n", err)
+			return
+		}
+		if string(data) != string(last) {
+			if _, err := fmt.Fprintf(w, "event: progress\ndata: %s\n\n", data); err != nil {
+				return
+			}
+			flusher.Flush()
+			last = data
+		}
+		select {
+		case <-r.Context().Done():
+			return
+		case <-c.Done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
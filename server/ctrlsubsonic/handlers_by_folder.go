@@ -20,42 +20,32 @@ import (
 // under the root directory
 
 func (c *Controller) ServeGetMusicFolders(_ *http.Request) *spec.Response {
-	var dbFolders []*db.Album
+	var musicFolders []*db.MusicFolder
 	c.DB.
-		Select("*").
-		Where("parent_id is NULL").
-		Find(&dbFolders)
-
-	musicFolders := make([]*spec.MusicFolder, 0, len(dbFolders))
-	for _, dbFolder := range dbFolders {
-		if dbFolder.RightPath == "." {
-			continue
-		}
-		musicFolders = append(musicFolders, &spec.MusicFolder{ID: dbFolder.ID, Name: dbFolder.RightPath})
-	}
-
+		Order("id").
+		Find(&musicFolders)
 	sub := spec.NewResponse()
-	sub.MusicFolders = &spec.MusicFolders {
-		List: musicFolders,
+	sub.MusicFolders = &spec.MusicFolders{
+		List: make([]*spec.MusicFolder, len(musicFolders)),
+	}
+	for i, musicFolder := range musicFolders {
+		sub.MusicFolders.List[i] = spec.NewMusicFolder(musicFolder)
 	}
 	return sub
 }
 
 func (c *Controller) ServeGetIndexes(r *http.Request) *spec.Response {
 	parameters := r.Context().Value(CtxParams).(params.Params)
-	musicFolderId, err := parameters.GetInt("musicFolderId")
-	if err != nil {
-		return spec.NewError(10, "please provide an `musicFolderId` parameter")
-	}
-
+	// the "artists" are the folders directly under the root of each
+	// music folder
 	var folders []*db.Album
-	c.DB.
+	q := c.DB.
 		Select("albums.*, count(sub.id) child_count").
 		Joins("LEFT JOIN albums sub ON albums.id=sub.parent_id").
-		Where("albums.parent_id=?", musicFolderId).
-		Group("albums.id").
+		Joins("JOIN albums roots ON albums.parent_id=roots.id AND roots.parent_id IS NULL").
+		Group("albums.id")
+	withMusicFolder(q, parameters).
 		Find(&folders)
-
 	// [a-z#] -> 27
 	indexMap := make(map[string]*spec.Index, 27)
 	resp := make([]*spec.Index, 0, 27)
@@ -92,7 +82,9 @@ func (c *Controller) ServeGetMusicDirectory(r *http.Request) *spec.Response {
 	}
 	childrenObj := []*spec.TrackChild{}
 	folder := &db.Album{}
-	c.DB.First(folder, id)
+	c.DB.
+		Preload("Parent").
+		First(folder, id)
 	// ** begin start looking for child childFolders in the current dir
 	var childFolders []*db.Album
 	c.DB.
@@ -140,6 +132,9 @@ func (c *Controller) ServeGetAlbumList(r *http.Request) *spec.Response {
 		q = q.Order("parent_albums.right_path")
 	case "alphabeticalByName":
 		q = q.Order("right_path")
+	case "compilation":
+		q = q.Where("albums.tag_compilation=?", true)
+		q = q.Order("right_path")
 	case "frequent":
 		user := r.Context().Value(CtxUser).(*db.User)
 		q = q.Joins(`
@@ -164,7 +159,7 @@ func (c *Controller) ServeGetAlbumList(r *http.Request) *spec.Response {
 	var folders []*db.Album
 	// TODO: think about removing this extra join to count number
 	// of children. it might make sense to store that in the db
-	q.
+	withMusicFolder(q, parameters).
 		Select("albums.*, count(tracks.id) child_count").
 		Joins("LEFT JOIN tracks ON tracks.album_id=albums.id").
 		Group("albums.id").
@@ -193,11 +188,11 @@ func (c *Controller) ServeSearchTwo(r *http.Request) *spec.Response {
 	results := &spec.SearchResultTwo{}
 	// ** begin search "artists"
 	var artists []*db.Album
-	c.DB.
+	withMusicFolder(c.DB.DB, parameters).
+		Joins("JOIN albums roots ON albums.parent_id=roots.id AND roots.parent_id IS NULL").
 		Where(`
-			parent_id=1
-			AND (	right_path LIKE ? OR
-					right_path_u_dec LIKE ?	)`,
+			albums.right_path LIKE ? OR
+			albums.right_path_u_dec LIKE ?`,
 			query, query).
 		Offset(parameters.GetIntOr("artistOffset", 0)).
 		Limit(parameters.GetIntOr("artistCount", 20)).
@@ -208,7 +203,7 @@ func (c *Controller) ServeSearchTwo(r *http.Request) *spec.Response {
 	}
 	// ** begin search "albums"
 	var albums []*db.Album
-	c.DB.
+	withMusicFolder(c.DB.DB, parameters).
 		Where(`
 			tag_artist_id IS NOT NULL
 			AND (	right_path LIKE ? OR
@@ -222,7 +217,8 @@ func (c *Controller) ServeSearchTwo(r *http.Request) *spec.Response {
 	}
 	// ** begin search tracks
 	var tracks []*db.Track
-	c.DB.
+	withMusicFolder(c.DB.DB, parameters).
+		Joins("JOIN albums ON tracks.album_id=albums.id").
 		Preload("Album").
 		Where("filename LIKE ? OR filename_u_dec LIKE ?",
 			query, query).
@@ -15,12 +15,23 @@ import (
 )
 
 func (c *Controller) ServeGetArtists(r *http.Request) *spec.Response {
+	params := r.Context().Value(CtxParams).(params.Params)
 	var artists []*db.Artist
-	c.DB.
-		Select("*, count(sub.id) album_count").
-		Joins("LEFT JOIN albums sub ON artists.id=sub.tag_artist_id").
-		Group("artists.id").
-		Find(&artists)
+	q := c.DB.
+		Select("artists.*, count(sub.id) album_count").
+		Group("artists.id")
+	if musicFolderID, err := params.GetInt("musicFolderId"); err == nil {
+		q = q.
+			Joins("LEFT JOIN album_artists ON artists.id=album_artists.artist_id").
+			Joins("LEFT JOIN albums sub ON album_artists.album_id=sub.id AND sub.id=sub.tag_album_id AND sub.music_folder_id=?",
+				musicFolderID).
+			Having("count(sub.id) > 0")
+	} else {
+		q = q.
+			Joins("LEFT JOIN album_artists ON artists.id=album_artists.artist_id").
+			Joins("LEFT JOIN albums sub ON album_artists.album_id=sub.id AND sub.id=sub.tag_album_id")
+	}
+	q.Find(&artists)
 	// [a-z#] -> 27
 	indexMap := make(map[string]*spec.Index, 27)
 	resp := make([]*spec.Index, 0, 27)
@@ -56,8 +67,15 @@ func (c *Controller) ServeGetArtist(r *http.Request) *spec.Response {
 	}
 	artist := &db.Artist{}
 	c.DB.
-		Preload("Albums").
 		First(artist, id)
+	// the albums it's one of the artists of, not only the ones it's the
+	// first artist of
+	c.DB.
+		Select("albums.*").
+		Joins("JOIN album_artists ON albums.id=album_artists.album_id").
+		Where("album_artists.artist_id=?", artist.ID).
+		Where("albums.id=albums.tag_album_id").
+		Find(&artist.Albums)
 	sub := spec.NewResponse()
 	sub.Artist = spec.NewArtistByTags(artist)
 	sub.Artist.Albums = make([]*spec.Album, len(artist.Albums))
@@ -77,19 +95,45 @@ func (c *Controller) ServeGetAlbum(r *http.Request) *spec.Response {
 	album := &db.Album{}
 	err = c.DB.
 		Preload("TagArtist").
-		Preload("Tracks", func(db *gorm.DB) *gorm.DB {
-			return db.Order("tracks.tag_disc_number, tracks.tag_track_number")
-		}).
 		First(album, id).
 		Error
 	if gorm.IsRecordNotFoundError(err) {
 		return spec.NewError(10, "couldn't find an album with that id")
 	}
+	if album.TagAlbumID != 0 && album.TagAlbumID != album.ID {
+		// the id of another disc's folder, from before they were grouped
+		tagAlbumID := album.TagAlbumID
+		album = &db.Album{}
+		c.DB.
+			Preload("TagArtist").
+			First(album, tagAlbumID)
+	}
+	// the tracks of all of the album's folders
+	var tracks []*db.Track
+	c.DB.
+		Select("tracks.*").
+		Joins("JOIN albums ON tracks.album_id=albums.id").
+		Where("albums.tag_album_id=? OR albums.id=?", album.ID, album.ID).
+		Preload("Album.TagArtist").
+		Order("tracks.tag_disc_number, albums.left_path, albums.right_path, tracks.tag_track_number, tracks.cue_track").
+		Find(&tracks)
+	// discs in folders of their own often aren't tagged with their numbers,
+	// so they're numbered by folder
+	folderDiscs := map[int]int{}
+	for _, track := range tracks {
+		if _, ok := folderDiscs[track.AlbumID]; !ok {
+			folderDiscs[track.AlbumID] = len(folderDiscs) + 1
+		}
+	}
 	sub := spec.NewResponse()
 	sub.Album = spec.NewAlbumByTags(album, album.TagArtist)
-	sub.Album.Tracks = make([]*spec.TrackChild, len(album.Tracks))
-	for i, track := range album.Tracks {
-		sub.Album.Tracks[i] = spec.NewTrackByTags(track, album)
+	sub.Album.TrackCount = len(tracks)
+	sub.Album.Tracks = make([]*spec.TrackChild, len(tracks))
+	for i, track := range tracks {
+		sub.Album.Tracks[i] = spec.NewTrackByTags(track, track.Album)
+		if sub.Album.Tracks[i].DiscNumber == 0 && len(folderDiscs) > 1 {
+			sub.Album.Tracks[i].DiscNumber = folderDiscs[track.AlbumID]
+		}
 	}
 	return sub
 }
@@ -105,44 +149,58 @@ func (c *Controller) ServeGetAlbumListTwo(r *http.Request) *spec.Response {
 	q := c.DB.DB
 	switch listType {
 	case "alphabeticalByArtist":
+		// the sort tags are used if there are, eg. "Beatles, The"
 		q = q.Joins("JOIN artists ON albums.tag_artist_id=artists.id")
-		q = q.Order("artists.name")
+		q = q.Order("COALESCE(NULLIF(albums.tag_artist_sort, ''), artists.name)")
+		q = q.Order("COALESCE(NULLIF(albums.tag_title_sort, ''), albums.tag_title)")
 	case "alphabeticalByName":
-		q = q.Order("tag_title")
+		q = q.Order("COALESCE(NULLIF(albums.tag_title_sort, ''), albums.tag_title)")
 	case "byYear":
+		// reissues are listed with when they first came out
+		year := "COALESCE(NULLIF(albums.tag_original_year, 0), albums.tag_year)"
 		q = q.Where(
-			"tag_year BETWEEN ? AND ?",
+			year+" BETWEEN ? AND ?",
 			params.GetIntOr("fromYear", 1800),
 			params.GetIntOr("toYear", 2200))
-		q = q.Order("tag_year")
+		q = q.Order(year)
+	case "compilation":
+		q = q.Where("albums.tag_compilation=?", true)
+		q = q.Order("COALESCE(NULLIF(albums.tag_title_sort, ''), albums.tag_title)")
 	case "byGenre":
-		q = q.Joins("JOIN genres ON albums.tag_genre_id=genres.id AND genres.name=?",
+		q = q.Joins("JOIN album_genres ON albums.id=album_genres.album_id")
+		q = q.Joins("JOIN genres ON album_genres.genre_id=genres.id AND genres.name=?",
 			params.GetOr("genre", "Unknown Genre"))
 	case "frequent":
+		// plays are of folders, which can be discs of the album
 		user := r.Context().Value(CtxUser).(*db.User)
-		q = q.Joins("JOIN plays ON albums.id=plays.album_id AND plays.user_id=?",
+		q = q.Joins("JOIN albums discs ON discs.tag_album_id=albums.id")
+		q = q.Joins("JOIN plays ON discs.id=plays.album_id AND plays.user_id=?",
 			user.ID)
-		q = q.Order("plays.count DESC")
+		q = q.Order("SUM(plays.count) DESC")
 	case "newest":
 		q = q.Order("modified_at DESC")
 	case "random":
 		q = q.Order(gorm.Expr("random()"))
 	case "recent":
 		user := r.Context().Value(CtxUser).(*db.User)
-		q = q.Joins("JOIN plays ON albums.id=plays.album_id AND plays.user_id=?",
+		q = q.Joins("JOIN albums discs ON discs.tag_album_id=albums.id")
+		q = q.Joins("JOIN plays ON discs.id=plays.album_id AND plays.user_id=?",
 			user.ID)
-		q = q.Order("plays.time DESC")
+		q = q.Order("MAX(plays.time) DESC")
 	default:
 		return spec.NewError(10, "unknown value `%s` for parameter 'type'", listType)
 	}
 	var albums []*db.Album
-	// TODO: think about removing this extra join to count number
+	// TODO: think about removing this extra query to count number
 	// of children. it might make sense to store that in the db
-	q.
-		Select("albums.*, count(tracks.id) child_count").
-		Joins("LEFT JOIN tracks ON tracks.album_id=albums.id").
+	withMusicFolder(q, params).
+		Select(`albums.*,
+			(SELECT count(tracks.id) FROM tracks
+			 JOIN albums discs ON tracks.album_id=discs.id
+			 WHERE discs.tag_album_id=albums.id) child_count`).
 		Group("albums.id").
 		Where("albums.tag_artist_id IS NOT NULL").
+		Where("albums.id=albums.tag_album_id").
 		Offset(params.GetIntOr("offset", 0)).
 		Limit(params.GetIntOr("size", 10)).
 		Preload("TagArtist").
@@ -168,9 +226,18 @@ func (c *Controller) ServeSearchThree(r *http.Request) *spec.Response {
 	results := &spec.SearchResultThree{}
 	// ** begin search "artists"
 	var artists []*db.Artist
-	c.DB.
+	q := c.DB.
 		Where("name LIKE ? OR name_u_dec LIKE ?",
-			query, query).
+			query, query)
+	if musicFolderID, err := params.GetInt("musicFolderId"); err == nil {
+		q = q.Where(`
+			EXISTS (SELECT 1 FROM album_artists
+				JOIN albums ON album_artists.album_id=albums.id
+				WHERE album_artists.artist_id=artists.id
+				AND albums.music_folder_id=?)`,
+			musicFolderID)
+	}
+	q.
 		Offset(params.GetIntOr("artistOffset", 0)).
 		Limit(params.GetIntOr("artistCount", 20)).
 		Find(&artists)
@@ -180,10 +247,11 @@ func (c *Controller) ServeSearchThree(r *http.Request) *spec.Response {
 	}
 	// ** begin search "albums"
 	var albums []*db.Album
-	c.DB.
+	withMusicFolder(c.DB.DB, params).
 		Preload("TagArtist").
 		Where("tag_title LIKE ? OR tag_title_u_dec LIKE ?",
 			query, query).
+		Where("albums.id=albums.tag_album_id").
 		Offset(params.GetIntOr("albumOffset", 0)).
 		Limit(params.GetIntOr("albumCount", 20)).
 		Find(&albums)
@@ -193,9 +261,10 @@ func (c *Controller) ServeSearchThree(r *http.Request) *spec.Response {
 	}
 	// ** begin search tracks
 	var tracks []*db.Track
-	c.DB.
-		Preload("Album").
-		Where("tag_title LIKE ? OR tag_title_u_dec LIKE ?",
+	withMusicFolder(c.DB.DB, params).
+		Joins("JOIN albums ON tracks.album_id=albums.id").
+		Preload("Album.TagArtist").
+		Where("tracks.tag_title LIKE ? OR tracks.tag_title_u_dec LIKE ?",
 			query, query).
 		Offset(params.GetIntOr("songOffset", 0)).
 		Limit(params.GetIntOr("songCount", 20)).
@@ -255,9 +324,9 @@ func (c *Controller) ServeGetArtistInfoTwo(r *http.Request) *spec.Response {
 		}
 		artist = &db.Artist{}
 		err = c.DB.
-			Select("artists.*, count(albums.id) album_count").
+			Select("artists.*, count(album_artists.album_id) album_count").
 			Where("name=?", similarInfo.Name).
-			Joins("LEFT JOIN albums ON artists.id=albums.tag_artist_id").
+			Joins("LEFT JOIN album_artists ON artists.id=album_artists.artist_id").
 			Group("artists.id").
 			Find(artist).
 			Error
@@ -280,8 +349,10 @@ func (c *Controller) ServeGetGenres(r *http.Request) *spec.Response {
 	var genres []*db.Genre
 	c.DB.
 		Select(`*,
-			(SELECT count(id) FROM albums WHERE tag_genre_id=genres.id) album_count,
-			(SELECT count(id) FROM tracks WHERE tag_genre_id=genres.id) track_count`).
+			(SELECT count(album_id) FROM album_genres
+			 JOIN albums ON albums.id=album_genres.album_id AND albums.id=albums.tag_album_id
+			 WHERE genre_id=genres.id) album_count,
+			(SELECT count(track_id) FROM track_genres WHERE genre_id=genres.id) track_count`).
 		Group("genres.id").
 		Find(&genres)
 	sub := spec.NewResponse()
@@ -300,13 +371,12 @@ func (c *Controller) ServeGetSongsByGenre(r *http.Request) *spec.Response {
 	if genre == "" {
 		return spec.NewError(10, "please provide an `genre` parameter")
 	}
-	// TODO: add musicFolderId parameter
-	// (since 1.12.0) only return albums in the music folder with the given id
 	var tracks []*db.Track
-	c.DB.
+	withMusicFolder(c.DB.DB, params).
 		Joins("JOIN albums ON tracks.album_id=albums.id").
-		Joins("JOIN genres ON tracks.tag_genre_id=genres.id AND genres.name=?", genre).
-		Preload("Album").
+		Joins("JOIN track_genres ON tracks.id=track_genres.track_id").
+		Joins("JOIN genres ON track_genres.genre_id=genres.id AND genres.name=?", genre).
+		Preload("Album.TagArtist").
 		Offset(params.GetIntOr("offset", 0)).
 		Limit(params.GetIntOr("count", 10)).
 		Find(&tracks)
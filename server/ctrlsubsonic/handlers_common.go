@@ -1,7 +1,6 @@
 package ctrlsubsonic
 
 import (
-	"log"
 	"net/http"
 	"sort"
 	"strconv"
@@ -12,6 +11,8 @@ import (
 
 	"senan.xyz/g/gonic/db"
 	"senan.xyz/g/gonic/scanner"
+	"senan.xyz/g/gonic/scanner/lyrics"
+	"senan.xyz/g/gonic/server/ctrlbase"
 	"senan.xyz/g/gonic/server/ctrlsubsonic/params"
 	"senan.xyz/g/gonic/server/ctrlsubsonic/spec"
 	"senan.xyz/g/gonic/server/lastfm"
@@ -25,6 +26,16 @@ func lowerUDecOrHash(in string) string {
 	return string(lower)
 }
 
+// withMusicFolder limits a query over albums to the music folder given
+// by the optional `musicFolderId` parameter
+func withMusicFolder(q *gorm.DB, params params.Params) *gorm.DB {
+	musicFolderID, err := params.GetInt("musicFolderId")
+	if err != nil {
+		return q
+	}
+	return q.Where("albums.music_folder_id=?", musicFolderID)
+}
+
 func (c *Controller) ServeGetLicence(r *http.Request) *spec.Response {
 	sub := spec.NewResponse()
 	sub.Licence = &spec.Licence{
@@ -74,23 +85,74 @@ func (c *Controller) ServeScrobble(r *http.Request) *spec.Response {
 	return spec.NewResponse()
 }
 
+// ServeStartScan takes some params which aren't in the spec. `full=true`
+// reads everything again, even what hasn't been modified. `id` scans just
+// the folder with that id, and `musicFolderId` just that music folder, or
+// `path` in it. only admins can give those
 func (c *Controller) ServeStartScan(r *http.Request) *spec.Response {
-	go func() {
-		if err := c.Scanner.Start(); err != nil {
-			log.Printf("error while scanning: %v\n", err)
+	params := r.Context().Value(CtxParams).(params.Params)
+	user := r.Context().Value(CtxUser).(*db.User)
+	for _, key := range []string{"full", "id", "musicFolderId", "path"} {
+		if params.Get(key) != "" && !user.IsAdmin {
+			return spec.NewError(50, "user not admin")
+		}
+	}
+	target := ctrlbase.ScanTarget{
+		Opts: scanner.ScanOptions{
+			Force: params.GetOr("full", "false") == "true",
+		},
+	}
+	if id, err := params.GetInt("id"); err == nil {
+		folder := &db.Album{}
+		err := c.DB.
+			Select("music_folder_id, left_path, right_path").
+			First(folder, id).
+			Error
+		if gorm.IsRecordNotFoundError(err) {
+			return spec.NewError(70, "couldn't find a folder with that id")
 		}
-	}()
+		target.MusicFolderID = folder.MusicFolderID
+		target.RelPath = folder.RelPath()
+	} else if musicFolderID, err := params.GetInt("musicFolderId"); err == nil {
+		target.MusicFolderID = musicFolderID
+		target.RelPath = params.Get("path")
+	}
+	if err := c.StartScan(target); err != nil {
+		return spec.NewError(10, "error starting scan: %v", err)
+	}
+	return c.ServeGetScanStatus(r)
+}
+
+// ServeCancelScan isn't in the spec. it stops the current scan, and
+// responds like getScanStatus. only admins can cancel scans
+func (c *Controller) ServeCancelScan(r *http.Request) *spec.Response {
+	user := r.Context().Value(CtxUser).(*db.User)
+	if !user.IsAdmin {
+		return spec.NewError(50, "user not admin")
+	}
+	if err := c.Scanner.Cancel(); err != nil {
+		return spec.NewError(0, "error cancelling scan: %v", err)
+	}
 	return c.ServeGetScanStatus(r)
 }
 
 func (c *Controller) ServeGetScanStatus(r *http.Request) *spec.Response {
+	sub := spec.NewResponse()
+	// while scanning, the count is how many tracks the scan has got
+	// through. otherwise it's how many there are
+	if progress := c.Scanner.Progress(); progress.Scanning {
+		sub.ScanStatus = &spec.ScanStatus{
+			Scanning: true,
+			Count:    progress.Tracks,
+		}
+		return sub
+	}
 	var trackCount int
 	c.DB.
 		Model(db.Track{}).
 		Count(&trackCount)
-	sub := spec.NewResponse()
 	sub.ScanStatus = &spec.ScanStatus{
-		Scanning: scanner.IsScanning(),
+		Scanning: false,
 		Count:    trackCount,
 	}
 	return sub
@@ -98,12 +160,17 @@ func (c *Controller) ServeGetScanStatus(r *http.Request) *spec.Response {
 
 func (c *Controller) ServeGetUser(r *http.Request) *spec.Response {
 	user := r.Context().Value(CtxUser).(*db.User)
+	var musicFolderIDs []int
+	c.DB.
+		Model(db.MusicFolder{}).
+		Order("id").
+		Pluck("id", &musicFolderIDs)
 	sub := spec.NewResponse()
 	sub.User = &spec.User{
 		Username:          user.Name,
 		AdminRole:         user.IsAdmin,
 		ScrobblingEnabled: user.LastFMSession != "",
-		Folder:            []int{1},
+		Folder:            musicFolderIDs,
 	}
 	return sub
 }
@@ -289,12 +356,15 @@ func (c *Controller) ServeGetRandomSongs(r *http.Request) *spec.Response {
 		q = q.Where("albums.tag_year <= ?", year)
 	}
 	if genre := params.Get("genre"); genre != "" {
-		q = q.Joins(
-			"JOIN genres ON tracks.tag_genre_id=genres.id AND genres.name=?",
-			genre,
-		)
+		q = q.
+			Joins("JOIN track_genres ON tracks.id=track_genres.track_id").
+			Joins(
+				"JOIN genres ON track_genres.genre_id=genres.id AND genres.name=?",
+				genre,
+			)
 	}
-	q.Find(&tracks)
+	withMusicFolder(q, params).
+		Find(&tracks)
 	sub := spec.NewResponse()
 	sub.RandomTracks = &spec.RandomTracks{}
 	sub.RandomTracks.List = make([]*spec.TrackChild, len(tracks))
@@ -303,3 +373,71 @@ func (c *Controller) ServeGetRandomSongs(r *http.Request) *spec.Response {
 	}
 	return sub
 }
+
+// findLyrics returns the lyrics of a track, those from .lrc files first
+func (c *Controller) findLyrics(trackID int) []*lyrics.Lyrics {
+	var rows []*db.Lyrics
+	c.DB.
+		Where("track_id=?", trackID).
+		Order("source").
+		Find(&rows)
+	ret := make([]*lyrics.Lyrics, 0, len(rows))
+	for _, row := range rows {
+		ret = append(ret, lyrics.Parse(row.Text))
+	}
+	return ret
+}
+
+func (c *Controller) ServeGetLyrics(r *http.Request) *spec.Response {
+	params := r.Context().Value(CtxParams).(params.Params)
+	title := params.Get("title")
+	if title == "" {
+		return spec.NewError(10, "please provide a `title` parameter")
+	}
+	sub := spec.NewResponse()
+	sub.Lyrics = &spec.Lyrics{}
+	track := &db.Track{}
+	q := c.DB.
+		Where("lower(tracks.tag_title)=lower(?)", title).
+		Where("EXISTS (SELECT 1 FROM lyrics WHERE lyrics.track_id=tracks.id)")
+	if artist := params.Get("artist"); artist != "" {
+		q = q.
+			Joins("JOIN artists ON artists.id=tracks.artist_id").
+			Where("lower(tracks.tag_track_artist)=lower(?) OR lower(artists.name)=lower(?)", artist, artist)
+	}
+	err := q.
+		Select("tracks.*").
+		First(track).
+		Error
+	if gorm.IsRecordNotFoundError(err) {
+		return sub
+	}
+	if found := c.findLyrics(track.ID); len(found) > 0 {
+		sub.Lyrics = spec.NewLyrics(track, found[0])
+	}
+	return sub
+}
+
+func (c *Controller) ServeGetLyricsBySongID(r *http.Request) *spec.Response {
+	params := r.Context().Value(CtxParams).(params.Params)
+	id, err := params.GetInt("id")
+	if err != nil {
+		return spec.NewError(10, "please provide an `id` parameter")
+	}
+	track := &db.Track{}
+	err = c.DB.
+		First(track, id).
+		Error
+	if gorm.IsRecordNotFoundError(err) {
+		return spec.NewError(70, "media with id `%d` was not found", id)
+	}
+	sub := spec.NewResponse()
+	sub.LyricsList = &spec.LyricsList{
+		StructuredLyrics: []*spec.StructuredLyrics{},
+	}
+	for _, found := range c.findLyrics(track.ID) {
+		sub.LyricsList.StructuredLyrics = append(sub.LyricsList.StructuredLyrics,
+			spec.NewStructuredLyrics(track, found))
+	}
+	return sub
+}
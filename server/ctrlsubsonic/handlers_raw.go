@@ -5,12 +5,16 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jinzhu/gorm"
 
 	"senan.xyz/g/gonic/db"
 	"senan.xyz/g/gonic/dir"
+	"senan.xyz/g/gonic/mime"
+	"senan.xyz/g/gonic/server/covers"
 	"senan.xyz/g/gonic/server/ctrlsubsonic/params"
 	"senan.xyz/g/gonic/server/ctrlsubsonic/spec"
 	"senan.xyz/g/gonic/server/encode"
@@ -24,34 +28,32 @@ import (
 
 func (c *Controller) ServeGetCoverArt(w http.ResponseWriter, r *http.Request) *spec.Response {
 	params := r.Context().Value(CtxParams).(params.Params)
-	id, err := params.GetInt("id")
-	if err != nil {
+	id := params.Get("id")
+	if id == "" {
 		return spec.NewError(10, "please provide an `id` parameter")
 	}
-	folder := &db.Album{}
-	err = c.DB.
-		Select("id, left_path, right_path, cover").
-		First(folder, id).
-		Error
-	if gorm.IsRecordNotFoundError(err) {
-		return spec.NewError(10, "could not find a cover with that id")
+	findCover := c.Covers.AlbumCover
+	if strings.HasPrefix(id, spec.CoverTrackPrefix) {
+		findCover = c.Covers.TrackCover
+		id = strings.TrimPrefix(id, spec.CoverTrackPrefix)
 	}
-	if folder.Cover == "" {
-		return spec.NewError(10, "no cover found for that folder")
+	coverID, err := strconv.Atoi(id)
+	if err != nil {
+		return spec.NewError(10, "please provide a valid `id` parameter")
+	}
+	cover, err := findCover(coverID)
+	if err == covers.ErrNotFound {
+		return spec.NewError(10, "could not find a cover with that id")
 	}
-
-	relPath := path.Join(
-		folder.LeftPath,
-		folder.RightPath,
-		folder.Cover,
-	)
-	lastModified, readerSeeker, err := c.MusicDir.GetFile(relPath)
 	if err != nil {
-		return spec.NewError(11, "failed to get file: %v", err)
+		return spec.NewError(0, "finding cover: %v", err)
 	}
-	http.ServeContent(w, r, folder.Cover, lastModified, readerSeeker)
-	if err := readerSeeker.Close(); err != nil {
-		return spec.NewError(21, "failed to close input file: %v", err)
+	size := params.GetIntOr("size", 0)
+	if err := c.Covers.Serve(w, r, cover, size); err != nil {
+		if err == covers.ErrNotFound {
+			return spec.NewError(10, "could not find a cover with that id")
+		}
+		return spec.NewError(0, "serving cover: %v", err)
 	}
 	return nil
 }
@@ -73,6 +75,9 @@ type serveTrackOptions struct {
 }
 
 func serveTrackRaw(w http.ResponseWriter, r *http.Request, opts serveTrackOptions) *spec.Response{
+	if opts.track.CueTrack > 0 {
+		return serveTrackCut(w, r, opts)
+	}
 	log.Printf("serving raw %q\n", opts.track.Filename)
 	w.Header().Set("Content-Type", opts.track.MIME())
 	lastModified, readerSeeker, err := opts.musicDir.GetFile(opts.track.RelPath())
@@ -86,11 +91,45 @@ func serveTrackRaw(w http.ResponseWriter, r *http.Request, opts serveTrackOption
 	return nil
 }
 
+// serveTrackCut serves a track from a cue sheet, which is a segment of
+// its file, in the file's format if it can be
+func serveTrackCut(w http.ResponseWriter, r *http.Request, opts serveTrackOptions) *spec.Response {
+	seg := trackSegment(opts.track)
+	ext := opts.track.Ext()
+	cacheFile := path.Join(opts.cachePath, encode.CutCacheKey(opts.track.Album.MusicFolderID, opts.track.RelPath(), seg, ext))
+	if fileExists(cacheFile) {
+		log.Printf("serving cut `%s` [%s]: cache hit!\n", opts.track.Filename, seg)
+		http.ServeFile(w, r, cacheFile)
+		return nil
+	}
+	log.Printf("serving cut `%s` [%s]: cache miss!\n", opts.track.Filename, seg)
+	_, originalFile, err := opts.musicDir.GetFile(opts.track.RelPath())
+	if err != nil {
+		return spec.NewError(11, "failed to read original file for cut: %v", err)
+	}
+	defer originalFile.Close()
+	w.Header().Set("Content-Type", mime.Types[encode.CutFormat(ext)])
+	if err := encode.Cut(r.Context(), originalFile, w, cacheFile, ext, seg); err != nil {
+		return spec.NewError(12, "error cutting %v: %v", opts.track.RelPath(), err)
+	}
+	return nil
+}
+
+// trackSegment is the part of its file a track is, or nil for tracks
+// which are whole files
+func trackSegment(track *db.Track) *encode.Segment {
+	if track.CueTrack == 0 {
+		return nil
+	}
+	return encode.NewSegment(track.CueStart, track.CueEnd)
+}
+
 func serveTrackEncode(w http.ResponseWriter, r *http.Request, opts serveTrackOptions) *spec.Response {
 	profile := encode.Profiles[opts.pref.Profile]
 	bitrate := encode.GetBitrate(opts.maxBitrate, profile)
 
-	cacheKey := encode.CacheKey(opts.track.RelPath(), opts.pref.Profile, bitrate)
+	seg := trackSegment(opts.track)
+	cacheKey := encode.CacheKey(opts.track.Album.MusicFolderID, opts.track.RelPath(), seg, opts.pref.Profile, bitrate)
 	cacheFile := path.Join(opts.cachePath, cacheKey)
 	if fileExists(cacheFile) {
 		log.Printf("serving transcode `%s`: cache [%s/%s] hit!\n", opts.track.Filename, profile.Format, bitrate)
@@ -103,7 +142,7 @@ func serveTrackEncode(w http.ResponseWriter, r *http.Request, opts serveTrackOpt
 	if err != nil {
 		return spec.NewError(11, "failed to read original file for encode: %v", err)
 	}
-	if err := encode.Encode(originalFile, w, cacheFile, profile, bitrate); err != nil {
+	if err := encode.Encode(r.Context(), originalFile, w, cacheFile, profile, bitrate, seg); err != nil {
 		if err2 := originalFile.Close(); err2 != nil {
 			return spec.NewError(121, "error encoding %v: %v\nEncountered error while closing input data: %v", opts.track.RelPath(), err, err2)
 		} else {
@@ -144,10 +183,15 @@ func (c *Controller) ServeStream(w http.ResponseWriter, r *http.Request) *spec.R
 		play.Count++           // for getAlbumList?type=frequent
 		c.DB.Save(&play)
 	}()
+	musicDir, err := c.MusicDir(track.Album.MusicFolderID)
+	if err != nil {
+		return spec.NewError(0, "finding music folder: %v", err)
+	}
 	client := params.Get("c")
 	servOpts := serveTrackOptions{
 		track:     track,
-		musicDir:  c.MusicDir,
+		musicDir:  musicDir,
+		cachePath: c.cachePath,
 	}
 	pref := &db.TranscodePreference{}
 	err = c.DB.
@@ -163,7 +207,6 @@ func (c *Controller) ServeStream(w http.ResponseWriter, r *http.Request) *spec.R
 	} else {
 		servOpts.pref = pref
 		servOpts.maxBitrate = params.GetIntOr("maxBitRate", 0)
-		servOpts.cachePath = c.cachePath
 		return serveTrackEncode(w, r, servOpts)
 	}
 }
@@ -182,8 +225,13 @@ func (c *Controller) ServeDownload(w http.ResponseWriter, r *http.Request) *spec
 	if gorm.IsRecordNotFoundError(err) {
 		return spec.NewError(70, "media with id `%d` was not found", id)
 	}
+	musicDir, err := c.MusicDir(track.Album.MusicFolderID)
+	if err != nil {
+		return spec.NewError(0, "finding music folder: %v", err)
+	}
 	return serveTrackRaw(w, r, serveTrackOptions{
 		track:     track,
-		musicDir:  c.MusicDir,
+		musicDir:  musicDir,
+		cachePath: c.cachePath,
 	})
 }
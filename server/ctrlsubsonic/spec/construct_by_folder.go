@@ -17,9 +17,7 @@ func NewAlbumByFolder(f *db.Album) *Album {
 	if f.Parent != nil {
 		a.Artist = f.Parent.RightPath
 	}
-	if f.Cover != "" {
-		a.CoverID = f.ID
-	}
+	a.CoverID = albumCoverID(f)
 	return a
 }
 
@@ -31,9 +29,7 @@ func NewTCAlbumByFolder(f *db.Album) *TrackChild {
 		ParentID:  f.ParentID,
 		CreatedAt: f.UpdatedAt,
 	}
-	if f.Cover != "" {
-		trCh.CoverID = f.ID
-	}
+	trCh.CoverID = albumCoverID(f)
 	return trCh
 }
 
@@ -59,9 +55,9 @@ func NewTCTrackByFolder(t *db.Track, parent *db.Album) *TrackChild {
 		Type:      "music",
 		CreatedAt: t.CreatedAt,
 	}
-	if parent.Cover != "" {
-		trCh.CoverID = parent.ID
-	}
+	trCh.CoverID = trackCoverID(t, parent)
+	trCh.ReplayGain = newReplayGain(t, parent)
+	setTrackTags(trCh, t)
 	if t.Album != nil {
 		trCh.Album = t.Album.RightPath
 	}
@@ -82,9 +78,16 @@ func NewDirectoryByFolder(f *db.Album, children []*TrackChild) *Directory {
 		Name:     f.RightPath,
 		Children: children,
 	}
-	// don't show the root dir as a parent
-	if f.ParentID != 1 {
+	// don't show the root dir of a music folder as a parent
+	if f.Parent != nil && f.Parent.ParentID != 0 {
 		dir.ParentID = f.ParentID
 	}
 	return dir
 }
+
+func NewMusicFolder(f *db.MusicFolder) *MusicFolder {
+	return &MusicFolder{
+		ID:   f.ID,
+		Name: f.Name,
+	}
+}
@@ -13,9 +13,8 @@ func NewAlbumByTags(a *db.Album, artist *db.Artist) *Album {
 		Name:       a.TagTitle,
 		TrackCount: a.ChildCount,
 	}
-	if a.Cover != "" {
-		ret.CoverID = a.ID
-	}
+	ret.CoverID = albumCoverID(a)
+	setAlbumTags(ret, a)
 	if artist != nil {
 		ret.Artist = artist.Name
 		ret.ArtistID = artist.ID
@@ -46,9 +45,13 @@ func NewTrackByTags(t *db.Track, album *db.Album) *TrackChild {
 		Bitrate:  t.Bitrate,
 		Type:     "music",
 	}
-	if album.Cover != "" {
-		ret.CoverID = album.ID
+	if album.TagAlbumID != 0 {
+		// the folder could be a disc of the album
+		ret.AlbumID = album.TagAlbumID
 	}
+	ret.CoverID = trackCoverID(t, album)
+	ret.ReplayGain = newReplayGain(t, album)
+	setTrackTags(ret, t)
 	if album.TagArtist != nil {
 		ret.ArtistID = album.TagArtist.ID
 	}
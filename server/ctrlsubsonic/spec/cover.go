@@ -0,0 +1,28 @@
+package spec
+
+import (
+	"strconv"
+
+	"senan.xyz/g/gonic/db"
+)
+
+// CoverTrackPrefix is put before the id of a track in a cover id, for the
+// picture embedded in the track. other cover ids are album ids, for the
+// album's cover file, or the picture of its cover track
+const CoverTrackPrefix = "tr-"
+
+func albumCoverID(a *db.Album) string {
+	if !a.HasCover() {
+		return ""
+	}
+	return strconv.Itoa(a.ID)
+}
+
+// trackCoverID gives tracks without a cover file their own cover, so that
+// the tracks in a folder of singles can have different ones
+func trackCoverID(t *db.Track, album *db.Album) string {
+	if album.Cover == "" && t.EmbeddedCover {
+		return CoverTrackPrefix + strconv.Itoa(t.ID)
+	}
+	return albumCoverID(album)
+}
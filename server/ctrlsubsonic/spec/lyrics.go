@@ -0,0 +1,38 @@
+package spec
+
+import (
+	"time"
+
+	"senan.xyz/g/gonic/db"
+	"senan.xyz/g/gonic/scanner/lyrics"
+)
+
+func NewLyrics(track *db.Track, l *lyrics.Lyrics) *Lyrics {
+	return &Lyrics{
+		Artist: track.TagTrackArtist,
+		Title:  track.TagTitle,
+		Value:  l.Plain(),
+	}
+}
+
+func NewStructuredLyrics(track *db.Track, l *lyrics.Lyrics) *StructuredLyrics {
+	ret := &StructuredLyrics{
+		Lang:          l.Lang,
+		Synced:        l.Synced,
+		Offset:        int64(l.Offset / time.Millisecond),
+		DisplayArtist: track.TagTrackArtist,
+		DisplayTitle:  track.TagTitle,
+		Lines:         make([]*LyricsLine, len(l.Lines)),
+	}
+	if ret.Lang == "" {
+		ret.Lang = "und"
+	}
+	for i, line := range l.Lines {
+		ret.Lines[i] = &LyricsLine{Value: line.Value}
+		if l.Synced {
+			start := int64(line.Start / time.Millisecond)
+			ret.Lines[i].Start = &start
+		}
+	}
+	return ret
+}
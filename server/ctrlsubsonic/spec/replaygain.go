@@ -0,0 +1,22 @@
+package spec
+
+import (
+	"senan.xyz/g/gonic/db"
+)
+
+// newReplayGain returns the gains of a track and its album, or nil if
+// there are none
+func newReplayGain(t *db.Track, album *db.Album) *ReplayGain {
+	ret := &ReplayGain{
+		TrackGain: t.ReplayGainTrackGain,
+		TrackPeak: t.ReplayGainTrackPeak,
+	}
+	if album != nil {
+		ret.AlbumGain = album.ReplayGainAlbumGain
+		ret.AlbumPeak = album.ReplayGainAlbumPeak
+	}
+	if ret.TrackGain == nil && ret.AlbumGain == nil {
+		return nil
+	}
+	return ret
+}
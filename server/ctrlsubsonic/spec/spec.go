@@ -41,6 +41,8 @@ type Response struct {
 	ArtistInfoTwo     *ArtistInfo        `xml:"artistInfo2"       json:"artistInfo2,omitempty"`
 	Genres            *Genres            `xml:"genres"            json:"genres,omitempty"`
 	PlayQueue         *PlayQueue         `xml:"playQueue"         json:"playQueue,omitempty"`
+	Lyrics            *Lyrics            `xml:"lyrics"            json:"lyrics,omitempty"`
+	LyricsList        *LyricsList        `xml:"lyricsList"        json:"lyricsList,omitempty"`
 }
 
 func NewResponse() *Response {
@@ -89,7 +91,7 @@ type Albums struct {
 type Album struct {
 	// common
 	ID       int    `xml:"id,attr,omitempty"       json:"id,string"`
-	CoverID  int    `xml:"coverArt,attr,omitempty" json:"coverArt,omitempty,string"`
+	CoverID  string `xml:"coverArt,attr,omitempty" json:"coverArt,omitempty"`
 	ArtistID int    `xml:"artistId,attr,omitempty" json:"artistId,omitempty,string"`
 	Artist   string `xml:"artist,attr,omitempty"   json:"artist,omitempty"`
 	// browsing by folder (getAlbumList)
@@ -103,6 +105,25 @@ type Album struct {
 	Duration   int           `xml:"duration,attr"          json:"duration"`
 	Created    time.Time     `xml:"created,attr,omitempty" json:"created,omitempty"`
 	Tracks     []*TrackChild `xml:"song,omitempty"         json:"song,omitempty"`
+
+	// opensubsonic
+	SortName            string         `xml:"sortName,attr,omitempty"       json:"sortName,omitempty"`
+	RecordLabels        []*RecordLabel `xml:"recordLabels,omitempty"        json:"recordLabels,omitempty"`
+	ReleaseTypes        []string       `xml:"releaseTypes,omitempty"        json:"releaseTypes,omitempty"`
+	OriginalReleaseDate *ItemDate      `xml:"originalReleaseDate,omitempty" json:"originalReleaseDate,omitempty"`
+	IsCompilation       bool           `xml:"isCompilation,attr,omitempty"  json:"isCompilation,omitempty"`
+}
+
+type RecordLabel struct {
+	Name string `xml:"name,attr" json:"name"`
+}
+
+// ItemDate is an opensubsonic date, which can be just a year, or a year
+// and month
+type ItemDate struct {
+	Year  int `xml:"year,attr,omitempty"  json:"year,omitempty"`
+	Month int `xml:"month,attr,omitempty" json:"month,omitempty"`
+	Day   int `xml:"day,attr,omitempty"   json:"day,omitempty"`
 }
 
 type RandomTracks struct {
@@ -120,7 +141,7 @@ type TrackChild struct {
 	ArtistID    int       `xml:"artistId,attr,omitempty"    json:"artistId,omitempty,string"`
 	Bitrate     int       `xml:"bitRate,attr,omitempty"     json:"bitRate,omitempty"`
 	ContentType string    `xml:"contentType,attr,omitempty" json:"contentType,omitempty"`
-	CoverID     int       `xml:"coverArt,attr,omitempty"    json:"coverArt,omitempty,string"`
+	CoverID     string    `xml:"coverArt,attr,omitempty"    json:"coverArt,omitempty"`
 	CreatedAt   time.Time `xml:"created,attr,omitempty"     json:"created,omitempty"`
 	Duration    int       `xml:"duration,attr,omitempty"    json:"duration,omitempty"`
 	Genre       string    `xml:"genre,attr,omitempty"       json:"genre,omitempty"`
@@ -135,6 +156,20 @@ type TrackChild struct {
 	TrackNumber int       `xml:"track,attr,omitempty"       json:"track,omitempty"`
 	DiscNumber  int       `xml:"discNumber,attr,omitempty"  json:"discNumber,omitempty"`
 	Type        string    `xml:"type,attr,omitempty"        json:"type,omitempty"`
+
+	// opensubsonic
+	ReplayGain      *ReplayGain `xml:"replayGain,omitempty"           json:"replayGain,omitempty"`
+	BPM             int         `xml:"bpm,attr,omitempty"             json:"bpm,omitempty"`
+	Comment         string      `xml:"comment,attr,omitempty"         json:"comment,omitempty"`
+	DisplayComposer string      `xml:"displayComposer,attr,omitempty" json:"displayComposer,omitempty"`
+}
+
+// ReplayGain is the opensubsonic replaygain of a track. gains are in dB
+type ReplayGain struct {
+	TrackGain *float64 `xml:"trackGain,attr,omitempty" json:"trackGain,omitempty"`
+	AlbumGain *float64 `xml:"albumGain,attr,omitempty" json:"albumGain,omitempty"`
+	TrackPeak *float64 `xml:"trackPeak,attr,omitempty" json:"trackPeak,omitempty"`
+	AlbumPeak *float64 `xml:"albumPeak,attr,omitempty" json:"albumPeak,omitempty"`
 }
 
 type Artists struct {
@@ -267,3 +302,32 @@ type PlayQueue struct {
 	ChangedBy string        `xml:"changedBy,attr"          json:"changedBy"`
 	List      []*TrackChild `xml:"entry,omitempty"         json:"entry,omitempty"`
 }
+
+type Lyrics struct {
+	Artist string `xml:"artist,attr,omitempty" json:"artist,omitempty"`
+	Title  string `xml:"title,attr,omitempty"  json:"title,omitempty"`
+	Value  string `xml:",chardata"             json:"value,omitempty"`
+}
+
+// LyricsList is the opensubsonic lyrics of a track, with timestamps if
+// they're synced
+type LyricsList struct {
+	StructuredLyrics []*StructuredLyrics `xml:"structuredLyrics" json:"structuredLyrics"`
+}
+
+type StructuredLyrics struct {
+	// Lang is "und" if it isn't known
+	Lang          string        `xml:"lang,attr"                    json:"lang"`
+	Synced        bool          `xml:"synced,attr"                  json:"synced"`
+	Offset        int64         `xml:"offset,attr,omitempty"        json:"offset,omitempty"`
+	DisplayArtist string        `xml:"displayArtist,attr,omitempty" json:"displayArtist,omitempty"`
+	DisplayTitle  string        `xml:"displayTitle,attr,omitempty"  json:"displayTitle,omitempty"`
+	Lines         []*LyricsLine `xml:"line"                         json:"line"`
+}
+
+// LyricsLine is a line of StructuredLyrics. Start is in ms, and only
+// there for synced lyrics
+type LyricsLine struct {
+	Start *int64 `xml:"start,attr,omitempty" json:"start,omitempty"`
+	Value string `xml:",chardata"            json:"value"`
+}
@@ -0,0 +1,62 @@
+package spec
+
+import (
+	"strconv"
+	"strings"
+
+	"senan.xyz/g/gonic/db"
+)
+
+// setAlbumTags sets the opensubsonic fields of an album from its tags
+func setAlbumTags(ret *Album, a *db.Album) {
+	ret.SortName = a.TagTitleSort
+	if a.TagLabel != "" {
+		ret.RecordLabels = []*RecordLabel{{Name: a.TagLabel}}
+	}
+	for _, typ := range a.ReleaseTypes() {
+		ret.ReleaseTypes = append(ret.ReleaseTypes, releaseTypeName(typ))
+	}
+	ret.OriginalReleaseDate = newItemDate(a.TagOriginalDate)
+	ret.IsCompilation = a.TagCompilation
+}
+
+// setTrackTags sets the opensubsonic fields of a track from its tags
+func setTrackTags(ret *TrackChild, t *db.Track) {
+	ret.BPM = t.TagBPM
+	ret.Comment = t.TagComment
+	ret.DisplayComposer = t.TagComposer
+}
+
+// releaseTypeName is how musicbrainz writes a release type, eg. "Album"
+// or "EP"
+func releaseTypeName(typ string) string {
+	if typ == "ep" {
+		return "EP"
+	}
+	return strings.Title(typ)
+}
+
+// newItemDate parses dates like "1973-03-01", "1973-03", or "1973". it's
+// nil if there's no year
+func newItemDate(in string) *ItemDate {
+	parts := strings.SplitN(in, "-", 3)
+	nums := make([]int, len(parts))
+	for i, part := range parts {
+		num, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			break
+		}
+		nums[i] = num
+	}
+	if nums[0] <= 0 {
+		return nil
+	}
+	ret := &ItemDate{Year: nums[0]}
+	if len(nums) > 1 {
+		ret.Month = nums[1]
+	}
+	if len(nums) > 2 {
+		ret.Day = nums[2]
+	}
+	return ret
+}
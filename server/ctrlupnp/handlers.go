@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"regexp"
 	"senan.xyz/g/gonic/db"
+	"senan.xyz/g/gonic/mime"
+	"senan.xyz/g/gonic/server/encode"
 	"strconv"
 	"strings"
 )
@@ -88,10 +90,11 @@ func (c *Controller) ServeCDSControl(r *http.Request) *Response {
 		totalItems := 0
 		itemsResponse := ""
 		if objectIDStr == "0" {
-			// identify our root folders
+			// identify our root folders, one per music folder
 			var dbFolders []*db.Album
 			c.DB.
 				Select("*").
+				Preload("MusicFolder").
 				Where("parent_id is NULL").
 				Order("right_path").
 				Limit(requestedCount).
@@ -104,13 +107,15 @@ func (c *Controller) ServeCDSControl(r *http.Request) *Response {
 
 			// render result
 			for _, dbFolder := range dbFolders {
-				if dbFolder.RightPath != "." {
-					itemsResponse = fmt.Sprintf(`%s<container id="%d" parentID="0" restricted="1">
+				title := dbFolder.RightPath
+				if dbFolder.MusicFolder != nil {
+					title = dbFolder.MusicFolder.Name
+				}
+				itemsResponse = fmt.Sprintf(`%s<container id="%d" parentID="0" restricted="1">
 					<dc:title>%s</dc:title>
 					<upnp:class>object.container.storageFolder</upnp:class>
-				</container>`, itemsResponse, dbFolder.ID, dbFolder.RightPath)
-					numItems = numItems + 1
-				}
+				</container>`, itemsResponse, dbFolder.ID, title)
+				numItems = numItems + 1
 			}
 		} else if strings.HasPrefix(objectIDStr, "track:") {
 			// not a folder browse, but one regarding a track
@@ -242,7 +247,25 @@ func (c *Controller) ServeStream(w http.ResponseWriter, r *http.Request) *Respon
 	} else {
 		log.Printf("serving raw %q\n", track.Filename)
 
-		lastModified, readerSeeker, err := c.MusicDir.GetFile(track.RelPath())
+		musicDir, err := c.MusicDir(track.Album.MusicFolderID)
+		if err != nil {
+			return &Response{code: http.StatusInternalServerError, err: fmt.Sprintf("finding music folder: %v", err)}
+		}
+		if track.CueTrack > 0 {
+			// a track from a cue sheet, which is part of its file
+			_, originalFile, err := musicDir.GetFile(track.RelPath())
+			if err != nil {
+				return &Response{code: http.StatusInternalServerError, err: fmt.Sprintf("failed to get file: %v", err)}
+			}
+			defer originalFile.Close()
+			w.Header().Set("Content-Type", mime.Types[encode.CutFormat(track.Ext())])
+			seg := encode.NewSegment(track.CueStart, track.CueEnd)
+			if err := encode.Cut(r.Context(), originalFile, w, "", track.Ext(), seg); err != nil {
+				log.Printf("error cutting %q: %v", track.Filename, err)
+			}
+			return nil
+		}
+		lastModified, readerSeeker, err := musicDir.GetFile(track.RelPath())
 		if err != nil {
 			return &Response{code: http.StatusInternalServerError, err: fmt.Sprintf("failed to get file: %v", err)}
 		}
@@ -1,12 +1,16 @@
 package encode
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
 	"os/exec"
+	"strconv"
+	"time"
 
 	"github.com/cespare/xxhash"
 	"github.com/pkg/errors"
@@ -68,14 +72,58 @@ func writeCmdOutput(out, cache io.Writer, pipeReader io.ReadCloser) {
 	}
 }
 
-// pre-format the ffmpeg command with needed options
-func ffmpegCommand(profile *Profile, bitrate string) *exec.Cmd {
+// Segment is the part of a file a track is, for tracks from a cue sheet.
+// End is zero for the end of the file
+type Segment struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// NewSegment makes a segment from offsets in milliseconds, as they're
+// stored in the db
+func NewSegment(startMS, endMS int) *Segment {
+	return &Segment{
+		Start: time.Duration(startMS) * time.Millisecond,
+		End:   time.Duration(endMS) * time.Millisecond,
+	}
+}
+
+// args are ffmpeg output options which keep only the segment. they're
+// output options since the input is a pipe, which can't be seeked
+func (s *Segment) args() []string {
+	if s == nil {
+		return nil
+	}
+	args := []string{"-ss", seconds(s.Start)}
+	if s.End > 0 {
+		args = append(args, "-to", seconds(s.End))
+	}
+	return args
+}
+
+func (s *Segment) String() string {
+	if s == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d-%d", s.Start/time.Millisecond, s.End/time.Millisecond)
+}
+
+func seconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+}
+
+// pre-format the ffmpeg command with needed options. it's killed if ctx
+// is done first
+func ffmpegCommand(ctx context.Context, profile *Profile, bitrate string, seg *Segment) *exec.Cmd {
 	args := []string{
 		"-v", "0",
 		"-i", "pipe:",
 		"-map", "0:0",
 		"-vn",
-		"-b:a", bitrate,
+	}
+	args = append(args, seg.args()...)
+	if bitrate != "" {
+		args = append(args, "-b:a", bitrate)
 	}
 	args = append(args, profile.ffmpegOptions...)
 	if profile.forceRG {
@@ -90,33 +138,89 @@ func ffmpegCommand(profile *Profile, bitrate string) *exec.Cmd {
 		)
 	}
 	args = append(args, "-f", profile.Format, "-")
-	return exec.Command("/usr/bin/ffmpeg", args...)
+	return exec.CommandContext(ctx, "/usr/bin/ffmpeg", args...)
+}
+
+// Encode transcodes in, or seg of it if it isn't nil, to out, and to a file
+// at cachePath. ffmpeg is killed if ctx is done, eg. when the client goes
+// away or we're shutting down. the cache file is removed if the encode
+// doesn't finish
+func Encode(ctx context.Context, in io.Reader, out io.Writer, cachePath string, profile *Profile, bitrate string, seg *Segment) error {
+	return run(ctx, ffmpegCommand(ctx, profile, bitrate, seg), in, out, cachePath)
+}
+
+// cutProfiles are the formats that ffmpeg can copy segments of files into
+// without transcoding, by file extension. the muxers for aac and m4a need
+// to seek their output, so those are encoded as flac instead
+var cutProfiles = map[string]*Profile{
+	"flac": {"flac", 0, []string{"-c:a", "copy"}, false},
+	"mp3":  {"mp3", 0, []string{"-c:a", "copy"}, false},
+	"ogg":  {"ogg", 0, []string{"-c:a", "copy"}, false},
+	"opus": {"opus", 0, []string{"-c:a", "copy"}, false},
+}
+
+var cutFallback = &Profile{"flac", 0, []string{"-c:a", "flac"}, false}
+
+// CutFormat is the extension of what Cut makes of a file with extension ext
+func CutFormat(ext string) string {
+	if _, ok := cutProfiles[ext]; ok {
+		return ext
+	}
+	return cutFallback.Format
 }
 
-func Encode(in io.Reader, out io.Writer, cachePath string, profile *Profile, bitrate string) error {
+// Cut is like Encode, but keeps the codec of the file if it can. it's for
+// serving segments "raw". the cache file isn't written if cachePath is empty
+func Cut(ctx context.Context, in io.Reader, out io.Writer, cachePath string, ext string, seg *Segment) error {
+	profile, ok := cutProfiles[ext]
+	if !ok {
+		profile = cutFallback
+	}
+	return run(ctx, ffmpegCommand(ctx, profile, "", seg), in, out, cachePath)
+}
+
+func run(ctx context.Context, cmd *exec.Cmd, in io.Reader, out io.Writer, cachePath string) error {
 	// prepare the command and file descriptors
-	cmd := ffmpegCommand(profile, bitrate)
 	cmd.Stdin = in
 	outputPipeReader, outputPipeWriter := io.Pipe()
 	cmd.Stdout = outputPipeWriter
 	cmd.Stderr = outputPipeWriter
 	// create cache file
-	cacheFile, err := os.Create(cachePath)
-	if err != nil {
-		return errors.Wrapf(err, "writing to cache file %q: %v", cachePath, err)
+	var cache io.Writer = ioutil.Discard
+	var cacheFile *os.File
+	if cachePath != "" {
+		var err error
+		cacheFile, err = os.Create(cachePath)
+		if err != nil {
+			return errors.Wrapf(err, "writing to cache file %q: %v", cachePath, err)
+		}
+		cache = cacheFile
 	}
 	// still unsure if buffer version (writeCmdOutput) is any better than io.Copy-based one (copyCmdOutput)
 	// initial goal here is to start streaming response asap, with smallest ttfb. more testing needed
 	// -- @spijet
 	//
 	// start up writers for cache file and http response
-	go writeCmdOutput(out, cacheFile, outputPipeReader)
+	go writeCmdOutput(out, cache, outputPipeReader)
 	// run ffmpeg
 	if err := cmd.Run(); err != nil {
+		// close the pipe so the writers stop, and don't leave a partial
+		// encode in the cache
+		outputPipeWriter.CloseWithError(err)
+		if cacheFile != nil {
+			cacheFile.Close()
+			os.Remove(cachePath)
+		}
+		if ctx.Err() != nil {
+			return errors.Wrapf(ctx.Err(), "running ffmpeg")
+		}
 		return errors.Wrapf(err, "running ffmpeg")
 	}
 	// close all pipes and flush cache file
 	outputPipeWriter.Close()
+	if cacheFile == nil {
+		return nil
+	}
 	if err := cacheFile.Sync(); err != nil {
 		return errors.Wrapf(err, "flushing %q", cachePath)
 	}
@@ -124,13 +228,28 @@ func Encode(in io.Reader, out io.Writer, cachePath string, profile *Profile, bit
 	return nil
 }
 
-// generate cache key (file name). for, you know, encoded tracks cache
-func CacheKey(sourcePath string, profile, bitrate string) string {
+// generate cache key (file name). for, you know, encoded tracks cache.
+// seg is nil for whole files. the music folder is part of it, since two
+// of them could have files at the same path
+func CacheKey(musicFolderID int, sourcePath string, seg *Segment, profile, bitrate string) string {
 	format := Profiles[profile].Format
-	hash := xxhash.Sum64String(sourcePath)
+	hash := sourceHash(musicFolderID, sourcePath)
+	if seg != nil {
+		return fmt.Sprintf("%x-%s-%s-%s.%s", hash, seg, profile, bitrate, format)
+	}
 	return fmt.Sprintf("%x-%s-%s.%s", hash, profile, bitrate, format)
 }
 
+// CutCacheKey is the cache key of a segment made by Cut
+func CutCacheKey(musicFolderID int, sourcePath string, seg *Segment, ext string) string {
+	hash := sourceHash(musicFolderID, sourcePath)
+	return fmt.Sprintf("%x-%s-cut.%s", hash, seg, CutFormat(ext))
+}
+
+func sourceHash(musicFolderID int, sourcePath string) uint64 {
+	return xxhash.Sum64String(fmt.Sprintf("%d/%s", musicFolderID, sourcePath))
+}
+
 // check if client forces bitrate lower than set in profile
 func GetBitrate(clientBitrate int, profile *Profile) string {
 	bitrate := profile.Bitrate
@@ -0,0 +1,38 @@
+package encode
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSegmentArgs(t *testing.T) {
+	var whole *Segment
+	if args := whole.args(); args != nil {
+		t.Errorf("expected no args for a whole file, got %q", args)
+	}
+	seg := NewSegment(67493, 230986)
+	if exp := []string{"-ss", "67.493", "-to", "230.986"}; !reflect.DeepEqual(seg.args(), exp) {
+		t.Errorf("expected args %q, got %q", exp, seg.args())
+	}
+	last := NewSegment(230986, 0)
+	if exp := []string{"-ss", "230.986"}; !reflect.DeepEqual(last.args(), exp) {
+		t.Errorf("expected args %q, got %q", exp, last.args())
+	}
+	if CacheKey(1, "a/b.flac", seg, "mp3", "128k") == CacheKey(1, "a/b.flac", last, "mp3", "128k") {
+		t.Errorf("expected segments to have different cache keys")
+	}
+	if CacheKey(1, "a/b.flac", nil, "mp3", "128k") == CacheKey(2, "a/b.flac", nil, "mp3", "128k") {
+		t.Errorf("expected files in different music folders to have different cache keys")
+	}
+	if CutCacheKey(1, "a/b.flac", seg, "flac") == CutCacheKey(2, "a/b.flac", seg, "flac") {
+		t.Errorf("expected cuts in different music folders to have different cache keys")
+	}
+}
+
+func TestCutFormat(t *testing.T) {
+	for ext, exp := range map[string]string{"flac": "flac", "mp3": "mp3", "m4a": "flac"} {
+		if format := CutFormat(ext); format != exp {
+			t.Errorf("expected %q to be cut to %q, got %q", ext, exp, format)
+		}
+	}
+}
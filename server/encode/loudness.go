@@ -0,0 +1,99 @@
+package encode
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ReplayGainReference is the loudness replaygain 2.0 gains bring tracks to
+const ReplayGainReference = -18.0
+
+// Loudness is an EBU R128 measurement of a track
+type Loudness struct {
+	// Integrated is the loudness of the whole track, in LUFS
+	Integrated float64
+	// Peak is the sample peak, linear
+	Peak float64
+}
+
+// Gain is the replaygain 2.0 gain of the track, in dB
+func (l Loudness) Gain() float64 {
+	return ReplayGainReference - l.Integrated
+}
+
+// MeasureLoudness decodes in, or seg of it if it isn't nil, with ffmpeg's
+// ebur128 filter. ffmpeg is killed if ctx is done
+func MeasureLoudness(ctx context.Context, in io.Reader, seg *Segment) (Loudness, error) {
+	cmd := exec.CommandContext(ctx, "/usr/bin/ffmpeg", loudnessArgs(seg)...)
+	cmd.Stdin = in
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return Loudness{}, errors.Wrap(ctx.Err(), "running ffmpeg")
+		}
+		return Loudness{}, errors.Wrap(err, "running ffmpeg")
+	}
+	return parseLoudness(&stderr)
+}
+
+// loudnessArgs are the ffmpeg arguments for measuring seg of the input
+func loudnessArgs(seg *Segment) []string {
+	args := []string{
+		"-nostats", "-hide_banner",
+		"-i", "pipe:",
+		"-map", "0:a:0",
+	}
+	args = append(args, seg.args()...)
+	return append(args,
+		"-af", "ebur128=peak=sample",
+		"-f", "null", "-",
+	)
+}
+
+// parseLoudness reads the summary the ebur128 filter prints at the end.
+// the integrated loudness is on a line like "I: -19.4 LUFS", and the
+// sample peak on one like "Peak: -0.5 dBFS"
+func parseLoudness(r io.Reader) (Loudness, error) {
+	var loudness Loudness
+	var foundI, foundPeak bool
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			continue
+		}
+		// the summary is last, so the values of the last lines are kept
+		switch {
+		case fields[0] == "I:" && fields[2] == "LUFS":
+			loudness.Integrated = value
+			foundI = true
+		case fields[0] == "Peak:" && fields[2] == "dBFS":
+			loudness.Peak = math.Pow(10, value/20)
+			foundPeak = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Loudness{}, errors.Wrap(err, "reading ffmpeg output")
+	}
+	if !foundI || !foundPeak {
+		return Loudness{}, errors.New("no loudness summary in ffmpeg output")
+	}
+	if loudness.Integrated <= -70 {
+		// ffmpeg says -70 for silence, which can't be brought up
+		return Loudness{}, errors.New("track is silent")
+	}
+	return loudness, nil
+}
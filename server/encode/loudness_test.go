@@ -0,0 +1,59 @@
+package encode
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+const testEBUR128Output = `Input #0, flac, from 'pipe:':
+[Parsed_ebur128_0 @ 0x55d0] t: 0.1       TARGET:-23 LUFS    M: -120.7 S:-120.7     I: -70.0 LUFS       LRA:   0.0 LU  SPK: -5.2 dBFS
+[Parsed_ebur128_0 @ 0x55d0] Summary:
+
+  Integrated loudness:
+    I:         -12.5 LUFS
+    Threshold: -22.9 LUFS
+
+  Loudness range:
+    LRA:         4.2 LU
+    Threshold: -33.0 LUFS
+    LRA low:   -15.4 LUFS
+    LRA high:  -11.2 LUFS
+
+  Sample peak:
+    Peak:       -0.5 dBFS
+`
+
+func TestParseLoudness(t *testing.T) {
+	loudness, err := parseLoudness(strings.NewReader(testEBUR128Output))
+	if err != nil {
+		t.Fatalf("parsing: %v", err)
+	}
+	if loudness.Integrated != -12.5 {
+		t.Errorf("expected -12.5 LUFS, got %v", loudness.Integrated)
+	}
+	if gain := loudness.Gain(); gain != -5.5 {
+		t.Errorf("expected a gain of -5.5 dB, got %v", gain)
+	}
+	if math.Abs(loudness.Peak-0.944) > 0.001 {
+		t.Errorf("expected a peak of 0.944, got %v", loudness.Peak)
+	}
+	silent := strings.Replace(testEBUR128Output, "-12.5 LUFS", "-70.0 LUFS", 1)
+	if _, err := parseLoudness(strings.NewReader(silent)); err == nil {
+		t.Errorf("expected an error for a silent track")
+	}
+	if _, err := parseLoudness(strings.NewReader("pipe:: Invalid data\n")); err == nil {
+		t.Errorf("expected an error without a summary")
+	}
+}
+
+func TestLoudnessArgs(t *testing.T) {
+	whole := strings.Join(loudnessArgs(nil), " ")
+	if strings.Contains(whole, "-ss") {
+		t.Errorf("expected the whole file to be measured, got %q", whole)
+	}
+	seg := strings.Join(loudnessArgs(NewSegment(67493, 230986)), " ")
+	if !strings.Contains(seg, "-i pipe: -map 0:a:0 -ss 67.493 -to 230.986 -af") {
+		t.Errorf("expected only the segment to be measured, got %q", seg)
+	}
+}
@@ -0,0 +1,265 @@
+// Package loudness measures the loudness of tracks which aren't tagged
+// with replaygain, so that clients can be given gains for every track
+package loudness
+
+import (
+	"context"
+	"log"
+	"math"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"senan.xyz/g/gonic/db"
+	"senan.xyz/g/gonic/dir"
+	"senan.xyz/g/gonic/server/encode"
+)
+
+var (
+	errAlreadyRunning = errors.New("already analysing loudness")
+	errNotRunning     = errors.New("not analysing loudness")
+	errNoFFmpeg       = errors.New("ffmpeg is needed to analyse loudness")
+)
+
+// Progress is a snapshot of the current analysis, or of the last one if
+// Running is false
+type Progress struct {
+	Running bool
+	// Tracks is how many tracks there are to analyse, and Done how many
+	// of them have been. Errors is how many of those couldn't be. Albums
+	// is how many albums were given gains after
+	Tracks  int
+	Done    int
+	Errors  int
+	Albums  int
+	Started time.Time
+	Elapsed time.Duration
+	// Cancelled is whether the analysis was stopped before it finished
+	Cancelled bool
+}
+
+type Analyser struct {
+	db        *db.DB
+	musicDirs map[int]dir.Dir
+	// the current or last analysis
+	progressMu sync.Mutex
+	progress   Progress
+	cancel     context.CancelFunc
+	jobs       sync.WaitGroup
+}
+
+func New(db *db.DB, musicDirs map[int]dir.Dir) *Analyser {
+	return &Analyser{
+		db:        db,
+		musicDirs: musicDirs,
+	}
+}
+
+// Progress returns a snapshot of the current analysis, or of the last one
+func (a *Analyser) Progress() Progress {
+	a.progressMu.Lock()
+	p := a.progress
+	a.progressMu.Unlock()
+	if p.Running {
+		p.Elapsed = time.Since(p.Started).Round(time.Second)
+	}
+	return p
+}
+
+func (a *Analyser) updateProgress(fn func(p *Progress)) {
+	a.progressMu.Lock()
+	defer a.progressMu.Unlock()
+	fn(&a.progress)
+}
+
+// Unanalysed is how many tracks don't have a replaygain gain
+func (a *Analyser) Unanalysed() int {
+	var count int
+	a.db.
+		Model(db.Track{}).
+		Where("replay_gain_track_gain IS NULL").
+		Count(&count)
+	return count
+}
+
+// StartAnalysing measures the tracks without replaygain tags in the
+// background, then gives gains to the albums whose tracks all have them
+func (a *Analyser) StartAnalysing() error {
+	if _, err := exec.LookPath("/usr/bin/ffmpeg"); err != nil {
+		return errNoFFmpeg
+	}
+	a.progressMu.Lock()
+	defer a.progressMu.Unlock()
+	if a.progress.Running {
+		return errAlreadyRunning
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancel = cancel
+	a.progress = Progress{
+		Running: true,
+		Started: time.Now(),
+	}
+	a.jobs.Add(1)
+	go func() {
+		defer a.jobs.Done()
+		defer cancel()
+		a.analyseTracks(ctx)
+		if ctx.Err() == nil {
+			a.analyseAlbums()
+		}
+		a.updateProgress(func(p *Progress) {
+			p.Running = false
+			p.Elapsed = time.Since(p.Started).Round(time.Second)
+			p.Cancelled = ctx.Err() != nil
+			a.cancel = nil
+		})
+	}()
+	return nil
+}
+
+// Cancel stops the current analysis. it returns before it has stopped
+func (a *Analyser) Cancel() error {
+	a.progressMu.Lock()
+	defer a.progressMu.Unlock()
+	if a.cancel == nil {
+		return errNotRunning
+	}
+	a.cancel()
+	return nil
+}
+
+// Shutdown cancels the current analysis and waits for it to stop
+func (a *Analyser) Shutdown() {
+	_ = a.Cancel()
+	a.jobs.Wait()
+}
+
+func (a *Analyser) analyseTracks(ctx context.Context) {
+	start := time.Now()
+	var trackIDs []int
+	a.db.
+		Model(db.Track{}).
+		Where("replay_gain_track_gain IS NULL").
+		Order("id").
+		Pluck("id", &trackIDs)
+	a.updateProgress(func(p *Progress) {
+		p.Tracks = len(trackIDs)
+	})
+	for _, trackID := range trackIDs {
+		err := a.analyseTrack(ctx, trackID)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Printf("error analysing loudness of track %d: %v\n", trackID, err)
+		}
+		a.updateProgress(func(p *Progress) {
+			p.Done++
+			if err != nil {
+				p.Errors++
+			}
+		})
+	}
+	log.Printf("finished analysing the loudness of %d tracks in %s\n",
+		len(trackIDs), time.Since(start).Round(time.Second))
+}
+
+func (a *Analyser) analyseTrack(ctx context.Context, trackID int) error {
+	track := &db.Track{}
+	err := a.db.
+		Preload("Album").
+		First(track, trackID).
+		Error
+	if err != nil {
+		return errors.Wrap(err, "finding track")
+	}
+	musicDir, ok := a.musicDirs[track.Album.MusicFolderID]
+	if !ok {
+		return errors.Errorf("no music folder with id `%d`", track.Album.MusicFolderID)
+	}
+	_, readerSeeker, err := musicDir.GetFile(track.RelPath())
+	if err != nil {
+		return errors.Wrap(err, "getting file")
+	}
+	defer readerSeeker.Close()
+	loudness, err := encode.MeasureLoudness(ctx, readerSeeker, trackSegment(track))
+	if err != nil {
+		return err
+	}
+	gain := loudness.Gain()
+	return a.db.
+		Model(track).
+		UpdateColumns(map[string]interface{}{
+			"replay_gain_track_gain": gain,
+			"replay_gain_track_peak": loudness.Peak,
+			"replay_gain_analysed":   true,
+		}).
+		Error
+}
+
+// trackSegment is the part of its file a track is, or nil for tracks which
+// are whole files. tracks from cue sheets are only measured for their part
+func trackSegment(track *db.Track) *encode.Segment {
+	if track.CueTrack == 0 {
+		return nil
+	}
+	return encode.NewSegment(track.CueStart, track.CueEnd)
+}
+
+// analyseAlbums gives gains to the albums without them, if all of their
+// tracks have them. the album's loudness is the mean of its tracks' by
+// energy, weighted by their lengths. it's close to what measuring the
+// whole album at once would give
+func (a *Analyser) analyseAlbums() {
+	var albumIDs []int
+	a.db.
+		Model(db.Album{}).
+		Where("replay_gain_album_gain IS NULL").
+		Where("EXISTS (SELECT 1 FROM tracks WHERE tracks.album_id=albums.id)").
+		Where("NOT EXISTS (SELECT 1 FROM tracks WHERE tracks.album_id=albums.id AND tracks.replay_gain_track_gain IS NULL)").
+		Order("id").
+		Pluck("id", &albumIDs)
+	for _, albumID := range albumIDs {
+		var tracks []*db.Track
+		a.db.
+			Select("length, replay_gain_track_gain, replay_gain_track_peak").
+			Where("album_id=?", albumID).
+			Find(&tracks)
+		gain, peak := albumGain(tracks)
+		a.db.
+			Model(db.Album{}).
+			Where("id=?", albumID).
+			UpdateColumns(map[string]interface{}{
+				"replay_gain_album_gain": gain,
+				"replay_gain_album_peak": peak,
+				"replay_gain_analysed":   true,
+			})
+	}
+	a.updateProgress(func(p *Progress) {
+		p.Albums = len(albumIDs)
+	})
+}
+
+// albumGain returns the gain and peak of an album of tracks which all have
+// gains. the peak is nil if none of them have peaks
+func albumGain(tracks []*db.Track) (float64, *float64) {
+	var energy, length float64
+	var peak *float64
+	for _, track := range tracks {
+		weight := float64(track.Length)
+		if weight <= 0 {
+			weight = 1
+		}
+		loudness := encode.ReplayGainReference - *track.ReplayGainTrackGain
+		energy += weight * math.Pow(10, loudness/10)
+		length += weight
+		if track.ReplayGainTrackPeak != nil &&
+			(peak == nil || *track.ReplayGainTrackPeak > *peak) {
+			peak = track.ReplayGainTrackPeak
+		}
+	}
+	loudness := 10 * math.Log10(energy/length)
+	return encode.ReplayGainReference - loudness, peak
+}
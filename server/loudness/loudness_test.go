@@ -0,0 +1,53 @@
+package loudness
+
+import (
+	"math"
+	"testing"
+
+	"senan.xyz/g/gonic/db"
+)
+
+func float(f float64) *float64 { return &f }
+
+func TestAlbumGain(t *testing.T) {
+	// the same gain for every track is the album's gain
+	gain, peak := albumGain([]*db.Track{
+		{Length: 100, ReplayGainTrackGain: float(-4), ReplayGainTrackPeak: float(0.5)},
+		{Length: 200, ReplayGainTrackGain: float(-4), ReplayGainTrackPeak: float(0.9)},
+	})
+	if math.Abs(gain+4) > 0.0001 {
+		t.Errorf("expected a gain of -4, got %v", gain)
+	}
+	if peak == nil || *peak != 0.9 {
+		t.Errorf("expected the loudest peak, got %v", peak)
+	}
+	// loud tracks count for more than quiet ones, and long ones more than
+	// short ones
+	gain, peak = albumGain([]*db.Track{
+		{Length: 100, ReplayGainTrackGain: float(-10)},
+		{Length: 100, ReplayGainTrackGain: float(0)},
+	})
+	if gain > -7 || gain < -8 {
+		t.Errorf("expected a gain near the loud track's, got %v", gain)
+	}
+	if peak != nil {
+		t.Errorf("expected no peak, got %v", *peak)
+	}
+	gain, _ = albumGain([]*db.Track{
+		{Length: 900, ReplayGainTrackGain: float(0)},
+		{Length: 100, ReplayGainTrackGain: float(-10)},
+	})
+	if gain > -2.5 || gain < -3 {
+		t.Errorf("expected a gain near the long track's, got %v", gain)
+	}
+}
+
+func TestTrackSegment(t *testing.T) {
+	if seg := trackSegment(&db.Track{Length: 300}); seg != nil {
+		t.Errorf("expected no segment for a whole file, got %v", seg)
+	}
+	seg := trackSegment(&db.Track{CueTrack: 2, CueStart: 67493, CueEnd: 230986})
+	if seg == nil || seg.String() != "67493-230986" {
+		t.Errorf("expected the cue track's segment, got %v", seg)
+	}
+}
@@ -2,38 +2,65 @@ package server
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"path/filepath"
 	"senan.xyz/g/gonic/server/ctrlupnp"
+	"sync"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
 
 	"senan.xyz/g/gonic/db"
 	"senan.xyz/g/gonic/dir"
 	"senan.xyz/g/gonic/scanner"
 	"senan.xyz/g/gonic/server/assets"
+	"senan.xyz/g/gonic/server/covers"
 	"senan.xyz/g/gonic/server/ctrladmin"
 	"senan.xyz/g/gonic/server/ctrlbase"
 	"senan.xyz/g/gonic/server/ctrlsubsonic"
+	"senan.xyz/g/gonic/server/loudness"
 )
 
 type Options struct {
-	DB           *db.DB
-	MusicDir     dir.Dir
-	CachePath    string
-	ListenAddr   string
-	FrontendAddr string
-	ScanInterval time.Duration
-	ProxyPrefix  string
+	DB              *db.DB
+	MusicDirs       map[int]dir.Dir // music folder id -> dir
+	CachePath       string
+	ListenAddr      string
+	FrontendAddr    string
+	ScanInterval    time.Duration
+	ScanWatch       bool
+	ScanConcurrency int
+	// ScanDelimiters separate the values of multi-valued tags
+	ScanDelimiters string
+	// ScanVariousArtists is who compilations are filed under
+	ScanVariousArtists string
+	// CoverSizes are the sizes covers are scaled to for clients
+	CoverSizes  []int
+	ProxyPrefix string
 }
 
 type Server struct {
 	*http.Server
 	scanner      *scanner.Scanner
+	covers       *covers.Covers
+	loudness     *loudness.Analyser
+	musicDirs    map[int]dir.Dir
 	scanInterval time.Duration
+	scanWatch    bool
+	// the scan ticker is started either by the scan interval, or as
+	// a fallback when folders can't be watched
+	tickerOnce sync.Once
+	// done is closed when we're shutting down, to stop the ticker, the
+	// watchers, and the scan event streams. cancelRequests cancels the
+	// contexts of the requests which are still going after the grace
+	// period, so that long running ones like transcodes stop
+	done           chan struct{}
+	cancelRequests context.CancelFunc
 }
 
 func New(opts Options) *Server {
@@ -41,15 +68,30 @@ func New(opts Options) *Server {
 	opts.CachePath = filepath.Clean(opts.CachePath)
 
 	// ** begin controllers
-	scanner := scanner.New(opts.DB, opts.MusicDir)
+	scanner := scanner.New(opts.DB, opts.MusicDirs, scanner.Options{
+		Concurrency:          opts.ScanConcurrency,
+		MultiValueDelimiters: opts.ScanDelimiters,
+		VariousArtists:       opts.ScanVariousArtists,
+	})
+
+	covers := covers.New(opts.DB, opts.MusicDirs, covers.Options{
+		CachePath: filepath.Join(opts.CachePath, "covers"),
+		Sizes:     opts.CoverSizes,
+	})
+	loudness := loudness.New(opts.DB, opts.MusicDirs)
+
+	done := make(chan struct{})
 
 	// the base controller, it's fields/middlewares are embedded/used by the
 	// other two admin ui and subsonic controllers
 	base := &ctrlbase.Controller{
 		DB:          opts.DB,
-		MusicDir:    opts.MusicDir,
+		MusicDirs:   opts.MusicDirs,
 		ProxyPrefix: opts.ProxyPrefix,
 		Scanner:     scanner,
+		Covers:      covers,
+		Loudness:    loudness,
+		Done:        done,
 	}
 
 	// router with common wares for admin / subsonic
@@ -65,17 +107,27 @@ func New(opts Options) *Server {
 	setupUPnP(setupUPnPRouter, ctrlupnp.New(base, opts.FrontendAddr))
 
 	//
+	requestCtx, cancelRequests := context.WithCancel(context.Background())
 	server := &http.Server{
 		Addr:         opts.ListenAddr,
 		Handler:      r,
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 15 * time.Minute,
 		IdleTimeout:  1 * time.Minute,
+		BaseContext: func(net.Listener) context.Context {
+			return requestCtx
+		},
 	}
 	return &Server{
-		Server:       server,
-		scanner:      scanner,
-		scanInterval: opts.ScanInterval,
+		Server:         server,
+		scanner:        scanner,
+		covers:         covers,
+		loudness:       loudness,
+		musicDirs:      opts.MusicDirs,
+		scanInterval:   opts.ScanInterval,
+		scanWatch:      opts.ScanWatch,
+		done:           done,
+		cancelRequests: cancelRequests,
 	}
 }
 
@@ -114,6 +166,7 @@ func setupAdmin(r *mux.Router, ctrl *ctrladmin.Controller) {
 	routUser.Use(ctrl.WithUserSession)
 	routUser.HandleFunc("/logout", ctrl.ServeLogout) // "raw" handler, updates session
 	routUser.Handle("/home", ctrl.H(ctrl.ServeHome))
+	routUser.HandleFunc("/scan_events", ctrl.ServeScanEvents) // "raw" handler, streams
 	routUser.Handle("/change_own_password", ctrl.H(ctrl.ServeChangeOwnPassword))
 	routUser.Handle("/change_own_password_do", ctrl.H(ctrl.ServeChangeOwnPasswordDo))
 	routUser.Handle("/link_lastfm_do", ctrl.H(ctrl.ServeLinkLastFMDo))
@@ -133,6 +186,14 @@ func setupAdmin(r *mux.Router, ctrl *ctrladmin.Controller) {
 	routAdmin.Handle("/update_lastfm_api_key", ctrl.H(ctrl.ServeUpdateLastFMAPIKey))
 	routAdmin.Handle("/update_lastfm_api_key_do", ctrl.H(ctrl.ServeUpdateLastFMAPIKeyDo))
 	routAdmin.Handle("/start_scan_do", ctrl.H(ctrl.ServeStartScanDo))
+	routAdmin.Handle("/cancel_scan_do", ctrl.H(ctrl.ServeCancelScanDo))
+	routAdmin.Handle("/scan_errors", ctrl.H(ctrl.ServeScanErrors))
+	routAdmin.Handle("/delete_music_folder_do", ctrl.H(ctrl.ServeDeleteMusicFolderDo))
+	routAdmin.Handle("/clear_music_cache_do", ctrl.H(ctrl.ServeClearMusicCacheDo))
+	routAdmin.Handle("/generate_covers_do", ctrl.H(ctrl.ServeGenerateCoversDo))
+	routAdmin.Handle("/cancel_generate_covers_do", ctrl.H(ctrl.ServeCancelGenerateCoversDo))
+	routAdmin.Handle("/analyse_loudness_do", ctrl.H(ctrl.ServeAnalyseLoudnessDo))
+	routAdmin.Handle("/cancel_analyse_loudness_do", ctrl.H(ctrl.ServeCancelAnalyseLoudnessDo))
 	// middlewares should be run for not found handler
 	// https://github.com/gorilla/mux/issues/416
 	notFoundHandler := ctrl.H(ctrl.ServeNotFound)
@@ -148,9 +209,11 @@ func setupSubsonic(r *mux.Router, ctrl *ctrlsubsonic.Controller) {
 	r.Handle("/getLicense{_:(?:\\.view)?}", ctrl.H(ctrl.ServeGetLicence))
 	r.Handle("/getMusicFolders{_:(?:\\.view)?}", ctrl.H(ctrl.ServeGetMusicFolders))
 	r.Handle("/getScanStatus{_:(?:\\.view)?}", ctrl.H(ctrl.ServeGetScanStatus))
+	r.HandleFunc("/getScanStatusEvents{_:(?:\\.view)?}", ctrl.ServeScanEvents) // not in the spec
 	r.Handle("/ping{_:(?:\\.view)?}", ctrl.H(ctrl.ServePing))
 	r.Handle("/scrobble{_:(?:\\.view)?}", ctrl.H(ctrl.ServeScrobble))
 	r.Handle("/startScan{_:(?:\\.view)?}", ctrl.H(ctrl.ServeStartScan))
+	r.Handle("/cancelScan{_:(?:\\.view)?}", ctrl.H(ctrl.ServeCancelScan)) // not in the spec
 	r.Handle("/getUser{_:(?:\\.view)?}", ctrl.H(ctrl.ServeGetUser))
 	r.Handle("/getPlaylists{_:(?:\\.view)?}", ctrl.H(ctrl.ServeGetPlaylists))
 	r.Handle("/getPlaylist{_:(?:\\.view)?}", ctrl.H(ctrl.ServeGetPlaylist))
@@ -162,6 +225,8 @@ func setupSubsonic(r *mux.Router, ctrl *ctrlsubsonic.Controller) {
 	r.Handle("/getSong{_:(?:\\.view)?}", ctrl.H(ctrl.ServeGetSong))
 	r.Handle("/getRandomSongs{_:(?:\\.view)?}", ctrl.H(ctrl.ServeGetRandomSongs))
 	r.Handle("/getSongsByGenre{_:(?:\\.view)?}", ctrl.H(ctrl.ServeGetSongsByGenre))
+	r.Handle("/getLyrics{_:(?:\\.view)?}", ctrl.H(ctrl.ServeGetLyrics))
+	r.Handle("/getLyricsBySongId{_:(?:\\.view)?}", ctrl.H(ctrl.ServeGetLyricsBySongID)) // opensubsonic
 	// ** begin raw
 	r.Handle("/download{_:(?:\\.view)?}", ctrl.HR(ctrl.ServeDownload))
 	r.Handle("/getCoverArt{_:(?:\\.view)?}", ctrl.HR(ctrl.ServeGetCoverArt))
@@ -198,17 +263,106 @@ func setupUPnP(r *mux.Router, ctrl *ctrlupnp.Controller) {
 	r.Handle("/streaming", ctrl.HR(ctrl.ServeStream))
 }
 
-func (s *Server) Start() error {
-	if s.scanInterval > 0 {
-		log.Printf("will be scanning at intervals of %s", s.scanInterval)
-		ticker := time.NewTicker(s.scanInterval)
+const (
+	// watchDebounce is how long to wait for a folder to settle before
+	// rescanning it. eg. while an album is still being copied in
+	watchDebounce = 3 * time.Second
+	// watchFallbackInterval is the scan interval used when a folder can't
+	// be watched, and no interval was set
+	watchFallbackInterval = 30 * time.Minute
+	// shutdownTimeout is how long the requests in flight have to finish
+	// when we're shutting down, before they're closed
+	shutdownTimeout = 10 * time.Second
+)
+
+func (s *Server) startScanTicker(interval time.Duration) {
+	s.tickerOnce.Do(func() {
+		log.Printf("will be scanning at intervals of %s", interval)
+		ticker := time.NewTicker(interval)
 		go func() {
-			for range ticker.C {
-				if err := s.scanner.Start(); err != nil {
+			defer ticker.Stop()
+			for {
+				select {
+				case <-s.done:
+					return
+				case <-ticker.C:
+				}
+				if err := s.scanner.Start(context.Background(), scanner.ScanOptions{}); err != nil {
 					log.Printf("error while scanner: %v", err)
 				}
 			}
 		}()
+	})
+}
+
+func (s *Server) startWatching() {
+	for id, musicDir := range s.musicDirs {
+		watcher, ok := musicDir.(dir.Watcher)
+		if !ok {
+			log.Printf("can't watch music folder %d (%s), falling back to scan intervals",
+				id, musicDir.GetTypeName())
+			s.startScanTicker(watchFallbackInterval)
+			continue
+		}
+		go func(id int, watcher dir.Watcher) {
+			// we watch for as long as we're running
+			err := watcher.Watch(s.done, watchDebounce, func(relPaths []string) error {
+				return s.scanner.StartPaths(context.Background(), id, relPaths, scanner.ScanOptions{})
+			})
+			select {
+			case <-s.done:
+				return
+			default:
+			}
+			log.Printf("stopped watching music folder %d, falling back to scan intervals: %v",
+				id, err)
+			if errors.Is(err, dir.ErrWatchLimit) {
+				log.Printf("consider raising fs.inotify.max_user_watches with sysctl")
+			}
+			s.startScanTicker(watchFallbackInterval)
+		}(id, watcher)
+	}
+}
+
+// Start serves until ctx is done, then shuts down gracefully
+func (s *Server) Start(ctx context.Context) error {
+	if s.scanInterval > 0 {
+		s.startScanTicker(s.scanInterval)
+	}
+	if s.scanWatch {
+		s.startWatching()
+	}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.ListenAndServe()
+	}()
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+	return s.shutdown()
+}
+
+// shutdown stops scanning, and waits a while for the requests in flight.
+// a scan writes each folder in a transaction of its own, so stopping one
+// leaves the db consistent. scan event streams are ended straight away,
+// since they'd never finish. requests still going when the wait is over,
+// like transcodes, are stopped by their contexts, which kills their
+// encoders
+func (s *Server) shutdown() error {
+	log.Printf("shutting down")
+	close(s.done)
+	s.scanner.Shutdown()
+	s.covers.Shutdown()
+	s.loudness.Shutdown()
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	err := s.Shutdown(ctx)
+	s.cancelRequests()
+	if err != nil {
+		log.Printf("requests didn't finish, closing them: %v", err)
+		return s.Close()
 	}
-	return s.ListenAndServe()
+	return nil
 }